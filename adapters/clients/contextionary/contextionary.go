@@ -14,10 +14,13 @@ package contextionary
 import (
 	"context"
 	"fmt"
+	"path"
+	"time"
 
 	pb "github.com/semi-technologies/contextionary/contextionary"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"google.golang.org/grpc"
@@ -30,9 +33,18 @@ type Client struct {
 	grpcClient pb.ContextionaryClient
 }
 
-// NewClient from gRPC discovery url to connect to a remote contextionary service
-func NewClient(uri string) (*Client, error) {
-	conn, err := grpc.Dial(uri, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*48)))
+// NewClient from gRPC discovery url to connect to a remote contextionary
+// service. The duration of every call is recorded, unless metrics is nil.
+func NewClient(uri string, metrics *monitoring.PrometheusMetrics) (*Client, error) {
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024 * 1024 * 48)),
+	}
+	if metrics != nil {
+		opts = append(opts, grpc.WithUnaryInterceptor(makeRecordDuration(metrics)))
+	}
+
+	conn, err := grpc.Dial(uri, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't connect to remote contextionary gRPC server: %s", err)
 	}
@@ -43,6 +55,17 @@ func NewClient(uri string) (*Client, error) {
 	}, nil
 }
 
+func makeRecordDuration(metrics *monitoring.PrometheusMetrics) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		before := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		metrics.ContextionaryDuration.WithLabelValues(path.Base(method)).
+			Observe(time.Since(before).Seconds())
+		return err
+	}
+}
+
 // IsStopWord returns true if the given word is a stopword, errors on connection errors
 func (c *Client) IsStopWord(ctx context.Context, word string) (bool, error) {
 	res, err := c.grpcClient.IsWordStopword(ctx, &pb.Word{Word: word})
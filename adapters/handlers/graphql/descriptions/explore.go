@@ -24,4 +24,8 @@ const (
 	ClassName            = "Name of the Class"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 	Distance             = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
+	NearVector           = "Search for objects close to the provided vector, such as a vector computed outside of Weaviate. Cannot be combined with concepts"
+	NearVectorVector     = "The vector to search with. Array type, e.g. [0.1, 0.2]. It must have as many dimensions as the vectors of the searched objects"
+	NearObject           = "Search for objects close to the vector of an existing object, such as to find similar objects. The object can be of any class. Cannot be combined with concepts"
+	NearObjectID         = "The id of the object whose vector is used for the search"
 )
@@ -132,6 +132,34 @@ const NetworkTimeout = "Specify the time in seconds after which an unresolved re
 
 // Pagination filter elements
 const (
-	First = "Show the first x results (pagination option)"
-	After = "Show the results after the first x results (pagination option)"
+	First  = "Show the first x results (pagination option)"
+	After  = "Show the results after the first x results (pagination option)"
+	Offset = "Skip the first x results (pagination option)"
+	Cursor = "Show only results with an id greater than the specified one, results are ordered by id (pagination option)"
+)
+
+// Sort filter elements
+const (
+	Sort      = "Sort the results by the values of one or more properties, subsequent properties are used to break ties"
+	SortPath  = "Specify the path of the property to sort by"
+	SortOrder = "Specify the order of the sort, either 'asc' or 'desc'"
+)
+
+// Keyword search elements
+const (
+	BM25           = "Rank the results by the relevance of their text and string properties to a keyword query using BM25. Cannot be combined with a vector search or sort"
+	BM25Query      = "The keyword query, it is analyzed the same way as the values of the searched properties"
+	BM25Properties = "The text or string properties to search, all text and string properties are searched if not set"
+	Score          = "The relevance score of the object to the keyword query, only set on keyword searches"
+)
+
+// Hybrid search elements
+const (
+	Hybrid           = "Combine a vector search and a BM25 keyword search for the same query into a single ranking. Cannot be combined with another vector search, bm25 or sort"
+	HybridQuery      = "The query, it is used for the keyword search and vectorized for the vector search unless a vector is set"
+	HybridAlpha      = "Weigh the vector ranking against the keyword ranking, 1 is a pure vector search, 0 is a pure keyword search"
+	HybridProperties = "The text or string properties to search with the keyword search, all text and string properties are searched if not set"
+	HybridVector     = "Use this vector for the vector search instead of vectorizing the query"
+	HybridFusion     = "Specify how the rankings are combined, either by the ranks of an object (rankedFusion) or by its normalized scores (relativeScoreFusion)"
+	HybridScore      = "The scores of a hybrid search, the combined score as well as the scores of the vector and keyword search. A component is null if the object was not found by the respective search"
 )
@@ -51,6 +51,10 @@ const (
 	AggregatePropertyMaximum              = "The maximum value for this property"
 	AggregatePropertyMean                 = "The mean of all values for this property"
 	AggregatePropertySum                  = "The sum of all values for this property"
+	AggregatePropertyHistogram            = "The number of values for this date property per interval, ordered by the start of the interval"
+	AggregatePropertyHistogramInterval    = "The length of a single interval of the histogram, intervals start at the beginning of the minute, hour, day, week (monday), month or year in UTC"
+	AggregatePropertyHistogramStart       = "The start of the interval as an RFC3339 formatted date"
+	AggregatePropertyHistogramCount       = "The number of values within the interval"
 )
 
 // Network
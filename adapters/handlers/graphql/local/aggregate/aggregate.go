@@ -252,7 +252,7 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeBoolean:
 		return makePropertyField(class, property, booleanPropertyFields)
 	case schema.DataTypeDate:
-		return makePropertyField(class, property, nonNumericPropertyFields)
+		return makePropertyField(class, property, datePropertyFields)
 	case schema.DataTypeCRef:
 		return makePropertyField(class, property, referencePropertyFields)
 	case schema.DataTypeGeoCoordinates:
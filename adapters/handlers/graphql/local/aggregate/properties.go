@@ -13,11 +13,13 @@ package aggregate
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 func numericPropertyFields(class *models.Class, property *models.Property, prefix string) *graphql.Object {
@@ -86,24 +88,170 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 	})
 }
 
-func nonNumericPropertyFields(class *models.Class,
+func datePropertyFields(class *models.Class,
 	property *models.Property, prefix string) *graphql.Object {
-	getMetaPointingFields := graphql.Fields{
+	getAggregateDateFields := graphql.Fields{
 		"count": &graphql.Field{
-			Name:        fmt.Sprintf("%s%sCount", prefix, class.Class),
-			Description: descriptions.AggregateCount,
+			Name:        fmt.Sprintf("%s%s%sCount", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyCount,
 			Type:        graphql.Int,
-			Resolve:     makeResolveNumericFieldAggregator("count"),
+			Resolve:     dateResolver(func(d aggregation.Date) interface{} { return d.Count }),
+		},
+		"minimum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMinimum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyMinimum,
+			Type:        graphql.String,
+			Resolve:     dateResolver(func(d aggregation.Date) interface{} { return formatDate(d, d.Minimum) }),
+		},
+		"maximum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMaximum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyMaximum,
+			Type:        graphql.String,
+			Resolve:     dateResolver(func(d aggregation.Date) interface{} { return formatDate(d, d.Maximum) }),
+		},
+		"type": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyType,
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				prop, ok := p.Source.(aggregation.Property)
+				if !ok {
+					return nil, fmt.Errorf("date type: expected aggregation.Property, got %T", p.Source)
+				}
+
+				return prop.SchemaType, nil
+			},
+		},
+		"histogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogram", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyHistogram,
+			Type:        graphql.NewList(dateHistogramBucket(class, property, prefix)),
+			Resolve: dateResolver(func(d aggregation.Date) interface{} {
+				list := make([]interface{}, len(d.Histogram))
+				for i, bucket := range d.Histogram {
+					list[i] = bucket
+				}
+
+				return list
+			}),
+			Args: graphql.FieldConfigArgument{
+				"interval": &graphql.ArgumentConfig{
+					Description:  descriptions.AggregatePropertyHistogramInterval,
+					Type:         dateHistogramInterval(class, property, prefix),
+					DefaultValue: traverser.DateIntervalDay,
+				},
+			},
 		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        fmt.Sprintf("%s%s%sObj", prefix, class.Class, property.Name),
-		Fields:      getMetaPointingFields,
+		Fields:      getAggregateDateFields,
 		Description: descriptions.AggregatePropertyObject,
 	})
 }
 
+func dateHistogramInterval(class *models.Class,
+	property *models.Property, prefix string) *graphql.Enum {
+	values := graphql.EnumValueConfigMap{}
+	for _, interval := range []string{
+		traverser.DateIntervalMinute, traverser.DateIntervalHour,
+		traverser.DateIntervalDay, traverser.DateIntervalWeek,
+		traverser.DateIntervalMonth, traverser.DateIntervalYear,
+	} {
+		values[interval] = &graphql.EnumValueConfig{Value: interval}
+	}
+
+	return graphql.NewEnum(graphql.EnumConfig{
+		Name:        fmt.Sprintf("%s%s%sHistogramIntervalEnum", prefix, class.Class, property.Name),
+		Values:      values,
+		Description: descriptions.AggregatePropertyHistogramInterval,
+	})
+}
+
+func dateHistogramBucket(class *models.Class,
+	property *models.Property, prefix string) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%s%sHistogramObj", prefix, class.Class, property.Name),
+		Fields: graphql.Fields{
+			"start": &graphql.Field{
+				Name:        fmt.Sprintf("%s%s%sHistogramStart", prefix, class.Class, property.Name),
+				Description: descriptions.AggregatePropertyHistogramStart,
+				Type:        graphql.String,
+				Resolve: dateHistogramBucketResolver(func(b aggregation.DateHistogramBucket) interface{} {
+					return b.Start.Format(time.RFC3339Nano)
+				}),
+			},
+			"count": &graphql.Field{
+				Name:        fmt.Sprintf("%s%s%sHistogramCount", prefix, class.Class, property.Name),
+				Description: descriptions.AggregatePropertyHistogramCount,
+				Type:        graphql.Int,
+				Resolve: dateHistogramBucketResolver(func(b aggregation.DateHistogramBucket) interface{} {
+					return b.Count
+				}),
+			},
+		},
+		Description: descriptions.AggregatePropertyHistogram,
+	})
+}
+
+// formatDate returns nil if there are no values, as the zero time would
+// otherwise look like an actual minimum or maximum
+func formatDate(agg aggregation.Date, date time.Time) interface{} {
+	if agg.Count == 0 {
+		return nil
+	}
+
+	return date.Format(time.RFC3339Nano)
+}
+
+type dateExtractorFunc func(aggregation.Date) interface{}
+
+func dateResolver(extractor dateExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		date, err := extractDateAggregation(p.Source)
+		if err != nil {
+			return nil, fmt.Errorf("date: %v", err)
+		}
+
+		return extractor(date), nil
+	}
+}
+
+func extractDateAggregation(source interface{}) (aggregation.Date, error) {
+	property, ok := source.(aggregation.Property)
+	if !ok {
+		return aggregation.Date{}, fmt.Errorf("expected aggregation.Property, got %T", source)
+	}
+
+	if property.Type == aggregation.PropertyTypeNumerical {
+		// in this case we can only use count
+		return aggregation.Date{
+			Count: int(property.NumericalAggregations["count"]),
+		}, nil
+	}
+
+	if property.Type != aggregation.PropertyTypeDate {
+		return aggregation.Date{}, fmt.Errorf("expected property to be of type date, got %s", property.Type)
+	}
+
+	return property.DateAggregation, nil
+}
+
+type dateHistogramBucketExtractorFunc func(aggregation.DateHistogramBucket) interface{}
+
+func dateHistogramBucketResolver(extractor dateHistogramBucketExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		bucket, ok := p.Source.(aggregation.DateHistogramBucket)
+		if !ok {
+			return nil, fmt.Errorf("histogram: %s: expected aggregation.DateHistogramBucket, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(bucket), nil
+	}
+}
+
 func referencePropertyFields(class *models.Class,
 	property *models.Property, prefix string) *graphql.Object {
 	getMetaPointingFields := graphql.Fields{
@@ -187,6 +187,12 @@ func extractAggregators(selections *ast.SelectionSet) ([]traverser.Aggregator, e
 			}
 		}
 
+		if property.Type == traverser.HistogramType {
+			if overwrite := extractIntervalFromArgs(field.Arguments); overwrite != "" {
+				property.Interval = overwrite
+			}
+		}
+
 		analyses = append(analyses, property)
 	}
 
@@ -247,3 +253,18 @@ func extractLimitFromArgs(args []*ast.Argument) *int {
 
 	return nil
 }
+
+func extractIntervalFromArgs(args []*ast.Argument) string {
+	for _, arg := range args {
+		if arg.Name.Value != "interval" {
+			continue
+		}
+
+		v, ok := arg.Value.GetValue().(string)
+		if ok {
+			return v
+		}
+	}
+
+	return ""
+}
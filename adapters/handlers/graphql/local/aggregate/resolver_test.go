@@ -13,6 +13,7 @@ package aggregate
 
 import (
 	"testing"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -387,6 +388,67 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "date prop with minimum, maximum and histogram",
+			query: `{ Aggregate { Things { Car { 
+				startOfProduction { count minimum maximum histogram(interval: year) { start count } } 
+				} } } } `,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name: "startOfProduction",
+					Aggregators: []traverser.Aggregator{
+						traverser.CountAggregator,
+						traverser.MinimumAggregator,
+						traverser.MaximumAggregator,
+						traverser.NewHistogramAggregator(traverser.DateIntervalYear),
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Count: 10,
+					Properties: map[string]aggregation.Property{
+						"startOfProduction": aggregation.Property{
+							SchemaType: "date",
+							Type:       aggregation.PropertyTypeDate,
+							DateAggregation: aggregation.Date{
+								Count:   3,
+								Minimum: time.Date(1994, 3, 1, 12, 0, 0, 0, time.UTC),
+								Maximum: time.Date(2017, 11, 30, 8, 30, 0, 0, time.UTC),
+								Histogram: []aggregation.DateHistogramBucket{
+									{Start: time.Date(1994, 1, 1, 0, 0, 0, 0, time.UTC), Count: 2},
+									{Start: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), Count: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			expectedGroupBy: nil,
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Things", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"startOfProduction": map[string]interface{}{
+							"count":   3,
+							"minimum": "1994-03-01T12:00:00Z",
+							"maximum": "2017-11-30T08:30:00Z",
+							"histogram": []interface{}{
+								map[string]interface{}{
+									"start": "1994-01-01T00:00:00Z",
+									"count": 2,
+								},
+								map[string]interface{}{
+									"start": "2017-01-01T00:00:00Z",
+									"count": 1,
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
 		testCase{
 			name:  "single prop: mean (with type)",
 			query: `{ Aggregate { Things { Car(groupBy:["madeBy", "Manufacturer", "name"]) { horsepower { mean type } } } } }`,
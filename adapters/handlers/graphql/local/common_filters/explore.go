@@ -18,11 +18,14 @@ import "github.com/semi-technologies/weaviate/usecases/traverser"
 func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 	var args traverser.ExploreParams
 
-	// keywords is a required argument, so we don't need to check for its existing
-	keywords := source["concepts"].([]interface{})
-	args.Values = make([]string, len(keywords))
-	for i, value := range keywords {
-		args.Values[i] = value.(string)
+	// concepts is only optional if a nearVector or nearObject is set instead,
+	// which is validated by the traverser
+	if keywords, ok := source["concepts"]; ok {
+		keywords := keywords.([]interface{})
+		args.Values = make([]string, len(keywords))
+		for i, value := range keywords {
+			args.Values[i] = value.(string)
+		}
 	}
 
 	// limit is an optional arg, so it could be nil
@@ -56,6 +59,54 @@ func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 		args.MoveAwayFrom = extractMovement(moveAwayFrom)
 	}
 
+	// nearVector is an optional arg, so it could be nil
+	nearVector, ok := source["nearVector"]
+	if ok {
+		p := ExtractNearVector(nearVector.(map[string]interface{}))
+		args.NearVector = &p
+	}
+
+	// nearObject is an optional arg, so it could be nil
+	nearObject, ok := source["nearObject"]
+	if ok {
+		p := ExtractNearObject(nearObject.(map[string]interface{}))
+		args.NearObject = &p
+	}
+
+	return args
+}
+
+// ExtractNearObject arguments, such as "id" and "certainty"
+func ExtractNearObject(source map[string]interface{}) traverser.NearObjectParams {
+	var args traverser.NearObjectParams
+
+	// id is a required argument, so we don't need to check for its existing
+	args.ID = source["id"].(string)
+
+	certainty, ok := source["certainty"]
+	if ok {
+		args.Certainty = certainty.(float64)
+	}
+
+	return args
+}
+
+// ExtractNearVector arguments, such as "vector" and "certainty"
+func ExtractNearVector(source map[string]interface{}) traverser.NearVectorParams {
+	var args traverser.NearVectorParams
+
+	// vector is a required argument, so we don't need to check for its existing
+	vector := source["vector"].([]interface{})
+	args.Vector = make([]float32, len(vector))
+	for i, value := range vector {
+		args.Vector[i] = float32(value.(float64))
+	}
+
+	certainty, ok := source["certainty"]
+	if ok {
+		args.Certainty = certainty.(float64)
+	}
+
 	return args
 }
 
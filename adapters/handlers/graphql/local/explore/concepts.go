@@ -33,7 +33,23 @@ func Build() *graphql.Field {
 			},
 			"concepts": &graphql.ArgumentConfig{
 				Description: descriptions.Keywords,
-				Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+				Type:        graphql.NewList(graphql.String),
+			},
+			"nearVector": &graphql.ArgumentConfig{
+				Description: descriptions.NearVector,
+				Type: graphql.NewInputObject(
+					graphql.InputObjectConfig{
+						Name:   "ExploreNearVector",
+						Fields: nearVectorInp(),
+					}),
+			},
+			"nearObject": &graphql.ArgumentConfig{
+				Description: descriptions.NearObject,
+				Type: graphql.NewInputObject(
+					graphql.InputObjectConfig{
+						Name:   "ExploreNearObject",
+						Fields: nearObjectInp(),
+					}),
 			},
 			"limit": &graphql.ArgumentConfig{
 				Type:        graphql.Int,
@@ -129,3 +145,29 @@ func movementInp() graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func nearVectorInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.NearVectorVector,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+func nearObjectInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.NearObjectID,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
@@ -68,6 +68,71 @@ func Test_ResolveExplore(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name: "Resolve Explore with nearVector",
+			query: `
+			{
+					Explore(nearVector: {vector: [0.1, -0.2, 0.3]}) {
+							beacon className certainty
+					}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				NearVector: &traverser.NearVectorParams{
+					Vector: []float32{0.1, -0.2, 0.3},
+				},
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+					Certainty: 0.7,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+						"certainty": float32(0.7),
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "Resolve Explore with nearObject",
+			query: `
+			{
+					Explore(nearObject: {id: "a9b2a0f5-8bf1-4a30-a0b6-0bbd4a1a4b1e", certainty: 0.7}) {
+							beacon className certainty
+					}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				NearObject: &traverser.NearObjectParams{
+					ID:        "a9b2a0f5-8bf1-4a30-a0b6-0bbd4a1a4b1e",
+					Certainty: 0.7,
+				},
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+					Certainty: 0.7,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+						"certainty": float32(0.7),
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "with optional limit and certainty set",
 			query: `
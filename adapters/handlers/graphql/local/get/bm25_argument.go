@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+func bm25Argument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.BM25,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sBM25InpObj", prefix),
+				Fields:      bm25Fields(),
+				Description: descriptions.BM25,
+			},
+		),
+	}
+}
+
+func bm25Fields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"query": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BM25Query,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"properties": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BM25Properties,
+			Type:        graphql.NewList(graphql.String),
+		},
+	}
+}
+
+func extractBM25(args map[string]interface{}) *traverser.KeywordRankingParams {
+	bm25, ok := args["bm25"]
+	if !ok {
+		return nil
+	}
+
+	asMap := bm25.(map[string]interface{}) // guaranteed by graphql
+	out := &traverser.KeywordRankingParams{
+		Type: "bm25",
+		// query is a required argument, so we don't need to check for its
+		// existence
+		Query: asMap["query"].(string),
+	}
+
+	if props, ok := asMap["properties"]; ok {
+		for _, prop := range props.([]interface{}) {
+			out.Properties = append(out.Properties, prop.(string))
+		}
+	}
+
+	return out
+}
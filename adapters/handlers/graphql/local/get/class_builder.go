@@ -160,6 +160,8 @@ func (b *classBuilder) underscoreFields(classProperties graphql.Fields, kindName
 	classProperties["_featureProjection"] = b.underscoreFeatureProjectionField(kindName, class)
 	classProperties["_semanticPath"] = b.underscoreSemanticPathField(kindName, class)
 	classProperties["_certainty"] = b.underscoreCertaintyField(kindName, class)
+	classProperties["_score"] = b.underscoreScoreField(kindName, class)
+	classProperties["_hybrid"] = b.underscoreHybridField(kindName, class)
 }
 
 func (b *classBuilder) underscoreClassificationField(kindName string, class *models.Class) *graphql.Field {
@@ -270,3 +272,24 @@ func (b *classBuilder) underscoreCertaintyField(kindName string, class *models.C
 		Type: graphql.Float,
 	}
 }
+
+func (b *classBuilder) underscoreScoreField(kindName string, class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Score,
+		Type:        graphql.Float,
+	}
+}
+
+func (b *classBuilder) underscoreHybridField(kindName string, class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.HybridScore,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sUnderscoreHybrid", class.Class),
+			Fields: graphql.Fields{
+				"score":        &graphql.Field{Type: graphql.Float},
+				"vectorScore":  &graphql.Field{Type: graphql.Float},
+				"keywordScore": &graphql.Field{Type: graphql.Float},
+			},
+		}),
+	}
+}
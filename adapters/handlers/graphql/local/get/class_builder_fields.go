@@ -172,9 +172,22 @@ func buildGetClassField(classObject *graphql.Object, k kind.Kind,
 				Description: descriptions.First,
 				Type:        graphql.Int,
 			},
-			"explore": exploreArgument(kindName, class.Class),
-			"where":   whereArgument(kindName, class.Class),
-			"group":   groupArgument(kindName, class.Class),
+			"offset": &graphql.ArgumentConfig{
+				Description: descriptions.Offset,
+				Type:        graphql.Int,
+			},
+			"after": &graphql.ArgumentConfig{
+				Description: descriptions.Cursor,
+				Type:        graphql.String,
+			},
+			"explore":    exploreArgument(kindName, class.Class),
+			"nearVector": nearVectorArgument(kindName, class.Class),
+			"nearObject": nearObjectArgument(kindName, class.Class),
+			"bm25":       bm25Argument(kindName, class.Class),
+			"hybrid":     hybridArgument(kindName, class.Class),
+			"where":      whereArgument(kindName, class.Class),
+			"group":      groupArgument(kindName, class.Class),
+			"sort":       sortArgument(kindName, class.Class),
 		},
 		Resolve: makeResolveGetClass(k, class.Class),
 	}
@@ -249,6 +262,11 @@ func makeResolveGetClass(k kind.Kind, className string) graphql.FieldResolveFn {
 			return nil, err
 		}
 
+		sort, err := filters.ExtractSortFromArgs(p.Args)
+		if err != nil {
+			return nil, err
+		}
+
 		// There can only be exactly one ast.Field; it is the class name.
 		if len(p.Info.FieldASTs) != 1 {
 			panic("Only one Field expected here")
@@ -271,15 +289,34 @@ func makeResolveGetClass(k kind.Kind, className string) graphql.FieldResolveFn {
 			exploreParams = &p
 		}
 
+		var nearVectorParams *traverser.NearVectorParams
+		if nearVector, ok := p.Args["nearVector"]; ok {
+			p := common_filters.ExtractNearVector(nearVector.(map[string]interface{}))
+			nearVectorParams = &p
+		}
+
+		var nearObjectParams *traverser.NearObjectParams
+		if nearObject, ok := p.Args["nearObject"]; ok {
+			p := common_filters.ExtractNearObject(nearObject.(map[string]interface{}))
+			nearObjectParams = &p
+		}
+
 		group := extractGroup(p.Args)
+		keywordRanking := extractBM25(p.Args)
+		hybrid := extractHybrid(p.Args)
 
 		params := traverser.GetParams{
 			Filters:              filters,
 			Kind:                 k,
 			ClassName:            className,
 			Pagination:           pagination,
+			Sort:                 sort,
 			Properties:           properties,
 			Explore:              exploreParams,
+			NearVector:           nearVectorParams,
+			NearObject:           nearObjectParams,
+			KeywordRanking:       keywordRanking,
+			Hybrid:               hybrid,
 			Group:                group,
 			UnderscoreProperties: underscore,
 		}
@@ -410,6 +447,10 @@ func extractProperties(selections *ast.SelectionSet, fragments map[string]ast.De
 				underscoreProps.FeatureProjection = parseFeatureProjectionArguments(field.Arguments)
 			case "_certainty":
 				underscoreProps.Certainty = true
+			case "_score":
+				underscoreProps.Score = true
+			case "_hybrid":
+				underscoreProps.Hybrid = true
 			}
 		} else {
 			properties = append(properties, property)
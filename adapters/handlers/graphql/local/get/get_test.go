@@ -639,6 +639,80 @@ func TestExploreRanker(t *testing.T) {
 	})
 }
 
+func TestNearVector(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	t.Run("for actions", func(t *testing.T) {
+		query := `{ Get { Actions { SomeAction(nearVector: {
+								vector: [0.1, -0.2, 3]
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &traverser.NearVectorParams{
+				Vector: []float32{0.1, -0.2, 3},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with optional certainty set", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(nearVector: {
+								vector: [0.1, -0.2, 3],
+								certainty: 0.4
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &traverser.NearVectorParams{
+				Vector:    []float32{0.1, -0.2, 3},
+				Certainty: 0.4,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestNearObject(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	t.Run("with optional certainty set", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(nearObject: {
+								id: "a9b2a0f5-8bf1-4a30-a0b6-0bbd4a1a4b1e",
+								certainty: 0.7
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearObject: &traverser.NearObjectParams{
+				ID:        "a9b2a0f5-8bf1-4a30-a0b6-0bbd4a1a4b1e",
+				Certainty: 0.7,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractPagination(t *testing.T) {
 	t.Parallel()
 
@@ -660,6 +734,170 @@ func TestExtractPagination(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractOffsetAndCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with an offset", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{
+				Limit:  10,
+				Offset: 20,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := "{ Get { Actions { SomeAction(limit: 10, offset: 20) { intField } } } }"
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with a cursor", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{
+				Limit: filters.LimitFlagNotSet,
+				After: "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(after: "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11") { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestExtractSort(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	expectedParams := traverser.GetParams{
+		Kind:       kind.Action,
+		ClassName:  "SomeAction",
+		Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Sort: []filters.Sort{
+			{Path: []string{"intField"}, Order: filters.SortOrderDesc},
+			{Path: []string{"name"}, Order: filters.SortOrderAsc},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ Get { Actions { SomeAction(sort: [{path: ["intField"], order: desc}, {path: ["name"]}]) { intField } } } }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractBM25(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with properties", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Type:       "bm25",
+				Query:      "quick fox",
+				Properties: []string{"name"},
+			},
+			UnderscoreProperties: traverser.UnderscoreProperties{
+				Score: true,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(bm25: {query: "quick fox", properties: ["name"]}) { intField _score } } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("without properties", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Type:  "bm25",
+				Query: "quick fox",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(bm25: {query: "quick fox"}) { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestExtractHybrid(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with all fields", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Hybrid: &traverser.HybridParams{
+				Query:      "quick fox",
+				Alpha:      0.3,
+				Properties: []string{"name"},
+				Vector:     []float32{0.1, 0.2},
+				Fusion:     traverser.HybridFusionRelativeScore,
+			},
+			UnderscoreProperties: traverser.UnderscoreProperties{
+				Hybrid: true,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(hybrid: {query: "quick fox", alpha: 0.3, properties: ["name"], vector: [0.1, 0.2], fusion: relativeScoreFusion}) { intField _hybrid { score vectorScore keywordScore } } } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with the default alpha", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Hybrid: &traverser.HybridParams{
+				Query: "quick fox",
+				Alpha: 0.75,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(hybrid: {query: "quick fox"}) { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractGroupParams(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+func hybridArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Hybrid,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sHybridInpObj", prefix),
+				Fields:      hybridFields(prefix),
+				Description: descriptions.Hybrid,
+			},
+		),
+	}
+}
+
+func hybridFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"query": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridQuery,
+			Type:        graphql.String,
+		},
+		"alpha": &graphql.InputObjectFieldConfig{
+			Description:  descriptions.HybridAlpha,
+			Type:         graphql.Float,
+			DefaultValue: 0.75,
+		},
+		"properties": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridProperties,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridVector,
+			Type:        graphql.NewList(graphql.Float),
+		},
+		"fusion": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridFusion,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sHybridInpObjFusionEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					traverser.HybridFusionRanked:        &graphql.EnumValueConfig{},
+					traverser.HybridFusionRelativeScore: &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+	}
+}
+
+func extractHybrid(args map[string]interface{}) *traverser.HybridParams {
+	hybrid, ok := args["hybrid"]
+	if !ok {
+		return nil
+	}
+
+	asMap := hybrid.(map[string]interface{}) // guaranteed by graphql
+	out := &traverser.HybridParams{
+		// alpha has a default value, so it is always set
+		Alpha: asMap["alpha"].(float64),
+	}
+
+	if query, ok := asMap["query"]; ok {
+		out.Query = query.(string)
+	}
+
+	if props, ok := asMap["properties"]; ok {
+		for _, prop := range props.([]interface{}) {
+			out.Properties = append(out.Properties, prop.(string))
+		}
+	}
+
+	if vector, ok := asMap["vector"]; ok {
+		for _, value := range vector.([]interface{}) {
+			out.Vector = append(out.Vector, float32(value.(float64)))
+		}
+	}
+
+	if fusion, ok := asMap["fusion"]; ok {
+		out.Fusion = fusion.(string)
+	}
+
+	return out
+}
@@ -33,15 +33,18 @@ import (
 	schemarepo "github.com/semi-technologies/weaviate/adapters/repos/schema"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/backup"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/semi-technologies/weaviate/usecases/nearestneighbors"
 	"github.com/semi-technologies/weaviate/usecases/network/common/peers"
 	"github.com/semi-technologies/weaviate/usecases/projector"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/semi-technologies/weaviate/usecases/sempath"
+	"github.com/semi-technologies/weaviate/usecases/shards"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus"
@@ -106,9 +109,15 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	var schemaRepo schemaUC.Repo
 	var classifierRepo classification.Repo
 
+	// backups and the status of shards are only supported in standalone mode
+	var backupDB backup.DB
+	var backupClassifications backup.ClassificationRepo
+	var shardsDB shards.DB
+
 	if appState.ServerConfig.Config.Standalone {
 		repo := db.New(appState.Logger, db.Config{
-			RootPath: appState.ServerConfig.Config.Persistence.DataPath,
+			RootPath:          appState.ServerConfig.Config.Persistence.DataPath,
+			PrometheusMetrics: appState.Metrics,
 		})
 		vectorMigrator = db.NewMigrator(repo, appState.Logger)
 		vectorRepo = repo
@@ -125,13 +134,18 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			os.Exit(1)
 		}
 
-		classifierRepo, err = classifications.NewRepo("./data", appState.Logger)
+		classificationsRepo, err := classifications.NewRepo("./data", appState.Logger)
 		if err != nil {
 			appState.Logger.
 				WithField("action", "startup").WithError(err).
 				Fatal("could not initialize classifications repo")
 			os.Exit(1)
 		}
+		classifierRepo = classificationsRepo
+
+		backupDB = repo
+		backupClassifications = classificationsRepo
+		shardsDB = repo
 	} else {
 		repo := esvector.NewRepo(esClient, appState.Logger, nil,
 			*appState.ServerConfig.Config.VectorIndex.NumberOfShards,     // guaranteed not to be nil as there are defaults
@@ -179,7 +193,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	kindsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorizer,
-		vectorRepo, explorer, schemaManager)
+		vectorRepo, explorer, schemaManager, appState.Metrics)
 
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
 		appState.Contextionary, appState.Logger)
@@ -204,6 +218,17 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupMiscHandlers(api, appState.ServerConfig, appState.Network, schemaManager, appState.Contextionary)
 	setupClassificationHandlers(api, classifier)
 
+	if backupDB != nil && appState.ServerConfig.Config.Backup.Enabled() {
+		backupManager := backup.NewManager(newBackupBackend(appState.ServerConfig.Config.Backup),
+			backupDB, schemaManager, backupClassifications, appState.Authorizer, appState.Logger)
+		setupBackupHandlers(api, backupManager)
+	}
+
+	if shardsDB != nil {
+		setupShardsHandlers(api, shards.NewManager(shardsDB, schemaManager,
+			appState.Authorizer))
+	}
+
 	api.ServerShutdown = func() {}
 	configureServer = makeConfigureServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
@@ -314,7 +339,12 @@ func startupRoutine() (*state.State, *clientv3.Client, *elasticsearch.Client) {
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("initialized stopword detector")
 
-	c11y, err := contextionary.NewClient(appState.ServerConfig.Config.Contextionary.URL)
+	if appState.ServerConfig.Config.Monitoring.Enabled {
+		appState.Metrics = monitoring.NewPrometheusMetrics()
+	}
+
+	c11y, err := contextionary.NewClient(appState.ServerConfig.Config.Contextionary.URL,
+		appState.Metrics)
 	if err != nil {
 		logger.WithField("action", "startup").
 			WithError(err).Error("cannot create c11y client")
@@ -145,6 +145,18 @@ func init() {
           {
             "$ref": "#/parameters/CommonLimitParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonMetaParameterQuery"
           },
@@ -723,6 +735,113 @@ func init() {
         ]
       }
     },
+    "/backups": {
+      "post": {
+        "description": "Copies the files of the specified classes, or of all classes if none are specified, to the configured backup storage. Writes to each class are paused while its files are copied. Only available in standalone mode.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Creates a backup.",
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "description": "the classes to back up and an optional id for the backup",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully created the backup.",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup request, for example an unknown class or an id which is already in use.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.create"
+        ]
+      }
+    },
+    "/backups/{id}/restore": {
+      "post": {
+        "description": "Recreates all classes of the backup from its files. None of the classes of the backup must exist. Only available in standalone mode.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Restores a backup.",
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "the id of the backup",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully restored the backup.",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist"
+          },
+          "422": {
+            "description": "The backup can't be restored, for example because one of its classes already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.restore"
+        ]
+      }
+    },
     "/batching/actions": {
       "post": {
         "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
@@ -802,6 +921,112 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.add"
         ]
+      },
+      "delete": {
+        "description": "Delete Actions in bulk that match a certain filter.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Deletes Actions based on a match filter as a batch.",
+        "operationId": "batching.actions.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Merge the same properties into all Actions that match a certain filter. Vectors are recomputed if a vectorized property changes.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Merges properties into Actions based on a match filter as a batch.",
+        "operationId": "batching.actions.merge",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchMerge"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchMergeResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
     "/batching/references": {
@@ -945,36 +1170,30 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.add"
         ]
-      }
-    },
-    "/c11y/concepts/{concept}": {
-      "get": {
-        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
+      },
+      "delete": {
+        "description": "Delete Things in bulk that match a certain filter.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "things"
         ],
-        "summary": "Checks if a concept is part of the contextionary.",
-        "operationId": "c11y.concepts",
+        "summary": "Deletes Things based on a match filter as a batch.",
+        "operationId": "batching.things.delete",
         "parameters": [
           {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "concept",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -986,44 +1205,156 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/c11y/corpus": {
-      "post": {
-        "description": "Analyzes a sentence based on the contextionary",
+      },
+      "patch": {
+        "description": "Merge the same properties into all Things that match a certain filter. Vectors are recomputed if a vectorized property changes.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "things"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.corpus.get",
+        "summary": "Merges properties into Things based on a match filter as a batch.",
+        "operationId": "batching.things.merge",
         "parameters": [
           {
-            "description": "A text corpus",
-            "name": "corpus",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "description": "The text corpus.",
-              "type": "object",
-              "properties": {
-                "corpus": {
-                  "type": "string",
-                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
-                }
-              }
+              "$ref": "#/definitions/BatchMerge"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchMergeResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/c11y/concepts/{concept}": {
+      "get": {
+        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Checks if a concept is part of the contextionary.",
+        "operationId": "c11y.concepts",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "CamelCase list of words to validate.",
+            "name": "concept",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.c11y.words.get"
+        ]
+      }
+    },
+    "/c11y/corpus": {
+      "post": {
+        "description": "Analyzes a sentence based on the contextionary",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.corpus.get",
+        "parameters": [
+          {
+            "description": "A text corpus",
+            "name": "corpus",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "The text corpus.",
+              "type": "object",
+              "properties": {
+                "corpus": {
+                  "type": "string",
+                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
+                }
+              }
             }
           }
         ],
@@ -1603,6 +1934,112 @@ func init() {
         ]
       }
     },
+    "/schema/actions/{className}/shards": {
+      "get": {
+        "description": "Lists the shards of an Action class together with their status, such as the number of vectors which are queued for asynchronous indexing. Only available in standalone mode.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the status of the shards of an Action class.",
+        "operationId": "schema.actions.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The status of every shard of the class.",
+            "schema": {
+              "$ref": "#/definitions/ClassShardsStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/actions/{className}/shards/{shardName}/vectorIndex": {
+      "get": {
+        "description": "Reports diagnostics of the vector index of a single shard of an Action class, such as the number of nodes, tombstones and the average degree per layer of the graph. Optionally checks whether every node can be reached from the entrypoint. Only available in standalone mode.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get diagnostics of the vector index of a shard of an Action class.",
+        "operationId": "schema.actions.shards.vectorIndex.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Traverse the graph from its entrypoint to find nodes which can never be returned by a search. This visits every node of the shard and temporarily needs about as much memory as the graph itself, so it should not be done routinely on large shards. Defaults to false.",
+            "name": "checkConnectivity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Diagnostics of the vector index of the shard.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexStats"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class or shard does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
     "/schema/things": {
       "post": {
         "tags": [
@@ -1757,36 +2194,27 @@ func init() {
         ]
       }
     },
-    "/things": {
+    "/schema/things/{className}/shards": {
       "get": {
-        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Lists the shards of a Thing class together with their status, such as the number of vectors which are queued for asynchronous indexing. Only available in standalone mode.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Get a list of Things.",
-        "operationId": "things.list",
+        "summary": "Get the status of the shards of a Thing class.",
+        "operationId": "schema.things.shards.get",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonMetaParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ThingsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The status of every shard of the class.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ClassShardsStatus"
             }
           },
           "401": {
@@ -1799,7 +2227,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "This class does not exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1808,33 +2236,160 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query.meta"
         ]
-      },
-      "post": {
-        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
+      }
+    },
+    "/schema/things/{className}/shards/{shardName}/vectorIndex": {
+      "get": {
+        "description": "Reports diagnostics of the vector index of a single shard of a Thing class, such as the number of nodes, tombstones and the average degree per layer of the graph. Optionally checks whether every node can be reached from the entrypoint. Only available in standalone mode.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Create a new Thing based on a Thing template.",
-        "operationId": "things.create",
+        "summary": "Get diagnostics of the vector index of a shard of a Thing class.",
+        "operationId": "schema.things.shards.vectorIndex.get",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Thing created.",
-            "schema": {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Traverse the graph from its entrypoint to find nodes which can never be returned by a search. This visits every node of the shard and temporarily needs about as much memory as the graph itself, so it should not be done routinely on large shards. Defaults to false.",
+            "name": "checkConnectivity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Diagnostics of the vector index of the shard.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexStats"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class or shard does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/things": {
+      "get": {
+        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a list of Things.",
+        "operationId": "things.list",
+        "parameters": [
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonMetaParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Create a new Thing based on a Thing template.",
+        "operationId": "things.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Thing created.",
+            "schema": {
               "$ref": "#/definitions/Thing"
             }
           },
@@ -2369,7 +2924,7 @@ func init() {
           "$ref": "#/definitions/NearestNeighbors"
         },
         "_vector": {
-          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "description": "This object's position in the vector space. Can be set on import to use your own vector instead of the vectorizer's. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
           "$ref": "#/definitions/C11yVector"
         },
         "class": {
@@ -2466,6 +3021,255 @@ func init() {
         }
       }
     },
+    "Backup": {
+      "description": "A backup of the data of one or more classes in standalone mode.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "names of the classes in this backup. If empty when creating a backup, all classes are backed up.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this backup. Generated if not set. May only contain letters, digits, '_', '-' and '.'.",
+          "type": "string",
+          "example": "2020-06-01-nightly"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Result of a batch delete.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with errors are described, successfully deleted objects are omitted. With output set to \"verbose\" all matched objects are listed with their respective status.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              },
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchMerge": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be merged yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be merged.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be merged.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be merged.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "schema": {
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "BatchMergeResponse": {
+      "description": "Result of a batch merge.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be merged yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be merged.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be merged.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be merged.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been merged but could not be merged.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with errors are described, successfully merged objects are omitted. With output set to \"verbose\" all matched objects are listed with their respective status.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              },
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects were successfully merged.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
     "BatchReference": {
       "properties": {
         "from": {
@@ -2671,20 +3475,49 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "shardCount": {
+          "description": "Number of shards the data of this class is split into. Objects are assigned to a shard based on their id. Defaults to 1, at most 64 shards are allowed. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexConfig": {
+          "$ref": "#/definitions/VectorIndexConfig"
+        },
         "vectorizeClassName": {
           "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
           "type": "boolean",
           "x-nullable": true
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors of this class' objects are created. 'text2vec-contextionary' (default) builds them from the object's properties using the contextionary. 'none' means the contextionary is never contacted for this class, so every object has to be imported with its own vector. Cannot be changed once the class has been created.",
+          "type": "string"
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
+    "ClassShardsStatus": {
+      "description": "The status of all shards of a class.",
       "type": "object",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The status of every shard of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShardStatus"
+          }
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
           "items": {
             "type": "string"
           },
@@ -3298,6 +4131,36 @@ func init() {
         }
       }
     },
+    "ProductQuantizationConfig": {
+      "description": "Settings for compressing the vectors held in memory by the vector index with product quantization. Compressed vectors use much less memory, but distances computed on them are approximations.",
+      "type": "object",
+      "properties": {
+        "centroids": {
+          "description": "Number of centroids in the codebook of each segment. At most 256. Defaults to 256. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "enabled": {
+          "description": "Compress the vectors of this class. Can be changed on a live class. The codebooks are trained as soon as the class contains trainingLimit objects.",
+          "type": "boolean"
+        },
+        "rescoreLimit": {
+          "description": "Number of the best candidates of a search whose distance is recalculated with the uncompressed vectors before the results are cut to the requested limit. 0 (default) disables rescoring. Can be changed on a live class.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segments": {
+          "description": "Number of segments each vector is split into. Every segment is stored as a single byte, so this is the size of a compressed vector. Must divide the number of dimensions. Defaults to a quarter of the dimensions. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "trainingLimit": {
+          "description": "Number of vectors sampled to train the codebooks. Compression starts once the class contains this many objects. Defaults to 100000. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "Property": {
       "type": "object",
       "properties": {
@@ -3329,6 +4192,9 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "textAnalyzer": {
+          "$ref": "#/definitions/TextAnalyzerConfig"
+        },
         "vectorizePropertyName": {
           "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
           "type": "boolean"
@@ -3435,6 +4301,28 @@ func init() {
         }
       }
     },
+    "ShardStatus": {
+      "description": "The status of a single shard of a class.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Name of the shard, unique within its class.",
+          "type": "string"
+        },
+        "vectorQueueFailures": {
+          "description": "Number of queued vectors whose last insert into the vector index failed. They stay queued and are retried with a backoff.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "Number of objects whose vectors are queued, but not yet inserted into the vector index. Always 0 unless the class indexes vectors asynchronously.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "SingleRef": {
       "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
@@ -3467,6 +4355,46 @@ func init() {
         }
       }
     },
+    "TextAnalyzerConfig": {
+      "description": "Settings for how the values of a text or string property are split into terms for the inverted index. The same analysis is applied to the values of filters and keyword searches on the property. Cannot be changed once the property has been created.",
+      "type": "object",
+      "properties": {
+        "additionalStopwords": {
+          "description": "Stopwords which are removed in addition to the ones of the stopwords preset.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lowercase": {
+          "description": "Lowercase all terms. Defaults to true for text and to false for string properties.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "ngramMaxLength": {
+          "description": "Maximum length of the n-grams of the 'ngram' tokenizer. Defaults to 3.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ngramMinLength": {
+          "description": "Minimum length of the n-grams of the 'ngram' tokenizer. Shorter words are kept as they are. Defaults to 3.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stemmer": {
+          "description": "Reduce all terms to their stem with a Snowball stemmer. One of 'none' (default), 'english' or 'german'.",
+          "type": "string"
+        },
+        "stopwords": {
+          "description": "Preset list of stopwords which are removed from the terms. One of 'none' (default), 'english' or 'german'.",
+          "type": "string"
+        },
+        "tokenizer": {
+          "description": "How values are split into terms. One of 'word' (split on everything that is not a letter or a digit, default for text), 'whitespace' (split on whitespace, default for string), 'field' (the whole value is a single term) or 'ngram' (character n-grams of the words).",
+          "type": "string"
+        }
+      }
+    },
     "Thing": {
       "type": "object",
       "properties": {
@@ -3487,7 +4415,7 @@ func init() {
           "$ref": "#/definitions/NearestNeighbors"
         },
         "_vector": {
-          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "description": "This object's position in the vector space. Can be set on import to use your own vector instead of the vectorizer's. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
           "$ref": "#/definitions/C11yVector"
         },
         "class": {
@@ -3649,6 +4577,190 @@ func init() {
         }
       }
     },
+    "VectorIndexCommitLog": {
+      "description": "A single commit log file of a vector index.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Name of the file, the unix time stamp at which it was started.",
+          "type": "string"
+        },
+        "sizeBytes": {
+          "description": "Size of the file in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorIndexConfig": {
+      "description": "Settings of the vector index of a class.",
+      "type": "object",
+      "properties": {
+        "asyncIndexing": {
+          "description": "If true, vectors are not inserted into the vector index as part of the write. Instead they are queued on disk and inserted by background workers, which decouples import throughput from graph insertion. Objects are searchable immediately, queued vectors are compared by brute force until they have been indexed. Defaults to false. Cannot be changed once the class has been created.",
+          "type": "boolean"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Interval in seconds in which deleted objects are cleaned up from the vector index. Defaults to 300. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "dimensions": {
+          "description": "Number of dimensions every vector of this class must have. Vectors with a different length are rejected on import. Required for classes with vectorizer 'none'. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "distance": {
+          "description": "The distance metric used to compare vectors. One of 'cosine' (default), 'dot', 'l2-squared', 'manhattan' or 'hamming'. The certainty of a result is derived from its distance: (1 + cosine similarity) / 2 for 'cosine', the logistic function of the dot product for 'dot', and 1 / (1 + distance) for all other metrics. Cannot be changed once the class has been created.",
+          "type": "string"
+        },
+        "ef": {
+          "description": "Size of the dynamic candidate list used at query time. Higher values increase recall at the cost of latency. If not set, it is derived from the requested limit. Can be changed on a live class.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "efConstruction": {
+          "description": "Size of the dynamic candidate list used when inserting into the vector index. Higher values lead to a better graph at the cost of slower imports. Defaults to 128. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxConnections": {
+          "description": "Maximum number of connections per node in the vector index. Defaults to 60. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "pq": {
+          "$ref": "#/definitions/ProductQuantizationConfig"
+        },
+        "type": {
+          "description": "The kind of vector index. One of 'hnsw' (default), which builds an approximate nearest neighbor graph, or 'flat', which compares the search vector to every vector of the shard. 'flat' returns exact results without the memory and disk overhead of a graph and is best suited for small classes or classes which are mostly searched with restrictive filters. 'flat' keeps at most 'vectorCacheMaxObjects' vectors in memory, all other vectors are read from disk on every search. Settings specific to hnsw, such as 'maxConnections' or 'efConstruction', are ignored by 'flat', product quantization is not supported. Cannot be changed once the class has been created.",
+          "type": "string"
+        },
+        "vectorCacheMaxObjects": {
+          "description": "Maximum number of vectors held in the in-memory vector cache. Defaults to 50000. Can be changed on a live class.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorIndexConnectivity": {
+      "description": "The result of traversing an hnsw graph from its entrypoint. Nodes which cannot be reached are never returned by a search, so a growing number of them silently degrades recall. Deleted nodes which have not been cleaned up yet are neither counted as reachable nor as unreachable.",
+      "type": "object",
+      "properties": {
+        "reachable": {
+          "description": "Number of nodes which can be reached from the entrypoint.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "unreachable": {
+          "description": "Number of nodes which cannot be reached from the entrypoint.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "unreachableDocIds": {
+          "description": "Doc IDs of the first 100 unreachable nodes, ordered ascending.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        }
+      }
+    },
+    "VectorIndexLayerStats": {
+      "description": "Size and degree of a single layer of an hnsw graph.",
+      "type": "object",
+      "properties": {
+        "averageDegree": {
+          "description": "Average number of outgoing connections of the nodes on this layer.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "level": {
+          "description": "The layer, 0 is the bottom layer which contains every node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "nodes": {
+          "description": "Number of nodes on this layer.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorIndexStats": {
+      "description": "Diagnostics of the vector index of a single shard.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "commitLogs": {
+          "description": "The commit logs the graph is restored from on startup, ordered from old to new.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexCommitLog"
+          }
+        },
+        "connectivity": {
+          "$ref": "#/definitions/VectorIndexConnectivity"
+        },
+        "entryPoint": {
+          "description": "Doc ID of the node every search starts at. Not set if the index is empty.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "layers": {
+          "description": "Size and degree of every layer of the graph, ordered from layer 0 to the highest layer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexLayerStats"
+          }
+        },
+        "maxLayer": {
+          "description": "The highest layer of the graph.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodes": {
+          "description": "Number of nodes in the index. For 'hnsw' this includes deleted nodes which have not been cleaned up yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "tombstones": {
+          "description": "Number of deleted nodes which are waiting to be cleaned up. Searches still traverse them, so a large backlog slows down queries.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "description": "The kind of vector index, 'hnsw' or 'flat'. Apart from the number of nodes, all other diagnostics are only reported for 'hnsw'.",
+          "type": "string"
+        },
+        "vectorCacheCount": {
+          "description": "Number of vectors currently held in the in-memory vector cache.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorCacheMaxObjects": {
+          "description": "Maximum number of vectors held in the in-memory vector cache. The cache is emptied once it is full.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -3761,6 +4873,13 @@ func init() {
     }
   },
   "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "format": "uuid",
+      "description": "Only return items with an id greater than the specified one. Items are ordered by their id, so the id of the last item of a page can be used as a cursor for the next page. Cannot be combined with offset.",
+      "name": "after",
+      "in": "query"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
@@ -3779,6 +4898,25 @@ func init() {
       "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
       "name": "meta",
       "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The number of items to skip before the first returned item. Defaults to 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order of the sort, either 'asc' or 'desc', separated by commas with one entry per property of the sort parameter. Defaults to 'asc'.",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Name(s) of the properties to sort the items by, separated by commas, e.g. 'price,name'. Subsequent properties are only used to break ties. Cannot be combined with after.",
+      "name": "sort",
+      "in": "query"
     }
   },
   "securityDefinitions": {
@@ -3822,6 +4960,10 @@ func init() {
     {
       "description": "These operations enable manipulation of the schema in Weaviate schema.",
       "name": "schema"
+    },
+    {
+      "description": "These operations create and restore backups of classes in standalone mode.",
+      "name": "backups"
     }
   ],
   "externalDocs": {
@@ -3949,6 +5091,32 @@ func init() {
             "name": "limit",
             "in": "query"
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of items to skip before the first returned item. Defaults to 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return items with an id greater than the specified one. Items are ordered by their id, so the id of the last item of a page can be used as a cursor for the next page. Cannot be combined with offset.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name(s) of the properties to sort the items by, separated by commas, e.g. 'price,name'. Subsequent properties are only used to break ties. Cannot be combined with after.",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order of the sort, either 'asc' or 'desc', separated by commas with one entry per property of the sort parameter. Defaults to 'asc'.",
+            "name": "order",
+            "in": "query"
+          },
           {
             "type": "boolean",
             "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
@@ -4539,56 +5707,30 @@ func init() {
         ]
       }
     },
-    "/batching/actions": {
+    "/backups": {
       "post": {
-        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
+        "description": "Copies the files of the specified classes, or of all classes if none are specified, to the configured backup storage. Writes to each class are paused while its files are copied. Only available in standalone mode.",
         "tags": [
-          "batching",
-          "actions"
+          "backups"
         ],
-        "summary": "Creates new Actions based on an Action template as a batch.",
-        "operationId": "batching.actions.create",
+        "summary": "Creates a backup.",
+        "operationId": "backups.create",
         "parameters": [
           {
+            "description": "the classes to back up and an optional id for the backup",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Action"
-                  }
-                },
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                }
-              }
+              "$ref": "#/definitions/Backup"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successfully created the backup.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ActionsGetResponse"
-              }
+              "$ref": "#/definitions/Backup"
             }
           },
           "401": {
@@ -4601,7 +5743,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup request, for example an unknown class or an id which is already in use.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4613,44 +5755,417 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.backups.create"
         ]
       }
     },
-    "/batching/references": {
+    "/backups/{id}/restore": {
       "post": {
-        "description": "Register cross-references between any class items (things or actions) in bulk.",
+        "description": "Recreates all classes of the backup from its files. None of the classes of the backup must exist. Only available in standalone mode.",
         "tags": [
-          "batching",
-          "references"
+          "backups"
+        ],
+        "summary": "Restores a backup.",
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "the id of the backup",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully restored the backup.",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist"
+          },
+          "422": {
+            "description": "The backup can't be restored, for example because one of its classes already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.restore"
+        ]
+      }
+    },
+    "/batching/actions": {
+      "post": {
+        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Creates new Actions based on an Action template as a batch.",
+        "operationId": "batching.actions.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "actions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Action"
+                  }
+                },
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Actions in bulk that match a certain filter.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Deletes Actions based on a match filter as a batch.",
+        "operationId": "batching.actions.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Merge the same properties into all Actions that match a certain filter. Vectors are recomputed if a vectorized property changes.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Merges properties into Actions based on a match filter as a batch.",
+        "operationId": "batching.actions.merge",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchMerge"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchMergeResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/batching/references": {
+      "post": {
+        "description": "Register cross-references between any class items (things or actions) in bulk.",
+        "tags": [
+          "batching",
+          "references"
         ],
         "summary": "Creates new Cross-References between arbitrary classes in bulk.",
         "operationId": "batching.references.create",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/batching/things": {
+      "post": {
+        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batching",
+          "things"
+        ],
+        "summary": "Creates new Things based on a Thing template as a batch.",
+        "operationId": "batching.things.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Things in bulk that match a certain filter.",
+        "tags": [
+          "batching",
+          "things"
+        ],
+        "summary": "Deletes Things based on a match filter as a batch.",
+        "operationId": "batching.things.delete",
+        "parameters": [
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/BatchDelete"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -4678,49 +6193,24 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/batching/things": {
-      "post": {
-        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+      },
+      "patch": {
+        "description": "Merge the same properties into all Things that match a certain filter. Vectors are recomputed if a vectorized property changes.",
         "tags": [
           "batching",
           "things"
         ],
-        "summary": "Creates new Things based on a Thing template as a batch.",
-        "operationId": "batching.things.create",
+        "summary": "Merges properties into Things based on a match filter as a batch.",
+        "operationId": "batching.things.merge",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "things": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Thing"
-                  }
-                }
-              }
+              "$ref": "#/definitions/BatchMerge"
             }
           }
         ],
@@ -4728,10 +6218,7 @@ func init() {
           "200": {
             "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ThingsGetResponse"
-              }
+              "$ref": "#/definitions/BatchMergeResponse"
             }
           },
           "401": {
@@ -4759,7 +6246,7 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
       }
     },
@@ -5419,6 +6906,112 @@ func init() {
         ]
       }
     },
+    "/schema/actions/{className}/shards": {
+      "get": {
+        "description": "Lists the shards of an Action class together with their status, such as the number of vectors which are queued for asynchronous indexing. Only available in standalone mode.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the status of the shards of an Action class.",
+        "operationId": "schema.actions.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The status of every shard of the class.",
+            "schema": {
+              "$ref": "#/definitions/ClassShardsStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/actions/{className}/shards/{shardName}/vectorIndex": {
+      "get": {
+        "description": "Reports diagnostics of the vector index of a single shard of an Action class, such as the number of nodes, tombstones and the average degree per layer of the graph. Optionally checks whether every node can be reached from the entrypoint. Only available in standalone mode.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get diagnostics of the vector index of a shard of an Action class.",
+        "operationId": "schema.actions.shards.vectorIndex.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Traverse the graph from its entrypoint to find nodes which can never be returned by a search. This visits every node of the shard and temporarily needs about as much memory as the graph itself, so it should not be done routinely on large shards. Defaults to false.",
+            "name": "checkConnectivity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Diagnostics of the vector index of the shard.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexStats"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class or shard does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
     "/schema/things": {
       "post": {
         "tags": [
@@ -5573,6 +7166,112 @@ func init() {
         ]
       }
     },
+    "/schema/things/{className}/shards": {
+      "get": {
+        "description": "Lists the shards of a Thing class together with their status, such as the number of vectors which are queued for asynchronous indexing. Only available in standalone mode.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the status of the shards of a Thing class.",
+        "operationId": "schema.things.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The status of every shard of the class.",
+            "schema": {
+              "$ref": "#/definitions/ClassShardsStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/things/{className}/shards/{shardName}/vectorIndex": {
+      "get": {
+        "description": "Reports diagnostics of the vector index of a single shard of a Thing class, such as the number of nodes, tombstones and the average degree per layer of the graph. Optionally checks whether every node can be reached from the entrypoint. Only available in standalone mode.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get diagnostics of the vector index of a shard of a Thing class.",
+        "operationId": "schema.things.shards.vectorIndex.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Traverse the graph from its entrypoint to find nodes which can never be returned by a search. This visits every node of the shard and temporarily needs about as much memory as the graph itself, so it should not be done routinely on large shards. Defaults to false.",
+            "name": "checkConnectivity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Diagnostics of the vector index of the shard.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexStats"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class or shard does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
     "/things": {
       "get": {
         "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
@@ -5589,6 +7288,32 @@ func init() {
             "name": "limit",
             "in": "query"
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of items to skip before the first returned item. Defaults to 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return items with an id greater than the specified one. Items are ordered by their id, so the id of the last item of a page can be used as a cursor for the next page. Cannot be combined with offset.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name(s) of the properties to sort the items by, separated by commas, e.g. 'price,name'. Subsequent properties are only used to break ties. Cannot be combined with after.",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order of the sort, either 'asc' or 'desc', separated by commas with one entry per property of the sort parameter. Defaults to 'asc'.",
+            "name": "order",
+            "in": "query"
+          },
           {
             "type": "boolean",
             "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
@@ -6201,7 +7926,7 @@ func init() {
           "$ref": "#/definitions/NearestNeighbors"
         },
         "_vector": {
-          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "description": "This object's position in the vector space. Can be set on import to use your own vector instead of the vectorizer's. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
           "$ref": "#/definitions/C11yVector"
         },
         "class": {
@@ -6250,71 +7975,448 @@ func init() {
             }
           }
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Action.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Action.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ActionsGetResponseAO2Result": {
+      "description": "Results for this specific Action.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "PENDING",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "ActionsListResponse": {
+      "description": "List of Actions.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actual list of Actions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Action"
+          }
+        },
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of Actions for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Backup": {
+      "description": "A backup of the data of one or more classes in standalone mode.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "names of the classes in this backup. If empty when creating a backup, all classes are backed up.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this backup. Generated if not set. May only contain letters, digits, '_', '-' and '.'.",
+          "type": "string",
+          "example": "2020-06-01-nightly"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteMatch": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Result of a batch delete.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with errors are described, successfully deleted objects are omitted. With output set to \"verbose\" all matched objects are listed with their respective status.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+              },
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchDeleteResponseMatch": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponseResults": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "How many objects should have been deleted but could not be deleted.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "With output set to \"minimal\" only objects with errors are described, successfully deleted objects are omitted. With output set to \"verbose\" all matched objects are listed with their respective status.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+          },
+          "x-omitempty": false
+        },
+        "successful": {
+          "description": "How many objects were successfully deleted.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BatchDeleteResponseResultsObjectsItems0": {
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
+        }
+      },
+      "type": "object"
+    },
+    "BatchMerge": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be merged yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be merged.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be merged.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be merged.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "schema": {
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "BatchMergeMatch": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be merged.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be merged.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchMergeResponse": {
+      "description": "Result of a batch merge.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be merged yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be merged.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be merged.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be merged.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been merged but could not be merged.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with errors are described, successfully merged objects are omitted. With output set to \"verbose\" all matched objects are listed with their respective status.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchMergeResponseResultsObjectsItems0"
+              },
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects were successfully merged.",
+              "type": "integer",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchMergeResponseMatch": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be merged.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be merged.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchMergeResponseResults": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "How many objects should have been merged but could not be merged.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "With output set to \"minimal\" only objects with errors are described, successfully merged objects are omitted. With output set to \"verbose\" all matched objects are listed with their respective status.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchMergeResponseResultsObjectsItems0"
+          },
+          "x-omitempty": false
+        },
+        "successful": {
+          "description": "How many objects were successfully merged.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
-      ]
+      }
     },
-    "ActionsGetResponseAO2Result": {
-      "description": "Results for this specific Action.",
+    "BatchMergeResponseResultsObjectsItems0": {
       "format": "object",
       "properties": {
         "errors": {
           "$ref": "#/definitions/ErrorResponse"
         },
+        "id": {
+          "description": "ID of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
         "status": {
           "type": "string",
           "default": "SUCCESS",
           "enum": [
             "SUCCESS",
-            "PENDING",
+            "DRYRUN",
             "FAILED"
           ]
         }
-      }
-    },
-    "ActionsListResponse": {
-      "description": "List of Actions.",
-      "type": "object",
-      "properties": {
-        "actions": {
-          "description": "The actual list of Actions.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Action"
-          }
-        },
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
-        },
-        "totalResults": {
-          "description": "The total number of Actions for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
+      },
+      "type": "object"
     },
     "BatchReference": {
       "properties": {
@@ -6583,10 +8685,39 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "shardCount": {
+          "description": "Number of shards the data of this class is split into. Objects are assigned to a shard based on their id. Defaults to 1, at most 64 shards are allowed. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexConfig": {
+          "$ref": "#/definitions/VectorIndexConfig"
+        },
         "vectorizeClassName": {
           "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
           "type": "boolean",
           "x-nullable": true
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors of this class' objects are created. 'text2vec-contextionary' (default) builds them from the object's properties using the contextionary. 'none' means the contextionary is never contacted for this class, so every object has to be imported with its own vector. Cannot be changed once the class has been created.",
+          "type": "string"
+        }
+      }
+    },
+    "ClassShardsStatus": {
+      "description": "The status of all shards of a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The status of every shard of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShardStatus"
+          }
         }
       }
     },
@@ -7219,6 +9350,36 @@ func init() {
         }
       }
     },
+    "ProductQuantizationConfig": {
+      "description": "Settings for compressing the vectors held in memory by the vector index with product quantization. Compressed vectors use much less memory, but distances computed on them are approximations.",
+      "type": "object",
+      "properties": {
+        "centroids": {
+          "description": "Number of centroids in the codebook of each segment. At most 256. Defaults to 256. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "enabled": {
+          "description": "Compress the vectors of this class. Can be changed on a live class. The codebooks are trained as soon as the class contains trainingLimit objects.",
+          "type": "boolean"
+        },
+        "rescoreLimit": {
+          "description": "Number of the best candidates of a search whose distance is recalculated with the uncompressed vectors before the results are cut to the requested limit. 0 (default) disables rescoring. Can be changed on a live class.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segments": {
+          "description": "Number of segments each vector is split into. Every segment is stored as a single byte, so this is the size of a compressed vector. Must divide the number of dimensions. Defaults to a quarter of the dimensions. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "trainingLimit": {
+          "description": "Number of vectors sampled to train the codebooks. Compression starts once the class contains this many objects. Defaults to 100000. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "Property": {
       "type": "object",
       "properties": {
@@ -7250,6 +9411,9 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "textAnalyzer": {
+          "$ref": "#/definitions/TextAnalyzerConfig"
+        },
         "vectorizePropertyName": {
           "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
           "type": "boolean"
@@ -7356,6 +9520,28 @@ func init() {
         }
       }
     },
+    "ShardStatus": {
+      "description": "The status of a single shard of a class.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Name of the shard, unique within its class.",
+          "type": "string"
+        },
+        "vectorQueueFailures": {
+          "description": "Number of queued vectors whose last insert into the vector index failed. They stay queued and are retried with a backoff.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "Number of objects whose vectors are queued, but not yet inserted into the vector index. Always 0 unless the class indexes vectors asynchronously.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "SingleRef": {
       "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
@@ -7388,6 +9574,46 @@ func init() {
         }
       }
     },
+    "TextAnalyzerConfig": {
+      "description": "Settings for how the values of a text or string property are split into terms for the inverted index. The same analysis is applied to the values of filters and keyword searches on the property. Cannot be changed once the property has been created.",
+      "type": "object",
+      "properties": {
+        "additionalStopwords": {
+          "description": "Stopwords which are removed in addition to the ones of the stopwords preset.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lowercase": {
+          "description": "Lowercase all terms. Defaults to true for text and to false for string properties.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "ngramMaxLength": {
+          "description": "Maximum length of the n-grams of the 'ngram' tokenizer. Defaults to 3.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ngramMinLength": {
+          "description": "Minimum length of the n-grams of the 'ngram' tokenizer. Shorter words are kept as they are. Defaults to 3.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stemmer": {
+          "description": "Reduce all terms to their stem with a Snowball stemmer. One of 'none' (default), 'english' or 'german'.",
+          "type": "string"
+        },
+        "stopwords": {
+          "description": "Preset list of stopwords which are removed from the terms. One of 'none' (default), 'english' or 'german'.",
+          "type": "string"
+        },
+        "tokenizer": {
+          "description": "How values are split into terms. One of 'word' (split on everything that is not a letter or a digit, default for text), 'whitespace' (split on whitespace, default for string), 'field' (the whole value is a single term) or 'ngram' (character n-grams of the words).",
+          "type": "string"
+        }
+      }
+    },
     "Thing": {
       "type": "object",
       "properties": {
@@ -7408,7 +9634,7 @@ func init() {
           "$ref": "#/definitions/NearestNeighbors"
         },
         "_vector": {
-          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "description": "This object's position in the vector space. Can be set on import to use your own vector instead of the vectorizer's. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
           "$ref": "#/definitions/C11yVector"
         },
         "class": {
@@ -7588,6 +9814,190 @@ func init() {
         }
       }
     },
+    "VectorIndexCommitLog": {
+      "description": "A single commit log file of a vector index.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Name of the file, the unix time stamp at which it was started.",
+          "type": "string"
+        },
+        "sizeBytes": {
+          "description": "Size of the file in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorIndexConfig": {
+      "description": "Settings of the vector index of a class.",
+      "type": "object",
+      "properties": {
+        "asyncIndexing": {
+          "description": "If true, vectors are not inserted into the vector index as part of the write. Instead they are queued on disk and inserted by background workers, which decouples import throughput from graph insertion. Objects are searchable immediately, queued vectors are compared by brute force until they have been indexed. Defaults to false. Cannot be changed once the class has been created.",
+          "type": "boolean"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Interval in seconds in which deleted objects are cleaned up from the vector index. Defaults to 300. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "dimensions": {
+          "description": "Number of dimensions every vector of this class must have. Vectors with a different length are rejected on import. Required for classes with vectorizer 'none'. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "distance": {
+          "description": "The distance metric used to compare vectors. One of 'cosine' (default), 'dot', 'l2-squared', 'manhattan' or 'hamming'. The certainty of a result is derived from its distance: (1 + cosine similarity) / 2 for 'cosine', the logistic function of the dot product for 'dot', and 1 / (1 + distance) for all other metrics. Cannot be changed once the class has been created.",
+          "type": "string"
+        },
+        "ef": {
+          "description": "Size of the dynamic candidate list used at query time. Higher values increase recall at the cost of latency. If not set, it is derived from the requested limit. Can be changed on a live class.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "efConstruction": {
+          "description": "Size of the dynamic candidate list used when inserting into the vector index. Higher values lead to a better graph at the cost of slower imports. Defaults to 128. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxConnections": {
+          "description": "Maximum number of connections per node in the vector index. Defaults to 60. Cannot be changed once the class has been created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "pq": {
+          "$ref": "#/definitions/ProductQuantizationConfig"
+        },
+        "type": {
+          "description": "The kind of vector index. One of 'hnsw' (default), which builds an approximate nearest neighbor graph, or 'flat', which compares the search vector to every vector of the shard. 'flat' returns exact results without the memory and disk overhead of a graph and is best suited for small classes or classes which are mostly searched with restrictive filters. 'flat' keeps at most 'vectorCacheMaxObjects' vectors in memory, all other vectors are read from disk on every search. Settings specific to hnsw, such as 'maxConnections' or 'efConstruction', are ignored by 'flat', product quantization is not supported. Cannot be changed once the class has been created.",
+          "type": "string"
+        },
+        "vectorCacheMaxObjects": {
+          "description": "Maximum number of vectors held in the in-memory vector cache. Defaults to 50000. Can be changed on a live class.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorIndexConnectivity": {
+      "description": "The result of traversing an hnsw graph from its entrypoint. Nodes which cannot be reached are never returned by a search, so a growing number of them silently degrades recall. Deleted nodes which have not been cleaned up yet are neither counted as reachable nor as unreachable.",
+      "type": "object",
+      "properties": {
+        "reachable": {
+          "description": "Number of nodes which can be reached from the entrypoint.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "unreachable": {
+          "description": "Number of nodes which cannot be reached from the entrypoint.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "unreachableDocIds": {
+          "description": "Doc IDs of the first 100 unreachable nodes, ordered ascending.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        }
+      }
+    },
+    "VectorIndexLayerStats": {
+      "description": "Size and degree of a single layer of an hnsw graph.",
+      "type": "object",
+      "properties": {
+        "averageDegree": {
+          "description": "Average number of outgoing connections of the nodes on this layer.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "level": {
+          "description": "The layer, 0 is the bottom layer which contains every node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "nodes": {
+          "description": "Number of nodes on this layer.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorIndexStats": {
+      "description": "Diagnostics of the vector index of a single shard.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "commitLogs": {
+          "description": "The commit logs the graph is restored from on startup, ordered from old to new.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexCommitLog"
+          }
+        },
+        "connectivity": {
+          "$ref": "#/definitions/VectorIndexConnectivity"
+        },
+        "entryPoint": {
+          "description": "Doc ID of the node every search starts at. Not set if the index is empty.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "layers": {
+          "description": "Size and degree of every layer of the graph, ordered from layer 0 to the highest layer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexLayerStats"
+          }
+        },
+        "maxLayer": {
+          "description": "The highest layer of the graph.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodes": {
+          "description": "Number of nodes in the index. For 'hnsw' this includes deleted nodes which have not been cleaned up yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "tombstones": {
+          "description": "Number of deleted nodes which are waiting to be cleaned up. Searches still traverse them, so a large backlog slows down queries.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "description": "The kind of vector index, 'hnsw' or 'flat'. Apart from the number of nodes, all other diagnostics are only reported for 'hnsw'.",
+          "type": "string"
+        },
+        "vectorCacheCount": {
+          "description": "Number of vectors currently held in the in-memory vector cache.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorCacheMaxObjects": {
+          "description": "Maximum number of vectors held in the in-memory vector cache. The cache is emptied once it is full.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -7709,6 +10119,13 @@ func init() {
     }
   },
   "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "format": "uuid",
+      "description": "Only return items with an id greater than the specified one. Items are ordered by their id, so the id of the last item of a page can be used as a cursor for the next page. Cannot be combined with offset.",
+      "name": "after",
+      "in": "query"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
@@ -7727,6 +10144,25 @@ func init() {
       "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
       "name": "meta",
       "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The number of items to skip before the first returned item. Defaults to 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order of the sort, either 'asc' or 'desc', separated by commas with one entry per property of the sort parameter. Defaults to 'asc'.",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Name(s) of the properties to sort the items by, separated by commas, e.g. 'price,name'. Subsequent properties are only used to break ties. Cannot be combined with after.",
+      "name": "sort",
+      "in": "query"
     }
   },
   "securityDefinitions": {
@@ -7770,6 +10206,10 @@ func init() {
     {
       "description": "These operations enable manipulation of the schema in Weaviate schema.",
       "name": "schema"
+    },
+    {
+      "description": "These operations create and restore backups of classes in standalone mode.",
+      "name": "backups"
     }
   ],
   "externalDocs": {
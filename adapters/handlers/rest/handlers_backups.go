@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/backups"
+	backuprepos "github.com/semi-technologies/weaviate/adapters/repos/backups"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+func setupBackupHandlers(api *operations.WeaviateAPI, manager *backup.Manager) {
+	api.BackupsBackupsCreateHandler = backups.BackupsCreateHandlerFunc(
+		func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Backup(params.HTTPRequest.Context(), principal, *params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return backups.NewBackupsCreateForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case backup.ErrInvalidUserInput:
+					return backups.NewBackupsCreateUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsCreateInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsCreateOK().WithPayload(res)
+		},
+	)
+
+	api.BackupsBackupsRestoreHandler = backups.BackupsRestoreHandlerFunc(
+		func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Restore(params.HTTPRequest.Context(), principal, params.ID)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return backups.NewBackupsRestoreForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case backup.ErrNotFound:
+					return backups.NewBackupsRestoreNotFound()
+				case backup.ErrInvalidUserInput:
+					return backups.NewBackupsRestoreUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsRestoreInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsRestoreOK().WithPayload(res)
+		},
+	)
+}
+
+func newBackupBackend(cfg config.Backup) backup.Backend {
+	if cfg.S3.Endpoint != "" {
+		return backuprepos.NewS3(cfg.S3)
+	}
+
+	return backuprepos.NewFilesystem(cfg.Path)
+}
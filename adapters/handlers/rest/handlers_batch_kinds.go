@@ -157,6 +157,194 @@ func (h *batchKindHandlers) referencesResponse(input kinds.BatchReferences) []*m
 	return response
 }
 
+func (h *batchKindHandlers) deleteThings(params batching.BatchingThingsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.DeleteThings(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingThingsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingThingsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingThingsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batching.NewBatchingThingsDeleteOK().
+		WithPayload(h.deleteResponse(res))
+}
+
+func (h *batchKindHandlers) deleteActions(params batching.BatchingActionsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.DeleteActions(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingActionsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingActionsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingActionsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batching.NewBatchingActionsDeleteOK().
+		WithPayload(h.deleteResponse(res))
+}
+
+func (h *batchKindHandlers) deleteResponse(input *kinds.BatchDeleteResponse) *models.BatchDeleteResponse {
+	var successful, failed int64
+	objects := []*models.BatchDeleteResponseResultsObjectsItems0{}
+	for _, obj := range input.Result.Objects {
+		var errorResponse *models.ErrorResponse
+
+		status := models.BatchDeleteResponseResultsObjectsItems0StatusSUCCESS
+		if input.Params.DryRun {
+			status = models.BatchDeleteResponseResultsObjectsItems0StatusDRYRUN
+		} else if obj.Err != nil {
+			errorResponse = errPayloadFromSingleErr(obj.Err)
+			status = models.BatchDeleteResponseResultsObjectsItems0StatusFAILED
+			failed++
+		} else {
+			successful++
+		}
+
+		if input.Output == kinds.BatchOutputMinimal &&
+			status != models.BatchDeleteResponseResultsObjectsItems0StatusFAILED {
+			continue
+		}
+
+		objects = append(objects, &models.BatchDeleteResponseResultsObjectsItems0{
+			Errors: errorResponse,
+			ID:     obj.UUID,
+			Status: &status,
+		})
+	}
+
+	dryRun := input.Params.DryRun
+	output := input.Output
+	var match *models.BatchDeleteResponseMatch
+	if input.Match != nil {
+		match = &models.BatchDeleteResponseMatch{
+			Class: input.Match.Class,
+			Where: input.Match.Where,
+		}
+	}
+
+	return &models.BatchDeleteResponse{
+		DryRun: &dryRun,
+		Match:  match,
+		Output: &output,
+		Results: &models.BatchDeleteResponseResults{
+			Failed:     failed,
+			Matches:    input.Result.Matches,
+			Objects:    objects,
+			Successful: successful,
+		},
+	}
+}
+
+func (h *batchKindHandlers) mergeThings(params batching.BatchingThingsMergeParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.MergeThings(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingThingsMergeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingThingsMergeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingThingsMergeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batching.NewBatchingThingsMergeOK().
+		WithPayload(h.mergeResponse(res))
+}
+
+func (h *batchKindHandlers) mergeActions(params batching.BatchingActionsMergeParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.MergeActions(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingActionsMergeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingActionsMergeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingActionsMergeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batching.NewBatchingActionsMergeOK().
+		WithPayload(h.mergeResponse(res))
+}
+
+func (h *batchKindHandlers) mergeResponse(input *kinds.BatchMergeResponse) *models.BatchMergeResponse {
+	var successful, failed int64
+	objects := []*models.BatchMergeResponseResultsObjectsItems0{}
+	for _, obj := range input.Result.Objects {
+		var errorResponse *models.ErrorResponse
+
+		status := models.BatchMergeResponseResultsObjectsItems0StatusSUCCESS
+		if input.DryRun {
+			status = models.BatchMergeResponseResultsObjectsItems0StatusDRYRUN
+		} else if obj.Err != nil {
+			errorResponse = errPayloadFromSingleErr(obj.Err)
+			status = models.BatchMergeResponseResultsObjectsItems0StatusFAILED
+			failed++
+		} else {
+			successful++
+		}
+
+		if input.Output == kinds.BatchOutputMinimal &&
+			status != models.BatchMergeResponseResultsObjectsItems0StatusFAILED {
+			continue
+		}
+
+		objects = append(objects, &models.BatchMergeResponseResultsObjectsItems0{
+			Errors: errorResponse,
+			ID:     obj.UUID,
+			Status: &status,
+		})
+	}
+
+	dryRun := input.DryRun
+	output := input.Output
+	var match *models.BatchMergeResponseMatch
+	if input.Match != nil {
+		match = &models.BatchMergeResponseMatch{
+			Class: input.Match.Class,
+			Where: input.Match.Where,
+		}
+	}
+
+	return &models.BatchMergeResponse{
+		DryRun: &dryRun,
+		Match:  match,
+		Output: &output,
+		Results: &models.BatchMergeResponseResults{
+			Failed:     failed,
+			Matches:    input.Result.Matches,
+			Objects:    objects,
+			Successful: successful,
+		},
+	}
+}
+
 func setupKindBatchHandlers(api *operations.WeaviateAPI, manager *kinds.BatchManager) {
 	h := &batchKindHandlers{manager}
 
@@ -166,4 +354,12 @@ func setupKindBatchHandlers(api *operations.WeaviateAPI, manager *kinds.BatchMan
 		BatchingActionsCreateHandlerFunc(h.addActions)
 	api.BatchingBatchingReferencesCreateHandler = batching.
 		BatchingReferencesCreateHandlerFunc(h.addReferences)
+	api.BatchingBatchingThingsDeleteHandler = batching.
+		BatchingThingsDeleteHandlerFunc(h.deleteThings)
+	api.BatchingBatchingActionsDeleteHandler = batching.
+		BatchingActionsDeleteHandlerFunc(h.deleteActions)
+	api.BatchingBatchingThingsMergeHandler = batching.
+		BatchingThingsMergeHandlerFunc(h.mergeThings)
+	api.BatchingBatchingActionsMergeHandler = batching.
+		BatchingActionsMergeHandlerFunc(h.mergeActions)
 }
@@ -45,8 +45,8 @@ type kindsManager interface {
 	ValidateAction(context.Context, *models.Principal, *models.Action) error
 	GetThing(context.Context, *models.Principal, strfmt.UUID, traverser.UnderscoreProperties) (*models.Thing, error)
 	GetAction(context.Context, *models.Principal, strfmt.UUID, traverser.UnderscoreProperties) (*models.Action, error)
-	GetThings(context.Context, *models.Principal, *int64, traverser.UnderscoreProperties) ([]*models.Thing, error)
-	GetActions(context.Context, *models.Principal, *int64, traverser.UnderscoreProperties) ([]*models.Action, error)
+	GetThings(context.Context, *models.Principal, *int64, *int64, *strfmt.UUID, *string, *string, traverser.UnderscoreProperties) ([]*models.Thing, error)
+	GetActions(context.Context, *models.Principal, *int64, *int64, *strfmt.UUID, *string, *string, traverser.UnderscoreProperties) ([]*models.Action, error)
 	UpdateThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing) (*models.Thing, error)
 	UpdateAction(context.Context, *models.Principal, strfmt.UUID, *models.Action) (*models.Action, error)
 	MergeThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing) error
@@ -243,12 +243,16 @@ func (h *kindHandlers) getThings(params things.ThingsListParams,
 		underscores.Vector = true
 	}
 
-	list, err := h.manager.GetThings(params.HTTPRequest.Context(), principal, params.Limit, underscores)
+	list, err := h.manager.GetThings(params.HTTPRequest.Context(), principal,
+		params.Limit, params.Offset, params.After, params.Sort, params.Order, underscores)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return things.NewThingsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return things.NewThingsListBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return things.NewThingsListInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -288,12 +292,16 @@ func (h *kindHandlers) getActions(params actions.ActionsListParams,
 		underscores.RefMeta = true
 		underscores.Vector = true
 	}
-	list, err := h.manager.GetActions(params.HTTPRequest.Context(), principal, params.Limit, underscores)
+	list, err := h.manager.GetActions(params.HTTPRequest.Context(), principal,
+		params.Limit, params.Offset, params.After, params.Sort, params.Order, underscores)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return actions.NewActionsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return actions.NewActionsListBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return actions.NewActionsListInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
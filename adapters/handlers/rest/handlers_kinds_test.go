@@ -687,11 +687,11 @@ func (f *fakeManager) GetAction(_ context.Context, _ *models.Principal, _ strfmt
 	return f.getActionReturn, nil
 }
 
-func (f *fakeManager) GetThings(_ context.Context, _ *models.Principal, _ *int64, _ traverser.UnderscoreProperties) ([]*models.Thing, error) {
+func (f *fakeManager) GetThings(_ context.Context, _ *models.Principal, _ *int64, _ *int64, _ *strfmt.UUID, _ *string, _ *string, _ traverser.UnderscoreProperties) ([]*models.Thing, error) {
 	return f.getThingsReturn, nil
 }
 
-func (f *fakeManager) GetActions(_ context.Context, _ *models.Principal, _ *int64, _ traverser.UnderscoreProperties) ([]*models.Action, error) {
+func (f *fakeManager) GetActions(_ context.Context, _ *models.Principal, _ *int64, _ *int64, _ *strfmt.UUID, _ *string, _ *string, _ traverser.UnderscoreProperties) ([]*models.Action, error) {
 	return f.getActionsReturn, nil
 }
 
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/shards"
+)
+
+func setupShardsHandlers(api *operations.WeaviateAPI, manager *shards.Manager) {
+	api.SchemaSchemaThingsShardsGetHandler = schema.SchemaThingsShardsGetHandlerFunc(
+		func(params schema.SchemaThingsShardsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.GetThingShards(params.HTTPRequest.Context(), principal, params.ClassName)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaThingsShardsGetForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case shards.ErrNotFound:
+					return schema.NewSchemaThingsShardsGetNotFound()
+				default:
+					return schema.NewSchemaThingsShardsGetInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaThingsShardsGetOK().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaActionsShardsGetHandler = schema.SchemaActionsShardsGetHandlerFunc(
+		func(params schema.SchemaActionsShardsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.GetActionShards(params.HTTPRequest.Context(), principal, params.ClassName)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaActionsShardsGetForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case shards.ErrNotFound:
+					return schema.NewSchemaActionsShardsGetNotFound()
+				default:
+					return schema.NewSchemaActionsShardsGetInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaActionsShardsGetOK().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaThingsShardsVectorIndexGetHandler = schema.SchemaThingsShardsVectorIndexGetHandlerFunc(
+		func(params schema.SchemaThingsShardsVectorIndexGetParams, principal *models.Principal) middleware.Responder {
+			checkConnectivity := params.CheckConnectivity != nil && *params.CheckConnectivity
+			res, err := manager.GetThingShardVectorIndex(params.HTTPRequest.Context(), principal,
+				params.ClassName, params.ShardName, checkConnectivity)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaThingsShardsVectorIndexGetForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case shards.ErrNotFound:
+					return schema.NewSchemaThingsShardsVectorIndexGetNotFound()
+				default:
+					return schema.NewSchemaThingsShardsVectorIndexGetInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaThingsShardsVectorIndexGetOK().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaActionsShardsVectorIndexGetHandler = schema.SchemaActionsShardsVectorIndexGetHandlerFunc(
+		func(params schema.SchemaActionsShardsVectorIndexGetParams, principal *models.Principal) middleware.Responder {
+			checkConnectivity := params.CheckConnectivity != nil && *params.CheckConnectivity
+			res, err := manager.GetActionShardVectorIndex(params.HTTPRequest.Context(), principal,
+				params.ClassName, params.ShardName, checkConnectivity)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaActionsShardsVectorIndexGetForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case shards.ErrNotFound:
+					return schema.NewSchemaActionsShardsVectorIndexGetNotFound()
+				default:
+					return schema.NewSchemaActionsShardsVectorIndexGetInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaActionsShardsVectorIndexGetOK().WithPayload(res)
+		},
+	)
+}
@@ -13,10 +13,15 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/sirupsen/logrus"
 )
 
@@ -27,6 +32,7 @@ import (
 // to some resources which are not exposed
 func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
+		handler = makeAddRequestMetrics(appState.Metrics)(handler)
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.String() == "/v1/.well-known/openid-configuration" {
 				handler.ServeHTTP(w, r)
@@ -37,6 +43,61 @@ func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler
 	}
 }
 
+// makeAddRequestMetrics records the duration of every request by the id of
+// the swagger operation, therefore it must run after routing
+func makeAddRequestMetrics(metrics *monitoring.PrometheusMetrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if metrics == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			operation := "unknown"
+			if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+				operation = route.Operation.ID
+			}
+
+			before := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			metrics.RESTRequestDuration.
+				WithLabelValues(operation, strconv.Itoa(rec.status)).
+				Observe(time.Since(before).Seconds())
+		})
+	}
+}
+
+// statusRecorder remembers the status code, so it can be used as a label
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// makeAddMetricsEndpoint serves the collected metrics for scraping by
+// prometheus, if monitoring is turned on
+func makeAddMetricsEndpoint(metrics *monitoring.PrometheusMetrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if metrics == nil {
+			return next
+		}
+
+		metricsHandler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/metrics" {
+				metricsHandler.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func addHandleRoot(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.String() == "/" {
@@ -65,6 +126,7 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		handler = makeAddLogging(appState.Logger)(handler)
 		handler = addPreflight(handler)
 		handler = addLiveAndReadyness(handler)
+		handler = makeAddMetricsEndpoint(appState.Metrics)(handler)
 		handler = addHandleRoot(handler)
 
 		return handler
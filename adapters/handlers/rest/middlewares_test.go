@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddlewares(t *testing.T) {
+	metrics := monitoring.NewPrometheusMetrics()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler := makeAddMetricsEndpoint(metrics)(makeAddRequestMetrics(metrics)(next))
+
+	t.Run("a regular request is passed on and recorded", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/things", nil))
+
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.RESTRequestDuration))
+	})
+
+	t.Run("the metrics are served for scraping", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.True(t, strings.Contains(body,
+			`rest_request_duration_seconds_count{operation="unknown",status="418"} 1`))
+	})
+
+	t.Run("without monitoring there is no endpoint", func(t *testing.T) {
+		handler := makeAddMetricsEndpoint(nil)(makeAddRequestMetrics(nil)(next))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+	})
+}
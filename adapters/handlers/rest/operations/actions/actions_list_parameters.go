@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewActionsListParams creates a new ActionsListParams object
@@ -42,6 +43,10 @@ type ActionsListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only return items with an id greater than the specified one. Items are ordered by their id, so the id of the last item of a page can be used as a cursor for the next page. Cannot be combined with offset.
+	  In: query
+	*/
+	After *strfmt.UUID
 	/*Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation
 	  In: query
 	*/
@@ -54,6 +59,18 @@ type ActionsListParams struct {
 	  In: query
 	*/
 	Meta *bool
+	/*The number of items to skip before the first returned item. Defaults to 0.
+	  In: query
+	*/
+	Offset *int64
+	/*Order of the sort, either 'asc' or 'desc', separated by commas with one entry per property of the sort parameter. Defaults to 'asc'.
+	  In: query
+	*/
+	Order *string
+	/*Name(s) of the properties to sort the items by, separated by commas, e.g. 'price,name'. Subsequent properties are only used to break ties. Cannot be combined with after.
+	  In: query
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -67,6 +84,11 @@ func (o *ActionsListParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAfter, qhkAfter, _ := qs.GetOK("after")
+	if err := o.bindAfter(qAfter, qhkAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qInclude, qhkInclude, _ := qs.GetOK("include")
 	if err := o.bindInclude(qInclude, qhkInclude, route.Formats); err != nil {
 		res = append(res, err)
@@ -82,12 +104,63 @@ func (o *ActionsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAfter binds and validates parameter After from query.
+func (o *ActionsListParams) bindAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("after", "query", "strfmt.UUID", raw)
+	}
+	o.After = (value.(*strfmt.UUID))
+
+	if err := o.validateAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAfter carries on validations for parameter After
+func (o *ActionsListParams) validateAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("after", "query", "uuid", o.After.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
 // bindInclude binds and validates parameter Include from query.
 func (o *ActionsListParams) bindInclude(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -149,3 +222,61 @@ func (o *ActionsListParams) bindMeta(rawData []string, hasKey bool, formats strf
 
 	return nil
 }
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *ActionsListParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *ActionsListParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Order = &raw
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *ActionsListParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Sort = &raw
+
+	return nil
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/contextionary_api"
@@ -98,15 +99,33 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ActionsActionsValidateHandler: actions.ActionsValidateHandlerFunc(func(params actions.ActionsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation actions.ActionsValidate has not yet been implemented")
 		}),
+		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
+		}),
+		BackupsBackupsRestoreHandler: backups.BackupsRestoreHandlerFunc(func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsRestore has not yet been implemented")
+		}),
 		BatchingBatchingActionsCreateHandler: batching.BatchingActionsCreateHandlerFunc(func(params batching.BatchingActionsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batching.BatchingActionsCreate has not yet been implemented")
 		}),
+		BatchingBatchingActionsDeleteHandler: batching.BatchingActionsDeleteHandlerFunc(func(params batching.BatchingActionsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingActionsDelete has not yet been implemented")
+		}),
+		BatchingBatchingActionsMergeHandler: batching.BatchingActionsMergeHandlerFunc(func(params batching.BatchingActionsMergeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingActionsMerge has not yet been implemented")
+		}),
 		BatchingBatchingReferencesCreateHandler: batching.BatchingReferencesCreateHandlerFunc(func(params batching.BatchingReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batching.BatchingReferencesCreate has not yet been implemented")
 		}),
 		BatchingBatchingThingsCreateHandler: batching.BatchingThingsCreateHandlerFunc(func(params batching.BatchingThingsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batching.BatchingThingsCreate has not yet been implemented")
 		}),
+		BatchingBatchingThingsDeleteHandler: batching.BatchingThingsDeleteHandlerFunc(func(params batching.BatchingThingsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingThingsDelete has not yet been implemented")
+		}),
+		BatchingBatchingThingsMergeHandler: batching.BatchingThingsMergeHandlerFunc(func(params batching.BatchingThingsMergeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingThingsMerge has not yet been implemented")
+		}),
 		ContextionaryAPIC11yConceptsHandler: contextionary_api.C11yConceptsHandlerFunc(func(params contextionary_api.C11yConceptsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation contextionary_api.C11yConcepts has not yet been implemented")
 		}),
@@ -143,6 +162,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaActionsPropertiesAddHandler: schema.SchemaActionsPropertiesAddHandlerFunc(func(params schema.SchemaActionsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaActionsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaActionsShardsGetHandler: schema.SchemaActionsShardsGetHandlerFunc(func(params schema.SchemaActionsShardsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaActionsShardsGet has not yet been implemented")
+		}),
+		SchemaSchemaActionsShardsVectorIndexGetHandler: schema.SchemaActionsShardsVectorIndexGetHandlerFunc(func(params schema.SchemaActionsShardsVectorIndexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaActionsShardsVectorIndexGet has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
@@ -155,6 +180,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaThingsPropertiesAddHandler: schema.SchemaThingsPropertiesAddHandlerFunc(func(params schema.SchemaThingsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaThingsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaThingsShardsGetHandler: schema.SchemaThingsShardsGetHandlerFunc(func(params schema.SchemaThingsShardsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaThingsShardsGet has not yet been implemented")
+		}),
+		SchemaSchemaThingsShardsVectorIndexGetHandler: schema.SchemaThingsShardsVectorIndexGetHandlerFunc(func(params schema.SchemaThingsShardsVectorIndexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaThingsShardsVectorIndexGet has not yet been implemented")
+		}),
 		ThingsThingsCreateHandler: things.ThingsCreateHandlerFunc(func(params things.ThingsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation things.ThingsCreate has not yet been implemented")
 		}),
@@ -265,12 +296,24 @@ type WeaviateAPI struct {
 	ActionsActionsUpdateHandler actions.ActionsUpdateHandler
 	// ActionsActionsValidateHandler sets the operation handler for the actions validate operation
 	ActionsActionsValidateHandler actions.ActionsValidateHandler
+	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
+	BackupsBackupsCreateHandler backups.BackupsCreateHandler
+	// BackupsBackupsRestoreHandler sets the operation handler for the backups restore operation
+	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BatchingBatchingActionsCreateHandler sets the operation handler for the batching actions create operation
 	BatchingBatchingActionsCreateHandler batching.BatchingActionsCreateHandler
+	// BatchingBatchingActionsDeleteHandler sets the operation handler for the batching actions delete operation
+	BatchingBatchingActionsDeleteHandler batching.BatchingActionsDeleteHandler
+	// BatchingBatchingActionsMergeHandler sets the operation handler for the batching actions merge operation
+	BatchingBatchingActionsMergeHandler batching.BatchingActionsMergeHandler
 	// BatchingBatchingReferencesCreateHandler sets the operation handler for the batching references create operation
 	BatchingBatchingReferencesCreateHandler batching.BatchingReferencesCreateHandler
 	// BatchingBatchingThingsCreateHandler sets the operation handler for the batching things create operation
 	BatchingBatchingThingsCreateHandler batching.BatchingThingsCreateHandler
+	// BatchingBatchingThingsDeleteHandler sets the operation handler for the batching things delete operation
+	BatchingBatchingThingsDeleteHandler batching.BatchingThingsDeleteHandler
+	// BatchingBatchingThingsMergeHandler sets the operation handler for the batching things merge operation
+	BatchingBatchingThingsMergeHandler batching.BatchingThingsMergeHandler
 	// ContextionaryAPIC11yConceptsHandler sets the operation handler for the c11y concepts operation
 	ContextionaryAPIC11yConceptsHandler contextionary_api.C11yConceptsHandler
 	// ContextionaryAPIC11yCorpusGetHandler sets the operation handler for the c11y corpus get operation
@@ -295,6 +338,10 @@ type WeaviateAPI struct {
 	SchemaSchemaActionsDeleteHandler schema.SchemaActionsDeleteHandler
 	// SchemaSchemaActionsPropertiesAddHandler sets the operation handler for the schema actions properties add operation
 	SchemaSchemaActionsPropertiesAddHandler schema.SchemaActionsPropertiesAddHandler
+	// SchemaSchemaActionsShardsGetHandler sets the operation handler for the schema actions shards get operation
+	SchemaSchemaActionsShardsGetHandler schema.SchemaActionsShardsGetHandler
+	// SchemaSchemaActionsShardsVectorIndexGetHandler sets the operation handler for the schema actions shards vector index get operation
+	SchemaSchemaActionsShardsVectorIndexGetHandler schema.SchemaActionsShardsVectorIndexGetHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
 	// SchemaSchemaThingsCreateHandler sets the operation handler for the schema things create operation
@@ -303,6 +350,10 @@ type WeaviateAPI struct {
 	SchemaSchemaThingsDeleteHandler schema.SchemaThingsDeleteHandler
 	// SchemaSchemaThingsPropertiesAddHandler sets the operation handler for the schema things properties add operation
 	SchemaSchemaThingsPropertiesAddHandler schema.SchemaThingsPropertiesAddHandler
+	// SchemaSchemaThingsShardsGetHandler sets the operation handler for the schema things shards get operation
+	SchemaSchemaThingsShardsGetHandler schema.SchemaThingsShardsGetHandler
+	// SchemaSchemaThingsShardsVectorIndexGetHandler sets the operation handler for the schema things shards vector index get operation
+	SchemaSchemaThingsShardsVectorIndexGetHandler schema.SchemaThingsShardsVectorIndexGetHandler
 	// ThingsThingsCreateHandler sets the operation handler for the things create operation
 	ThingsThingsCreateHandler things.ThingsCreateHandler
 	// ThingsThingsDeleteHandler sets the operation handler for the things delete operation
@@ -435,15 +486,33 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ActionsActionsValidateHandler == nil {
 		unregistered = append(unregistered, "actions.ActionsValidateHandler")
 	}
+	if o.BackupsBackupsCreateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsCreateHandler")
+	}
+	if o.BackupsBackupsRestoreHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsRestoreHandler")
+	}
 	if o.BatchingBatchingActionsCreateHandler == nil {
 		unregistered = append(unregistered, "batching.BatchingActionsCreateHandler")
 	}
+	if o.BatchingBatchingActionsDeleteHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingActionsDeleteHandler")
+	}
+	if o.BatchingBatchingActionsMergeHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingActionsMergeHandler")
+	}
 	if o.BatchingBatchingReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batching.BatchingReferencesCreateHandler")
 	}
 	if o.BatchingBatchingThingsCreateHandler == nil {
 		unregistered = append(unregistered, "batching.BatchingThingsCreateHandler")
 	}
+	if o.BatchingBatchingThingsDeleteHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingThingsDeleteHandler")
+	}
+	if o.BatchingBatchingThingsMergeHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingThingsMergeHandler")
+	}
 	if o.ContextionaryAPIC11yConceptsHandler == nil {
 		unregistered = append(unregistered, "contextionary_api.C11yConceptsHandler")
 	}
@@ -480,6 +549,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaActionsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaActionsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaActionsShardsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsShardsGetHandler")
+	}
+	if o.SchemaSchemaActionsShardsVectorIndexGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsShardsVectorIndexGetHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
@@ -492,6 +567,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaThingsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaThingsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaThingsShardsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsShardsGetHandler")
+	}
+	if o.SchemaSchemaThingsShardsVectorIndexGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsShardsVectorIndexGetHandler")
+	}
 	if o.ThingsThingsCreateHandler == nil {
 		unregistered = append(unregistered, "things.ThingsCreateHandler")
 	}
@@ -678,7 +759,23 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/backups"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/backups/{id}/restore"] = backups.NewBackupsRestore(o.context, o.BackupsBackupsRestoreHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batching/actions"] = batching.NewBatchingActionsCreate(o.context, o.BatchingBatchingActionsCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/batching/actions"] = batching.NewBatchingActionsDelete(o.context, o.BatchingBatchingActionsDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batching/actions"] = batching.NewBatchingActionsMerge(o.context, o.BatchingBatchingActionsMergeHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -687,6 +784,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/batching/things"] = batching.NewBatchingThingsCreate(o.context, o.BatchingBatchingThingsCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/batching/things"] = batching.NewBatchingThingsDelete(o.context, o.BatchingBatchingThingsDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batching/things"] = batching.NewBatchingThingsMerge(o.context, o.BatchingBatchingThingsMergeHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -738,6 +843,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/schema/actions/{className}/shards"] = schema.NewSchemaActionsShardsGet(o.context, o.SchemaSchemaActionsShardsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/actions/{className}/shards/{shardName}/vectorIndex"] = schema.NewSchemaActionsShardsVectorIndexGet(o.context, o.SchemaSchemaActionsShardsVectorIndexGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/schema"] = schema.NewSchemaDump(o.context, o.SchemaSchemaDumpHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -751,6 +864,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/things/{className}/properties"] = schema.NewSchemaThingsPropertiesAdd(o.context, o.SchemaSchemaThingsPropertiesAddHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/things/{className}/shards"] = schema.NewSchemaThingsShardsGet(o.context, o.SchemaSchemaThingsShardsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/things/{className}/shards/{shardName}/vectorIndex"] = schema.NewSchemaThingsShardsVectorIndexGet(o.context, o.SchemaSchemaThingsShardsVectorIndexGetHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package backups contains the backends which store backups of standalone
+// mode, see usecases/backup
+package backups
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+)
+
+// Filesystem stores backups in a directory on the local filesystem, for
+// example a mounted network drive
+type Filesystem struct {
+	root string
+}
+
+func NewFilesystem(root string) *Filesystem {
+	return &Filesystem{root: root}
+}
+
+func (f *Filesystem) path(key string) string {
+	return filepath.Join(f.root, filepath.FromSlash(key))
+}
+
+func (f *Filesystem) PutFile(ctx context.Context, key, path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "open source file")
+	}
+	defer in.Close()
+
+	return f.write(key, in)
+}
+
+func (f *Filesystem) GetFile(ctx context.Context, key, path string) error {
+	in, err := os.Open(f.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return backup.NewErrNotFound("%s does not exist", key)
+		}
+		return errors.Wrapf(err, "open %s", key)
+	}
+	defer in.Close()
+
+	out, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "create target file")
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return errors.Wrapf(err, "copy %s", key)
+	}
+
+	return out.Sync()
+}
+
+func (f *Filesystem) PutObject(ctx context.Context, key string, data []byte) error {
+	return f.write(key, bytes.NewReader(data))
+}
+
+func (f *Filesystem) GetObject(ctx context.Context, key string) ([]byte, error) {
+	data, err := ioutil.ReadFile(f.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, backup.NewErrNotFound("%s does not exist", key)
+		}
+		return nil, errors.Wrapf(err, "read %s", key)
+	}
+
+	return data, nil
+}
+
+// write goes through a temporary file, so that an interrupted write can't
+// leave a partial object behind
+func (f *Filesystem) write(key string, r io.Reader) error {
+	dst := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o777); err != nil {
+		return errors.Wrapf(err, "create directory for %s", key)
+	}
+
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return errors.Wrapf(err, "create %s", key)
+	}
+
+	_, err = io.Copy(out, r)
+	if err == nil {
+		err = out.Sync()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return errors.Wrapf(err, "write %s", key)
+	}
+
+	return errors.Wrapf(os.Rename(tmp, dst), "rename %s", key)
+}
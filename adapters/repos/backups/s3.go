@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backups
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// unsignedPayload is used for file uploads, so that large files don't have to
+// be read twice. The payload is still protected by TLS if the endpoint uses it.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// S3 stores backups in a bucket of an S3-compatible object store. Requests
+// use path-style addressing and are signed with AWS signature version 4, so
+// that stand-ins such as MinIO can be used instead of AWS.
+type S3 struct {
+	config config.BackupS3
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3(cfg config.BackupS3) *S3 {
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+
+	return &S3{
+		config: cfg,
+		client: &http.Client{},
+		now:    time.Now,
+	}
+}
+
+func (s *S3) url(key string) string {
+	return fmt.Sprintf("%s/%s/%s", s.config.Endpoint, s.config.Bucket, key)
+}
+
+func (s *S3) PutFile(ctx context.Context, key, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "open source file")
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat source file")
+	}
+
+	return s.put(ctx, key, f, info.Size(), unsignedPayload)
+}
+
+func (s *S3) GetFile(ctx context.Context, key, path string) error {
+	body, err := s.get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	out, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "create target file")
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, body); err != nil {
+		return errors.Wrapf(err, "download %s", key)
+	}
+
+	return out.Sync()
+}
+
+func (s *S3) PutObject(ctx context.Context, key string, data []byte) error {
+	return s.put(ctx, key, bytes.NewReader(data), int64(len(data)),
+		hashHex(data))
+}
+
+func (s *S3) GetObject(ctx context.Context, key string) ([]byte, error) {
+	body, err := s.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "download %s", key)
+	}
+
+	return data, nil
+}
+
+func (s *S3) put(ctx context.Context, key string, body io.Reader, size int64,
+	payloadHash string) error {
+	req, err := http.NewRequest(http.MethodPut, s.url(key), body)
+	if err != nil {
+		return errors.Wrapf(err, "upload %s", key)
+	}
+	req = req.WithContext(ctx)
+	req.ContentLength = size
+
+	res, err := s.do(req, payloadHash)
+	if err != nil {
+		return errors.Wrapf(err, "upload %s", key)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Wrapf(errorFromResponse(res), "upload %s", key)
+	}
+
+	return nil
+}
+
+// get returns the body of the object, which must be closed by the caller
+func (s *S3) get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, s.url(key), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "download %s", key)
+	}
+	req = req.WithContext(ctx)
+
+	res, err := s.do(req, hashHex(nil))
+	if err != nil {
+		return nil, errors.Wrapf(err, "download %s", key)
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, backup.NewErrNotFound("%s does not exist", key)
+	default:
+		defer res.Body.Close()
+		return nil, errors.Wrapf(errorFromResponse(res), "download %s", key)
+	}
+}
+
+func (s *S3) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	if s.config.AccessKeyID != "" {
+		signV4(req, payloadHash, s.config.AccessKeyID, s.config.SecretAccessKey,
+			s.config.Region, s.now())
+	}
+
+	return s.client.Do(req)
+}
+
+func errorFromResponse(res *http.Response) error {
+	body, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("s3 responded with status %d: %s", res.StatusCode,
+		strings.TrimSpace(string(body)))
+}
+
+// signV4 adds the x-amz-date, x-amz-content-sha256 and Authorization headers
+// of AWS signature version 4. All headers set on the request at this point
+// are signed, together with the host.
+func signV4(req *http.Request, payloadHash, accessKeyID, secretAccessKey,
+	region string, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -111,4 +111,26 @@ func (r *Repo) Get(ctx context.Context, id strfmt.UUID) (*models.Classification,
 	return &c, nil
 }
 
+// List returns all stored classifications, for example to include them in a
+// backup
+func (r *Repo) List(ctx context.Context) ([]*models.Classification, error) {
+	var out []*models.Classification
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(classificationsBucket).ForEach(func(k, v []byte) error {
+			var c models.Classification
+			if err := json.Unmarshal(v, &c); err != nil {
+				return errors.Wrapf(err, "parse classification %s from JSON", string(k))
+			}
+
+			out = append(out, &c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 var _ = classification.Repo(&Repo{})
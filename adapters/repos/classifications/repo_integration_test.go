@@ -68,6 +68,15 @@ func Test_ClassificationsRepo(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, &expectedTwo, res)
 	})
+
+	t.Run("listing stored classifications", func(t *testing.T) {
+		expectedOne := exampleOne()
+		expectedTwo := exampleTwo()
+
+		res, err := r.List(context.Background())
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []*models.Classification{&expectedOne, &expectedTwo}, res)
+	})
 }
 
 func exampleOne() models.Classification {
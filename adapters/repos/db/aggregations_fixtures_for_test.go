@@ -43,6 +43,10 @@ var companyClass = &models.Class{
 			Name:     "listedInIndex",
 			DataType: []string{"boolean"},
 		},
+		&models.Property{
+			Name:     "foundedAt",
+			DataType: []string{"date"},
+		},
 		&models.Property{
 			Name:     "makesProduct",
 			DataType: []string{"AggregationsTestProduct"},
@@ -57,6 +61,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 1.3,
 		"price":         int64(150),
 		"listedInIndex": true,
+		"foundedAt":     "1990-05-04T10:00:00Z",
 	},
 	{
 		"sector":        "Financials",
@@ -64,6 +69,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 4.0,
 		"price":         int64(600),
 		"listedInIndex": true,
+		"foundedAt":     "1990-11-20T08:30:00Z",
 	},
 	{
 		"sector":        "Financials",
@@ -71,6 +77,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 1.3,
 		"price":         int64(47),
 		"listedInIndex": true,
+		"foundedAt":     "2001-03-15T00:00:00Z",
 	},
 	{
 		"sector":        "Food",
@@ -78,6 +85,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 1.3,
 		"price":         int64(160),
 		"listedInIndex": true,
+		"foundedAt":     "1985-07-01T00:00:00Z",
 	},
 	{
 		"sector":        "Food",
@@ -85,6 +93,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 2.0,
 		"price":         int64(70),
 		"listedInIndex": true,
+		"foundedAt":     "1990-02-10T12:00:00Z",
 	},
 	{
 		"sector":        "Food",
@@ -92,6 +101,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 0.0,
 		"price":         int64(800),
 		"listedInIndex": false,
+		"foundedAt":     "2001-12-31T23:00:00-02:00",
 	},
 	{
 		"sector":        "Food",
@@ -99,6 +109,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 8.0,
 		"price":         int64(10),
 		"listedInIndex": true,
+		"foundedAt":     "1985-01-02T00:00:00Z",
 	},
 	{
 		"sector":        "Food",
@@ -106,6 +117,7 @@ var companies = []map[string]interface{}{
 		"dividendYield": 0.0,
 		"price":         int64(200),
 		"listedInIndex": true,
+		"foundedAt":     "2010-06-06T06:06:06Z",
 	},
 	{
 		"sector":        "Food",
@@ -113,5 +125,6 @@ var companies = []map[string]interface{}{
 		"dividendYield": 1.1,
 		"price":         int64(70),
 		"listedInIndex": true,
+		"foundedAt":     "1990-05-04T10:00:00Z",
 	},
 }
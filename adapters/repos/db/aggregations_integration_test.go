@@ -60,6 +60,9 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("numerical aggregations without grouping (formerly Meta)",
 		testNumericalAggregationsWithoutGrouping(repo))
 
+	t.Run("date aggregations",
+		testDateAggregations(repo))
+
 	// t.Run("clean up",
 	// 	cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -1072,6 +1075,162 @@ func testNumericalAggregationsWithoutGrouping(repo *DB) func(t *testing.T) {
 	}
 }
 
+func testDateAggregations(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		date := func(year int, month time.Month, day, hour, min, sec int) time.Time {
+			return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+		}
+
+		bucket := func(year int, count int) aggregation.DateHistogramBucket {
+			return aggregation.DateHistogramBucket{
+				Start: date(year, 1, 1, 0, 0, 0),
+				Count: count,
+			}
+		}
+
+		aggregate := func(t *testing.T,
+			filter *filters.LocalFilter) aggregation.Date {
+			params := traverser.AggregateParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Filters:   filter,
+				Properties: []traverser.AggregateProperty{
+					traverser.AggregateProperty{
+						Name: schema.PropertyName("foundedAt"),
+						Aggregators: []traverser.Aggregator{
+							traverser.CountAggregator,
+							traverser.MinimumAggregator,
+							traverser.MaximumAggregator,
+							traverser.NewHistogramAggregator(traverser.DateIntervalYear),
+						},
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+
+			prop, ok := res.Groups[0].Properties["foundedAt"]
+			require.True(t, ok)
+			assert.Equal(t, aggregation.PropertyTypeDate, prop.Type)
+			return prop.DateAggregation
+		}
+
+		t.Run("without filters", func(t *testing.T) {
+			expected := aggregation.Date{
+				Count:   9,
+				Minimum: date(1985, 1, 2, 0, 0, 0),
+				Maximum: date(2010, 6, 6, 6, 6, 6),
+				Histogram: []aggregation.DateHistogramBucket{
+					bucket(1985, 2),
+					bucket(1990, 4),
+					bucket(2001, 1),
+					// 2001-12-31T23:00:00-02:00 is already 2002 in UTC
+					bucket(2002, 1),
+					bucket(2010, 1),
+				},
+			}
+
+			assert.Equal(t, expected, aggregate(t, nil))
+		})
+
+		t.Run("filtered by sector", func(t *testing.T) {
+			expected := aggregation.Date{
+				Count:   6,
+				Minimum: date(1985, 1, 2, 0, 0, 0),
+				Maximum: date(2010, 6, 6, 6, 6, 6),
+				Histogram: []aggregation.DateHistogramBucket{
+					bucket(1985, 2),
+					bucket(1990, 2),
+					bucket(2002, 1),
+					bucket(2010, 1),
+				},
+			}
+
+			assert.Equal(t, expected, aggregate(t, sectorEqualsFoodFilter()))
+		})
+
+		t.Run("filtered by date", func(t *testing.T) {
+			filter := &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorGreaterThanEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(companyClass.Class),
+						Property: "foundedAt",
+					},
+					Value: &filters.Value{
+						Value: "2000-01-01T00:00:00Z",
+						Type:  schema.DataTypeDate,
+					},
+				},
+			}
+
+			expected := aggregation.Date{
+				Count:   3,
+				Minimum: date(2001, 3, 15, 0, 0, 0),
+				Maximum: date(2010, 6, 6, 6, 6, 6),
+				Histogram: []aggregation.DateHistogramBucket{
+					bucket(2001, 1),
+					bucket(2002, 1),
+					bucket(2010, 1),
+				},
+			}
+
+			assert.Equal(t, expected, aggregate(t, filter))
+		})
+
+		t.Run("histogram by week", func(t *testing.T) {
+			params := traverser.AggregateParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Filters:   sectorEqualsFoodFilter(),
+				Properties: []traverser.AggregateProperty{
+					traverser.AggregateProperty{
+						Name: schema.PropertyName("foundedAt"),
+						Aggregators: []traverser.Aggregator{
+							traverser.NewHistogramAggregator(traverser.DateIntervalWeek),
+						},
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			// weeks start on mondays
+			expected := []aggregation.DateHistogramBucket{
+				{Start: date(1984, 12, 31, 0, 0, 0), Count: 1},
+				{Start: date(1985, 7, 1, 0, 0, 0), Count: 1},
+				{Start: date(1990, 2, 5, 0, 0, 0), Count: 1},
+				{Start: date(1990, 4, 30, 0, 0, 0), Count: 1},
+				{Start: date(2001, 12, 31, 0, 0, 0), Count: 1},
+				{Start: date(2010, 5, 31, 0, 0, 0), Count: 1},
+			}
+			assert.Equal(t, expected,
+				res.Groups[0].Properties["foundedAt"].DateAggregation.Histogram)
+		})
+
+		t.Run("with an invalid interval", func(t *testing.T) {
+			params := traverser.AggregateParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Properties: []traverser.AggregateProperty{
+					traverser.AggregateProperty{
+						Name: schema.PropertyName("foundedAt"),
+						Aggregators: []traverser.Aggregator{
+							traverser.NewHistogramAggregator("fortnight"),
+						},
+					},
+				},
+			}
+
+			_, err := repo.Aggregate(context.Background(), params)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
 func ptInt(in int) *int {
 	return &in
 }
@@ -73,6 +73,8 @@ func (a *Aggregator) aggTypeOfProperty(
 		return aggregation.PropertyTypeBoolean, dt, nil
 	case schema.DataTypeText, schema.DataTypeString:
 		return aggregation.PropertyTypeText, dt, nil
+	case schema.DataTypeDate:
+		return aggregation.PropertyTypeDate, dt, nil
 	case schema.DataTypeGeoCoordinates:
 		return "", "", fmt.Errorf("dataType geoCoordinates can't be aggregated")
 	case schema.DataTypePhoneNumber:
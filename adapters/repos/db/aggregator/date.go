@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// extractIntervalFromHistogram returns the interval of the histogram
+// aggregator, an empty string means that no histogram was requested
+func extractIntervalFromHistogram(aggs []traverser.Aggregator) (string, error) {
+	for _, agg := range aggs {
+		if agg.Type != traverser.HistogramType {
+			continue
+		}
+
+		if _, err := truncateDate(time.Time{}, agg.Interval); err != nil {
+			return "", err
+		}
+
+		return agg.Interval, nil
+	}
+
+	return "", nil
+}
+
+// truncateDate returns the start of the interval (in UTC) the date falls
+// into. Weeks start on mondays.
+func truncateDate(in time.Time, interval string) (time.Time, error) {
+	in = in.UTC()
+	switch interval {
+	case traverser.DateIntervalMinute:
+		return in.Truncate(time.Minute), nil
+	case traverser.DateIntervalHour:
+		return in.Truncate(time.Hour), nil
+	case traverser.DateIntervalDay:
+		return time.Date(in.Year(), in.Month(), in.Day(), 0, 0, 0, 0, time.UTC), nil
+	case traverser.DateIntervalWeek:
+		day := time.Date(in.Year(), in.Month(), in.Day(), 0, 0, 0, 0, time.UTC)
+		daysSinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -daysSinceMonday), nil
+	case traverser.DateIntervalMonth:
+		return time.Date(in.Year(), in.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	case traverser.DateIntervalYear:
+		return time.Date(in.Year(), 1, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported histogram interval %q", interval)
+	}
+}
+
+func newDateAggregator(interval string) *dateAggregator {
+	return &dateAggregator{
+		interval: interval,
+		buckets:  map[time.Time]int{},
+	}
+}
+
+type dateAggregator struct {
+	count    int
+	min      time.Time
+	max      time.Time
+	interval string
+	buckets  map[time.Time]int
+}
+
+func (a *dateAggregator) AddDate(value time.Time) {
+	a.addDateWithCount(value.UTC(), 1)
+}
+
+func (a *dateAggregator) AddDateRow(date, count []byte) error {
+	var countParsed uint32
+
+	dateParsed, err := inverted.ParseLexicographicallySortableDate(date)
+	if err != nil {
+		return errors.Wrap(err, "read date")
+	}
+
+	if err := binary.Read(bytes.NewReader(count), binary.LittleEndian,
+		&countParsed); err != nil {
+		return errors.Wrap(err, "read doc count")
+	}
+
+	if countParsed == 0 {
+		// skip
+		return nil
+	}
+
+	a.addDateWithCount(dateParsed, int(countParsed))
+	return nil
+}
+
+func (a *dateAggregator) addDateWithCount(value time.Time, count int) {
+	if a.count == 0 || value.Before(a.min) {
+		a.min = value
+	}
+	if a.count == 0 || value.After(a.max) {
+		a.max = value
+	}
+	a.count += count
+
+	if a.interval == "" {
+		return
+	}
+
+	// the interval was validated when the aggregator was created
+	start, _ := truncateDate(value, a.interval)
+	a.buckets[start] += count
+}
+
+func (a *dateAggregator) Res() aggregation.Date {
+	out := aggregation.Date{
+		Count:   a.count,
+		Minimum: a.min,
+		Maximum: a.max,
+	}
+
+	if a.interval == "" {
+		return out
+	}
+
+	out.Histogram = make([]aggregation.DateHistogramBucket, 0, len(a.buckets))
+	for start, count := range a.buckets {
+		out.Histogram = append(out.Histogram, aggregation.DateHistogramBucket{
+			Start: start,
+			Count: count,
+		})
+	}
+
+	sortDateHistogram(out.Histogram)
+	return out
+}
+
+func sortDateHistogram(buckets []aggregation.DateHistogramBucket) {
+	sort.Slice(buckets, func(a, b int) bool {
+		return buckets[a].Start.Before(buckets[b].Start)
+	})
+}
@@ -125,6 +125,12 @@ func (fa *filteredAggregator) addPropValue(prop propAgg, value interface{}) {
 			return
 		}
 		prop.textAgg.AddText(asString)
+	case aggregation.PropertyTypeDate:
+		asDate, err := inverted.ParseDateValue(value)
+		if err != nil {
+			return
+		}
+		prop.dateAgg.AddDate(asDate)
 	default:
 	}
 }
@@ -142,10 +148,14 @@ type propAgg struct {
 	// use aggType to chose with agg to use
 	aggType aggregation.PropertyType
 
-	// only one of the following three would ever best
+	// only set on date props with a histogram aggregator
+	histogramInterval string
+
+	// only one of the following four would ever best
 	boolAgg      *boolAggregator
 	textAgg      *textAggregator
 	numericalAgg *numericalAggregator
+	dateAgg      *dateAggregator
 }
 
 // propAggs groups propAgg helpers by prop name
@@ -160,6 +170,8 @@ func (pa *propAgg) initAggregator() {
 		pa.boolAgg = newBoolAggregator()
 	case aggregation.PropertyTypeNumerical:
 		pa.numericalAgg = newNumericalAggregator()
+	case aggregation.PropertyTypeDate:
+		pa.dateAgg = newDateAggregator(pa.histogramInterval)
 	default:
 	}
 }
@@ -187,6 +199,10 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 				prop.numericalAgg)
 			out[prop.name.String()] = aggProp
 
+		case aggregation.PropertyTypeDate:
+			aggProp.DateAggregation = prop.dateAgg.Res()
+			out[prop.name.String()] = aggProp
+
 		default:
 		}
 	}
@@ -210,6 +226,13 @@ func (fa *filteredAggregator) prepareAggregatorsForProps() (propAggs, error) {
 
 		pa.aggType = at
 		pa.dataType = dt
+		if at == aggregation.PropertyTypeDate {
+			interval, err := extractIntervalFromHistogram(prop.Aggregators)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %s", prop.Name)
+			}
+			pa.histogramInterval = interval
+		}
 		pa.initAggregator()
 		out[prop.Name.String()] = pa
 	}
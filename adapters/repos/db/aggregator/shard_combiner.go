@@ -0,0 +1,370 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// ParamsForShard makes sure that every shard produces the information that
+// is required to combine the individual shard results later on. Numerical
+// aggregations can only be weighted correctly if the count is known, so it is
+// added to every property, even if the user did not ask for it.
+func ParamsForShard(params traverser.AggregateParams) traverser.AggregateParams {
+	out := params
+	out.Properties = make([]traverser.AggregateProperty, len(params.Properties))
+	for i, prop := range params.Properties {
+		out.Properties[i] = prop
+		if !containsAggregator(prop.Aggregators, traverser.CountAggregator) {
+			out.Properties[i].Aggregators = append(
+				append([]traverser.Aggregator{}, prop.Aggregators...),
+				traverser.CountAggregator)
+		}
+	}
+
+	return out
+}
+
+func containsAggregator(aggs []traverser.Aggregator,
+	needle traverser.Aggregator) bool {
+	for _, agg := range aggs {
+		if agg.Type == needle.Type {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShardCombiner merges the aggregation results of several shards of the same
+// index into a single result. Counts, sums, minimums, maximums and means are
+// exact. Since shards only return their final values and not the underlying
+// distributions, the median (count-weighted mean of the shard medians), the
+// mode (mode of the largest shard) and the top occurrences (top-n of each
+// shard) are approximations. Date aggregations, including their histogram,
+// are exact.
+type ShardCombiner struct {
+	params traverser.AggregateParams
+}
+
+func NewShardCombiner(params traverser.AggregateParams) *ShardCombiner {
+	return &ShardCombiner{params: params}
+}
+
+func (sc *ShardCombiner) Do(results []*aggregation.Result) *aggregation.Result {
+	if sc.params.GroupBy != nil {
+		return sc.combineGrouped(results)
+	}
+
+	groups := make([]aggregation.Group, 0, len(results))
+	for _, res := range results {
+		if res == nil || len(res.Groups) == 0 {
+			continue
+		}
+
+		groups = append(groups, res.Groups[0])
+	}
+
+	return &aggregation.Result{
+		Groups: []aggregation.Group{sc.combineGroups(groups)},
+	}
+}
+
+func (sc *ShardCombiner) combineGrouped(
+	results []*aggregation.Result) *aggregation.Result {
+	var order []string
+	byValue := map[string][]aggregation.Group{}
+	for _, res := range results {
+		if res == nil {
+			continue
+		}
+
+		for _, group := range res.Groups {
+			key := groupKey(group)
+			if _, ok := byValue[key]; !ok {
+				order = append(order, key)
+			}
+			byValue[key] = append(byValue[key], group)
+		}
+	}
+
+	out := make([]aggregation.Group, len(order))
+	for i, key := range order {
+		out[i] = sc.combineGroups(byValue[key])
+	}
+
+	sort.SliceStable(out, func(a, b int) bool {
+		return out[a].Count > out[b].Count
+	})
+
+	limit := 100 // same default as the grouped aggregator
+	if sc.params.Limit != nil {
+		limit = *sc.params.Limit
+	}
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return &aggregation.Result{Groups: out}
+}
+
+func groupKey(group aggregation.Group) string {
+	if group.GroupedBy == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", group.GroupedBy.Value)
+}
+
+func (sc *ShardCombiner) combineGroups(groups []aggregation.Group) aggregation.Group {
+	out := aggregation.Group{}
+	if len(groups) == 0 {
+		return out
+	}
+
+	out.GroupedBy = groups[0].GroupedBy
+
+	propsByName := map[string][]aggregation.Property{}
+	for _, group := range groups {
+		out.Count += group.Count
+		for name, prop := range group.Properties {
+			propsByName[name] = append(propsByName[name], prop)
+		}
+	}
+
+	if len(propsByName) == 0 {
+		return out
+	}
+
+	out.Properties = map[string]aggregation.Property{}
+	for name, props := range propsByName {
+		out.Properties[name] = sc.combineProperty(name, props)
+	}
+
+	return out
+}
+
+func (sc *ShardCombiner) combineProperty(name string,
+	props []aggregation.Property) aggregation.Property {
+	out := aggregation.Property{
+		Type:                 props[0].Type,
+		SchemaType:           props[0].SchemaType,
+		ReferenceAggregation: props[0].ReferenceAggregation,
+	}
+
+	switch out.Type {
+	case aggregation.PropertyTypeNumerical:
+		out.NumericalAggregations = sc.combineNumerical(name, props)
+	case aggregation.PropertyTypeBoolean:
+		out.BooleanAggregation = combineBoolean(props)
+	case aggregation.PropertyTypeText:
+		out.TextAggregation = sc.combineText(name, props)
+	case aggregation.PropertyTypeDate:
+		out.DateAggregation = combineDate(props)
+	}
+
+	return out
+}
+
+func (sc *ShardCombiner) combineNumerical(name string,
+	props []aggregation.Property) map[string]float64 {
+	var (
+		count          float64
+		sum            float64
+		weightedMean   float64
+		weightedMedian float64
+		min            = math.MaxFloat64
+		max            = -math.MaxFloat64
+		mode           float64
+		modeCount      = -1.0
+	)
+
+	for _, prop := range props {
+		aggs := prop.NumericalAggregations
+		c := aggs[traverser.CountAggregator.String()]
+		if c == 0 {
+			// this shard does not hold any values for the prop, its min/max
+			// values would only be the initial values
+			continue
+		}
+
+		count += c
+		sum += aggs[traverser.SumAggregator.String()]
+		weightedMean += aggs[traverser.MeanAggregator.String()] * c
+		weightedMedian += aggs[traverser.MedianAggregator.String()] * c
+		if v, ok := aggs[traverser.MinimumAggregator.String()]; ok && v < min {
+			min = v
+		}
+		if v, ok := aggs[traverser.MaximumAggregator.String()]; ok && v > max {
+			max = v
+		}
+		if c > modeCount {
+			modeCount = c
+			mode = aggs[traverser.ModeAggregator.String()]
+		}
+	}
+
+	out := map[string]float64{}
+	for _, agg := range sc.aggregatorsForProp(name) {
+		switch agg {
+		case traverser.MeanAggregator:
+			out[agg.String()] = divideOrZero(weightedMean, count)
+		case traverser.MinimumAggregator:
+			out[agg.String()] = min
+		case traverser.MaximumAggregator:
+			out[agg.String()] = max
+		case traverser.MedianAggregator:
+			out[agg.String()] = divideOrZero(weightedMedian, count)
+		case traverser.ModeAggregator:
+			out[agg.String()] = mode
+		case traverser.SumAggregator:
+			out[agg.String()] = sum
+		case traverser.CountAggregator:
+			out[agg.String()] = count
+		default:
+			continue
+		}
+	}
+
+	return out
+}
+
+func divideOrZero(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+
+	return a / b
+}
+
+func combineBoolean(props []aggregation.Property) aggregation.Boolean {
+	out := aggregation.Boolean{}
+	for _, prop := range props {
+		out.TotalTrue += prop.BooleanAggregation.TotalTrue
+		out.TotalFalse += prop.BooleanAggregation.TotalFalse
+	}
+
+	out.Count = out.TotalTrue + out.TotalFalse
+	if out.Count == 0 {
+		return out
+	}
+
+	out.PercentageTrue = float64(out.TotalTrue) / float64(out.Count)
+	out.PercentageFalse = float64(out.TotalFalse) / float64(out.Count)
+	return out
+}
+
+func combineDate(props []aggregation.Property) aggregation.Date {
+	out := aggregation.Date{}
+
+	var withHistogram bool
+	buckets := map[time.Time]int{}
+	for _, prop := range props {
+		date := prop.DateAggregation
+		if date.Histogram != nil {
+			withHistogram = true
+		}
+
+		if date.Count == 0 {
+			// this shard does not hold any values for the prop, its min/max
+			// values would only be zero values
+			continue
+		}
+
+		if out.Count == 0 || date.Minimum.Before(out.Minimum) {
+			out.Minimum = date.Minimum
+		}
+		if out.Count == 0 || date.Maximum.After(out.Maximum) {
+			out.Maximum = date.Maximum
+		}
+		out.Count += date.Count
+
+		for _, bucket := range date.Histogram {
+			buckets[bucket.Start] += bucket.Count
+		}
+	}
+
+	if !withHistogram {
+		return out
+	}
+
+	// unlike the top occurrences, the histogram is exact, as every shard
+	// returns all of its buckets
+	out.Histogram = make([]aggregation.DateHistogramBucket, 0, len(buckets))
+	for start, count := range buckets {
+		out.Histogram = append(out.Histogram, aggregation.DateHistogramBucket{
+			Start: start,
+			Count: count,
+		})
+	}
+	sortDateHistogram(out.Histogram)
+
+	return out
+}
+
+func (sc *ShardCombiner) combineText(name string,
+	props []aggregation.Property) aggregation.Text {
+	out := aggregation.Text{}
+
+	var order []string
+	occurrences := map[string]int{}
+	for _, prop := range props {
+		out.Count += prop.TextAggregation.Count
+		for _, item := range prop.TextAggregation.Items {
+			if _, ok := occurrences[item.Value]; !ok {
+				order = append(order, item.Value)
+			}
+			occurrences[item.Value] += item.Occurs
+		}
+	}
+
+	if len(order) == 0 {
+		return out
+	}
+
+	out.Items = make([]aggregation.TextOccurrence, len(order))
+	for i, value := range order {
+		out.Items[i] = aggregation.TextOccurrence{
+			Value:  value,
+			Occurs: occurrences[value],
+		}
+	}
+
+	sort.SliceStable(out.Items, func(a, b int) bool {
+		return out.Items[a].Occurs > out.Items[b].Occurs
+	})
+
+	limit := extractLimitFromTopOccs(sc.aggregatorsForProp(name))
+	if len(out.Items) > limit {
+		out.Items = out.Items[:limit]
+	}
+
+	return out
+}
+
+// aggregatorsForProp returns the aggregators the user originally asked for,
+// i.e. without the ones added by ParamsForShard
+func (sc *ShardCombiner) aggregatorsForProp(name string) []traverser.Aggregator {
+	for _, prop := range sc.params.Properties {
+		if prop.Name.String() == name {
+			return prop.Aggregators
+		}
+	}
+
+	return nil
+}
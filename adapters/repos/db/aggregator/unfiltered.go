@@ -122,6 +122,8 @@ func (ua unfilteredAggregator) property(ctx context.Context,
 		return ua.boolProperty(ctx, prop)
 	case aggregation.PropertyTypeText:
 		return ua.textProperty(ctx, prop)
+	case aggregation.PropertyTypeDate:
+		return ua.dateProperty(ctx, prop)
 	case aggregation.PropertyTypeReference:
 		// ignore, as this is handled outside the repo in the uc
 		return nil, nil
@@ -134,6 +134,63 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 	return &out, nil
 }
 
+func (ua unfilteredAggregator) dateProperty(ctx context.Context,
+	prop traverser.AggregateProperty) (*aggregation.Property, error) {
+	out := aggregation.Property{
+		Type: aggregation.PropertyTypeDate,
+	}
+
+	interval, err := extractIntervalFromHistogram(prop.Aggregators)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ua.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(helpers.BucketFromPropName(prop.Name.String()))
+		if b == nil {
+			return fmt.Errorf("could not find bucket for prop %s", prop.Name)
+		}
+
+		agg := newDateAggregator(interval)
+
+		if err := b.ForEach(func(k, v []byte) error {
+			return ua.parseAndAddDateRow(agg, k, v)
+		}); err != nil {
+			return err
+		}
+
+		out.DateAggregation = agg.Res()
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (ua unfilteredAggregator) parseAndAddDateRow(agg *dateAggregator, k, v []byte) error {
+	if len(k) != 12 {
+		// dates are stored as seconds (int64) and nanoseconds (uint32), so any
+		// non-12 length is unexpected
+		return fmt.Errorf("unexpected key length on inverted index, "+
+			"expected 12: got %d", len(k))
+	}
+
+	if len(v) < 8 {
+		// we expect to see a at least a checksum (4 bytes) and a count
+		// (uint32), if that's not the case, then the row is corrupt
+		return fmt.Errorf("unexpected value length on inverted index, "+
+			"expected at least 8: got %d", len(k))
+	}
+
+	if err := agg.AddDateRow(k, v[4:8]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (ua unfilteredAggregator) parseAndAddFloatRow(agg *numericalAggregator, k, v []byte) error {
 	if len(k) != 8 {
 		// we expect to see either an int64 or a float64, so any non-8 length
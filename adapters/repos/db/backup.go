@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// BackupClass writes a consistent copy of all files of the class into the
+// specified directory. Writes to the class are blocked until the copy is
+// complete, reads are not affected. The returned file names are relative to
+// the directory.
+func (d *DB) BackupClass(ctx context.Context, kind kind.Kind,
+	className schema.ClassName, dir string) ([]string, error) {
+	idx := d.GetIndex(kind, className)
+	if idx == nil {
+		return nil, fmt.Errorf("backup class %s: class does not exist", className)
+	}
+
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, errors.Wrapf(err, "backup class %s: create directory", className)
+	}
+
+	if err := idx.backup(dir); err != nil {
+		return nil, errors.Wrapf(err, "backup class %s", className)
+	}
+
+	files, err := filesInDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "backup class %s", className)
+	}
+
+	return files, nil
+}
+
+// RestoreClass copies the files of a class, as written by BackupClass, into
+// the root path of the db. It must be called before the class is added to
+// the schema, so that the index is opened on top of the restored files.
+func (d *DB) RestoreClass(ctx context.Context, kind kind.Kind,
+	className schema.ClassName, dir string) error {
+	if d.GetIndex(kind, className) != nil {
+		return fmt.Errorf("restore class %s: class already exists", className)
+	}
+
+	files, err := filesInDir(dir)
+	if err != nil {
+		return errors.Wrapf(err, "restore class %s", className)
+	}
+
+	// check all files first, so that nothing is overwritten or left behind
+	// half-way if any of them is in the way
+	prefix := indexID(kind, className) + "_"
+	for _, file := range files {
+		if len(file) < len(prefix) || file[:len(prefix)] != prefix {
+			return fmt.Errorf("restore class %s: file %q does not belong to the class",
+				className, file)
+		}
+
+		_, err := os.Stat(filepath.Join(d.config.RootPath, file))
+		if err == nil {
+			return fmt.Errorf("restore class %s: file %q already exists",
+				className, file)
+		}
+		if !os.IsNotExist(err) {
+			return errors.Wrapf(err, "restore class %s", className)
+		}
+	}
+
+	for _, file := range files {
+		if err := copyFile(filepath.Join(dir, file),
+			filepath.Join(d.config.RootPath, file)); err != nil {
+			return errors.Wrapf(err, "restore class %s", className)
+		}
+	}
+
+	return nil
+}
+
+// filesInDir lists all regular files below the directory, relative to it
+func filesInDir(dir string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "list files in %s", dir)
+	}
+
+	return files, nil
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o777); err != nil {
+		return errors.Wrapf(err, "create directory for %s", dst)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return errors.Wrapf(err, "copy %s to %s", src, dst)
+	}
+
+	return out.Sync()
+}
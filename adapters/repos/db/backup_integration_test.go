@@ -0,0 +1,375 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupAndRestoreClass(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	backupDirName := dirName + "_backup"
+	restoreDirName := dirName + "_restore"
+	for _, dir := range []string{dirName, restoreDirName} {
+		os.MkdirAll(dir, 0o777)
+	}
+	defer func() {
+		for _, dir := range []string{dirName, backupDirName, restoreDirName} {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:      "BackupCar",
+		ShardCount: 2,
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:     "location",
+				DataType: []string{string(schema.DataTypeGeoCoordinates)},
+			},
+		},
+	}
+
+	openRepo := func(t *testing.T, rootPath string) (*DB, *fakeSchemaGetter) {
+		schemaGetter := &fakeSchemaGetter{}
+		repo := New(logger, Config{RootPath: rootPath})
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		return repo, schemaGetter
+	}
+
+	repo, schemaGetter := openRepo(t, dirName)
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	ids := make([]strfmt.UUID, 12)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	putCar := func(t *testing.T, repo *DB, i int) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			Class: class.Class,
+			ID:    ids[i],
+			Schema: map[string]interface{}{
+				"name": fmt.Sprintf("car%d", i),
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(52.5),
+					Longitude: ptFloat32(13.4),
+				},
+			},
+		}, []float32{1, float32(i), 0})
+		require.Nil(t, err)
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			putCar(t, repo, i)
+		}
+		require.Nil(t, repo.DeleteThing(context.Background(), class.Class, ids[5]))
+	})
+
+	t.Run("backing up a class which doesn't exist", func(t *testing.T) {
+		_, err := repo.BackupClass(context.Background(), kind.Thing,
+			"BackupPlane", backupDirName)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("backing up the class", func(t *testing.T) {
+		files, err := repo.BackupClass(context.Background(), kind.Thing,
+			schema.ClassName(class.Class), backupDirName)
+		require.Nil(t, err)
+		assert.Contains(t, files, "thing_backupcar_shard0.db")
+		assert.Contains(t, files, "thing_backupcar_shard1.indexcount")
+	})
+
+	t.Run("writes after the backup are not part of it", func(t *testing.T) {
+		putCar(t, repo, 10)
+	})
+
+	restored, restoredSchemaGetter := openRepo(t, restoreDirName)
+	restoredMigrator := NewMigrator(restored, logger)
+
+	t.Run("restoring the class", func(t *testing.T) {
+		require.Nil(t, restored.RestoreClass(context.Background(), kind.Thing,
+			schema.ClassName(class.Class), backupDirName))
+		require.Nil(t, restoredMigrator.AddClass(context.Background(), kind.Thing, class))
+		restoredSchemaGetter.schema = schema.Schema{
+			Things: &models.Schema{Classes: []*models.Class{class}},
+		}
+	})
+
+	t.Run("restoring onto an existing class", func(t *testing.T) {
+		err := restored.RestoreClass(context.Background(), kind.Thing,
+			schema.ClassName(class.Class), backupDirName)
+		assert.NotNil(t, err)
+	})
+
+	getCar := func(t *testing.T, i int) *models.Thing {
+		res, err := restored.ThingByID(context.Background(), ids[i], nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		if res == nil {
+			return nil
+		}
+		return res.Thing()
+	}
+
+	t.Run("the restored class contains the backed up objects", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			if i == 5 {
+				assert.Nil(t, getCar(t, i), "deleted object")
+				continue
+			}
+			assert.NotNil(t, getCar(t, i), "object %d", i)
+		}
+		assert.Nil(t, getCar(t, 10), "written after the backup")
+
+		res, err := restored.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "name",
+					},
+					Value: &filters.Value{
+						Value: "car3",
+						Type:  schema.DataTypeString,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[3], res[0].ID)
+
+		vectorRes, err := restored.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 1},
+			SearchVector: []float32{0, 1, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, vectorRes, 1)
+		assert.Equal(t, ids[9], vectorRes[0].ID)
+	})
+
+	t.Run("the restored class can be written to", func(t *testing.T) {
+		putCar(t, restored, 11)
+		assert.NotNil(t, getCar(t, 11))
+		assert.NotNil(t, getCar(t, 0), "existing objects are not overwritten")
+
+		vectorRes, err := restored.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 1},
+			SearchVector: []float32{0, 1, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, vectorRes, 1)
+		assert.Equal(t, ids[11], vectorRes[0].ID)
+	})
+}
+
+// slowVectorIndex makes every insert take a while, so that the vector queue
+// is still being processed while a backup is taken
+type slowVectorIndex struct {
+	VectorIndex
+}
+
+func (s *slowVectorIndex) Add(id int, vector []float32) error {
+	time.Sleep(2 * time.Millisecond)
+	return s.VectorIndex.Add(id, vector)
+}
+
+func TestBackupAndRestoreClassWithQueuedVectors(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	backupDirName := dirName + "_backup"
+	restoreDirName := dirName + "_restore"
+	for _, dir := range []string{dirName, restoreDirName} {
+		os.MkdirAll(dir, 0o777)
+	}
+	defer func() {
+		for _, dir := range []string{dirName, backupDirName, restoreDirName} {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:      "BackupQueuedCar",
+		ShardCount: 1,
+		VectorIndexConfig: &models.VectorIndexConfig{
+			Distance:      "l2-squared",
+			AsyncIndexing: true,
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+
+	openRepo := func(t *testing.T, rootPath string) (*DB, *fakeSchemaGetter) {
+		schemaGetter := &fakeSchemaGetter{}
+		repo := New(logger, Config{RootPath: rootPath})
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		return repo, schemaGetter
+	}
+
+	repo, schemaGetter := openRepo(t, dirName)
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(),
+		kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	var shard *Shard
+	for _, s := range idx.Shards {
+		shard = s
+	}
+
+	const objectCount = 3000
+
+	t.Run("importing objects with the workers paused", func(t *testing.T) {
+		shard.vectorQueue.shutdown()
+		for i := 0; i < objectCount; i++ {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  class.Class,
+				ID:     strfmt.UUID(uuid.New().String()),
+				Schema: map[string]interface{}{"name": fmt.Sprintf("car%d", i)},
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+		assert.Equal(t, objectCount, shard.vectorQueue.length())
+	})
+
+	t.Run("backing up the class while the queue is processed", func(t *testing.T) {
+		shard.vectorIndex = &slowVectorIndex{VectorIndex: shard.vectorIndex}
+		shard.vectorQueue.start()
+
+		_, err := repo.BackupClass(context.Background(), kind.Thing,
+			schema.ClassName(class.Class), backupDirName)
+		require.Nil(t, err)
+		assert.Greater(t, shard.vectorQueue.length(), 0,
+			"the queue was drained before the backup was taken")
+	})
+
+	t.Run("every vector is either queued or indexed in the backup", func(t *testing.T) {
+		db, err := bolt.Open(shardDBPath(backupDirName, shard.ID()), 0o600,
+			&bolt.Options{ReadOnly: true})
+		require.Nil(t, err)
+		var objects, queued int
+		require.Nil(t, db.View(func(tx *bolt.Tx) error {
+			objects = tx.Bucket(helpers.IndexIDBucket).Stats().KeyN
+			queued = tx.Bucket(helpers.VectorQueueBucket).Stats().KeyN
+			return nil
+		}))
+		require.Nil(t, db.Close())
+
+		graph, err := hnsw.New(hnsw.Config{
+			RootPath:              backupDirName,
+			ID:                    shard.ID(),
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			MaximumConnections:    64,
+			EFConstruction:        128,
+			VectorForIDThunk: func(ctx context.Context, id int32) ([]float32, error) {
+				return nil, fmt.Errorf("no vectors needed for the stats")
+			},
+		})
+		require.Nil(t, err)
+		stats, err := graph.Stats(false)
+		require.Nil(t, err)
+		require.Nil(t, graph.Shutdown())
+
+		assert.Equal(t, objectCount, objects)
+		assert.Greater(t, queued, 0)
+		assert.Equal(t, objects, queued+stats.Nodes)
+	})
+
+	t.Run("the restored queue completes the graph", func(t *testing.T) {
+		restored, restoredSchemaGetter := openRepo(t, restoreDirName)
+		require.Nil(t, restored.RestoreClass(context.Background(), kind.Thing,
+			schema.ClassName(class.Class), backupDirName))
+		require.Nil(t, NewMigrator(restored, logger).AddClass(context.Background(),
+			kind.Thing, class))
+		restoredSchemaGetter.schema = schema.Schema{
+			Things: &models.Schema{Classes: []*models.Class{class}},
+		}
+
+		restoredIdx := restored.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.NotNil(t, restoredIdx)
+		for _, s := range restoredIdx.Shards {
+			deadline := time.Now().Add(time.Minute)
+			for s.vectorQueue.length() > 0 {
+				require.True(t, time.Now().Before(deadline), "queue not drained in time")
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			stats, err := s.vectorIndexStats(false)
+			require.Nil(t, err)
+			assert.Equal(t, objectCount, stats.Nodes)
+		}
+
+		res, err := restored.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 1},
+			SearchVector: []float32{1, 7, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "car7", res[0].Schema.(map[string]interface{})["name"])
+		require.Nil(t, restoredIdx.shutdown())
+	})
+
+	require.Nil(t, idx.shutdown())
+}
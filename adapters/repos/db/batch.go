@@ -13,7 +13,9 @@ package db
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
@@ -26,9 +28,10 @@ type batchQueue struct {
 }
 
 func (db *DB) BatchPutThings(ctx context.Context, things kinds.BatchThings) (kinds.BatchThings, error) {
-	byIndex := map[string]batchQueue{}
+	byIndex := map[*Index]batchQueue{}
+	indices := db.allIndices()
 	for _, item := range things {
-		for _, index := range db.indices {
+		for _, index := range indices {
 			if index.Config.Kind != kind.Thing || index.Config.ClassName != schema.ClassName(item.Thing.Class) {
 				continue
 			}
@@ -38,17 +41,17 @@ func (db *DB) BatchPutThings(ctx context.Context, things kinds.BatchThings) (kin
 				continue
 			}
 
-			queue := byIndex[index.ID()]
+			queue := byIndex[index]
 			queue.objects = append(queue.objects, storobj.FromThing(item.Thing, item.Vector))
 			queue.originalIndex = append(queue.originalIndex, item.OriginalIndex)
-			byIndex[index.ID()] = queue
+			byIndex[index] = queue
 		}
 	}
 
-	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects)
-		for index, err := range errs {
-			things[queue.originalIndex[index]].Err = err
+	for index, queue := range byIndex {
+		errs := index.putObjectBatch(ctx, queue.objects)
+		for i, err := range errs {
+			things[queue.originalIndex[i]].Err = err
 		}
 	}
 
@@ -56,9 +59,10 @@ func (db *DB) BatchPutThings(ctx context.Context, things kinds.BatchThings) (kin
 }
 
 func (db *DB) BatchPutActions(ctx context.Context, actions kinds.BatchActions) (kinds.BatchActions, error) {
-	byIndex := map[string]batchQueue{}
+	byIndex := map[*Index]batchQueue{}
+	indices := db.allIndices()
 	for _, item := range actions {
-		for _, index := range db.indices {
+		for _, index := range indices {
 			if index.Config.Kind != kind.Action || index.Config.ClassName != schema.ClassName(item.Action.Class) {
 				continue
 			}
@@ -68,17 +72,17 @@ func (db *DB) BatchPutActions(ctx context.Context, actions kinds.BatchActions) (
 				continue
 			}
 
-			queue := byIndex[index.ID()]
+			queue := byIndex[index]
 			queue.objects = append(queue.objects, storobj.FromAction(item.Action, item.Vector))
 			queue.originalIndex = append(queue.originalIndex, item.OriginalIndex)
-			byIndex[index.ID()] = queue
+			byIndex[index] = queue
 		}
 	}
 
-	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects)
-		for index, err := range errs {
-			actions[queue.originalIndex[index]].Err = err
+	for index, queue := range byIndex {
+		errs := index.putObjectBatch(ctx, queue.objects)
+		for i, err := range errs {
+			actions[queue.originalIndex[i]].Err = err
 		}
 	}
 
@@ -86,9 +90,10 @@ func (db *DB) BatchPutActions(ctx context.Context, actions kinds.BatchActions) (
 }
 
 func (db *DB) AddBatchReferences(ctx context.Context, references kinds.BatchReferences) (kinds.BatchReferences, error) {
-	byIndex := map[string]kinds.BatchReferences{}
+	byIndex := map[*Index]kinds.BatchReferences{}
+	indices := db.allIndices()
 	for _, item := range references {
-		for _, index := range db.indices {
+		for _, index := range indices {
 			if index.Config.Kind != item.From.Kind ||
 				index.Config.ClassName != item.From.Class {
 				continue
@@ -99,18 +104,51 @@ func (db *DB) AddBatchReferences(ctx context.Context, references kinds.BatchRefe
 				continue
 			}
 
-			queue := byIndex[index.ID()]
+			queue := byIndex[index]
 			queue = append(queue, item)
-			byIndex[index.ID()] = queue
+			byIndex[index] = queue
 		}
 	}
 
-	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].addReferencesBatch(ctx, queue)
-		for index, err := range errs {
-			references[queue[index].OriginalIndex].Err = err
+	for index, queue := range byIndex {
+		errs := index.addReferencesBatch(ctx, queue)
+		for i, err := range errs {
+			references[queue[i].OriginalIndex].Err = err
 		}
 	}
 
 	return references, nil
 }
+
+// BatchDeleteObjects deletes all objects of the class which match the
+// filters. With DryRun set, the matching objects are only listed.
+func (db *DB) BatchDeleteObjects(ctx context.Context,
+	params kinds.BatchDeleteParams) (kinds.BatchDeleteResult, error) {
+	index := db.GetIndex(params.Kind, params.ClassName)
+	if index == nil {
+		return kinds.BatchDeleteResult{}, fmt.Errorf("batch delete from non-existing index for %s/%s",
+			params.Kind, params.ClassName)
+	}
+
+	ids, err := index.findObjectIDs(ctx, params.Filters)
+	if err != nil {
+		return kinds.BatchDeleteResult{}, errors.Wrapf(err, "find objects in index %s", index.ID())
+	}
+
+	objects := make(kinds.BatchDeleteObjects, len(ids))
+	for i, id := range ids {
+		objects[i] = kinds.BatchDeleteObject{UUID: id}
+	}
+
+	if !params.DryRun {
+		errs := index.deleteObjectBatch(ctx, ids)
+		for i, err := range errs {
+			objects[i].Err = err
+		}
+	}
+
+	return kinds.BatchDeleteResult{
+		Matches: int64(len(ids)),
+		Objects: objects,
+	}, nil
+}
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchDeleteObjects(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "ThingForBatchDeletion",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "color",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+		schemaGetter.schema = schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	red := []strfmt.UUID{
+		"0e5d6f56-0b3a-4e3b-9c8a-7cb4a9d8cf01",
+		"0e5d6f56-0b3a-4e3b-9c8a-7cb4a9d8cf02",
+		"0e5d6f56-0b3a-4e3b-9c8a-7cb4a9d8cf03",
+	}
+	blue := []strfmt.UUID{
+		"0e5d6f56-0b3a-4e3b-9c8a-7cb4a9d8cf04",
+		"0e5d6f56-0b3a-4e3b-9c8a-7cb4a9d8cf05",
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		var batch kinds.BatchThings
+		add := func(ids []strfmt.UUID, color string, vector []float32) {
+			for _, id := range ids {
+				batch = append(batch, kinds.BatchThing{
+					OriginalIndex: len(batch),
+					Thing: &models.Thing{
+						Class:  class.Class,
+						ID:     id,
+						Schema: map[string]interface{}{"color": color},
+					},
+					UUID:   id,
+					Vector: vector,
+				})
+			}
+		}
+		add(red, "red", []float32{1, 0, 0})
+		add(blue, "blue", []float32{0, 0, 1})
+
+		res, err := repo.BatchPutThings(context.Background(), batch)
+		require.Nil(t, err)
+		for _, obj := range res {
+			require.Nil(t, obj.Err)
+		}
+	})
+
+	redFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "color",
+			},
+			Value: &filters.Value{
+				Value: "red",
+				Type:  schema.DataTypeString,
+			},
+		},
+	}
+
+	t.Run("finding the ids of the matches", func(t *testing.T) {
+		ids, err := repo.FindObjectIDs(context.Background(), kind.Thing,
+			schema.ClassName(class.Class), redFilter)
+		require.Nil(t, err)
+		assert.Equal(t, red, ids)
+	})
+
+	t.Run("deleting from a class which does not exist", func(t *testing.T) {
+		_, err := repo.BatchDeleteObjects(context.Background(), kinds.BatchDeleteParams{
+			Kind:      kind.Thing,
+			ClassName: "NoSuchClass",
+			Filters:   redFilter,
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("a dry run lists the matches without deleting them", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), kinds.BatchDeleteParams{
+			Kind:      kind.Thing,
+			ClassName: schema.ClassName(class.Class),
+			Filters:   redFilter,
+			DryRun:    true,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(3), res.Matches)
+		require.Len(t, res.Objects, 3)
+		for i, obj := range res.Objects {
+			assert.Equal(t, red[i], obj.UUID)
+			assert.Nil(t, obj.Err)
+		}
+
+		for _, id := range red {
+			ok, err := repo.Exists(context.Background(), id)
+			require.Nil(t, err)
+			assert.True(t, ok)
+		}
+	})
+
+	t.Run("deleting the matches", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), kinds.BatchDeleteParams{
+			Kind:      kind.Thing,
+			ClassName: schema.ClassName(class.Class),
+			Filters:   redFilter,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(3), res.Matches)
+		require.Len(t, res.Objects, 3)
+		for i, obj := range res.Objects {
+			assert.Equal(t, red[i], obj.UUID)
+			assert.Nil(t, obj.Err)
+		}
+	})
+
+	t.Run("only the non-matching objects are left", func(t *testing.T) {
+		for _, id := range red {
+			ok, err := repo.Exists(context.Background(), id)
+			require.Nil(t, err)
+			assert.False(t, ok)
+		}
+
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		for _, obj := range res {
+			assert.Contains(t, blue, obj.ID)
+		}
+	})
+
+	t.Run("the deleted objects are removed from the vector index", func(t *testing.T) {
+		res, err := repo.VectorSearch(context.Background(), []float32{1, 0, 0}, 10, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		for _, obj := range res {
+			assert.Contains(t, blue, obj.ID)
+		}
+	})
+
+	t.Run("running the same delete again matches nothing", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), kinds.BatchDeleteParams{
+			Kind:      kind.Thing,
+			ClassName: schema.ClassName(class.Class),
+			Filters:   redFilter,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(0), res.Matches)
+		assert.Len(t, res.Objects, 0)
+	})
+}
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBM25(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:      "KeywordArticle",
+		ShardCount: 1,
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+			&models.Property{
+				Name:     "body",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+			&models.Property{
+				Name:     "code",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:     "wordCount",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		"9a0e2bd1-6f3c-42d8-8b4b-2b3a7a4e1a01",
+		"a8c3a38a-2b5c-4a8e-9c26-1f2e3d4c5b02",
+		"b1f7e6d5-4c3b-4a29-8817-0e1f2a3b4c03",
+	}
+	objects := []map[string]interface{}{
+		{
+			"title":     "The quick brown Fox",
+			"body":      "A fox jumps over the lazy dog",
+			"code":      "QBF-1",
+			"wordCount": int64(7),
+		},
+		{
+			"title":     "Lazy dogs sleep",
+			"body":      "Dogs sleep all day long",
+			"code":      "LDS-2",
+			"wordCount": int64(5),
+		},
+		{
+			"title":     "Fox hunting",
+			"body":      "fox fox fox",
+			"code":      "FH-3",
+			"wordCount": int64(3),
+		},
+		{
+			"title":     "Weather report",
+			"body":      "Sunny with a chance of rain",
+			"code":      "WR-4",
+			"wordCount": int64(6),
+		},
+	}
+
+	put := func(t *testing.T, pos int, props map[string]interface{}) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			Class:  class.Class,
+			ID:     ids[pos],
+			Schema: props,
+		}, []float32{1, float32(pos), 0})
+		require.Nil(t, err)
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i, props := range objects {
+			put(t, i, props)
+		}
+	})
+
+	search := func(t *testing.T, pagination *filters.Pagination,
+		filter *filters.LocalFilter, query string,
+		props ...string) []search.Result {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: pagination,
+			Filters:    filter,
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Type:       "bm25",
+				Query:      query,
+				Properties: props,
+			},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	all := &filters.Pagination{Limit: 10}
+
+	t.Run("on a single prop, shorter docs rank higher", func(t *testing.T) {
+		res := search(t, all, nil, "fox", "title")
+		assert.Equal(t, []strfmt.UUID{ids[2], ids[0]}, resultIDs(res))
+		assert.Greater(t, res[0].Score, res[1].Score)
+		assert.Greater(t, res[1].Score, float32(0))
+	})
+
+	t.Run("the query is analyzed like the prop", func(t *testing.T) {
+		res := search(t, all, nil, "FOX, fox!", "title")
+		assert.Equal(t, []strfmt.UUID{ids[2], ids[0]}, resultIDs(res))
+	})
+
+	t.Run("without props, all text and string props are searched", func(t *testing.T) {
+		res := search(t, all, nil, "fox LDS-2")
+		require.Len(t, res, 3)
+		assert.Equal(t, ids[2], res[0].ID)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[1]}, resultIDs(res[1:]))
+	})
+
+	t.Run("string props are not split or lowercased", func(t *testing.T) {
+		res := search(t, all, nil, "lds-2", "code")
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("rare terms weigh more than common ones", func(t *testing.T) {
+		res := search(t, all, nil, "fox rain", "body")
+		require.Len(t, res, 3)
+		// "rain" is only contained in a single doc, whereas "fox" is contained
+		// in two, so a single occurrence of "rain" beats a single "fox"
+		assert.Equal(t, ids[2], res[0].ID)
+		assert.Equal(t, ids[3], res[1].ID)
+		assert.Equal(t, ids[0], res[2].ID)
+	})
+
+	t.Run("with a limit and offset", func(t *testing.T) {
+		res := search(t, &filters.Pagination{Limit: 1}, nil, "fox")
+		assert.Equal(t, []strfmt.UUID{ids[2]}, resultIDs(res))
+
+		res = search(t, &filters.Pagination{Offset: 1, Limit: 1}, nil, "fox")
+		assert.Equal(t, []strfmt.UUID{ids[0]}, resultIDs(res))
+	})
+
+	t.Run("combined with a where filter", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorGreaterThan,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: "wordCount",
+				},
+				Value: &filters.Value{
+					Value: 3,
+					Type:  schema.DataTypeInt,
+				},
+			},
+		}
+
+		res := search(t, all, filter, "fox")
+		assert.Equal(t, []strfmt.UUID{ids[0]}, resultIDs(res))
+	})
+
+	t.Run("on a non-text prop", func(t *testing.T) {
+		_, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: all,
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Type:       "bm25",
+				Query:      "7",
+				Properties: []string{"wordCount"},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("updating an object updates its terms and length", func(t *testing.T) {
+		updated := map[string]interface{}{
+			"title":     "Fox hunting is banned",
+			"body":      "no more fox hunting",
+			"code":      "FH-3",
+			"wordCount": int64(4),
+		}
+		put(t, 2, updated)
+
+		res := search(t, all, nil, "fox", "title")
+		require.Len(t, res, 2)
+		// "the quick brown fox" is now the shorter doc
+		assert.Equal(t, []strfmt.UUID{ids[0], ids[2]}, resultIDs(res))
+
+		res = search(t, all, nil, "banned")
+		assert.Equal(t, []strfmt.UUID{ids[2]}, resultIDs(res))
+	})
+
+	t.Run("deleted objects are no longer found", func(t *testing.T) {
+		require.Nil(t, repo.DeleteThing(context.Background(), class.Class, ids[0]))
+
+		res := search(t, all, nil, "fox", "title")
+		assert.Equal(t, []strfmt.UUID{ids[2]}, resultIDs(res))
+	})
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+// Certainty turns the distance between two vectors into a certainty between
+// 0 and 1, where 1 means identical, using the distance metric of the class.
+// Other than their distances, the certainties of classes with different
+// metrics can be compared with each other.
+func (db *DB) Certainty(k kind.Kind, className schema.ClassName,
+	a, b []float32) (float32, error) {
+	idx := db.GetIndex(k, className)
+	if idx == nil {
+		return 0, fmt.Errorf("certainty: class %s does not exist", className)
+	}
+
+	return idx.certainty(a, b)
+}
+
+func (i *Index) certainty(a, b []float32) (float32, error) {
+	if i.Config.Distance == "" || i.Config.Distance == schemaUC.DistanceCosine {
+		// identical to the certainty of classes which were created before the
+		// distance could be configured
+		dist, err := vectorizer.NormalizedDistance(a, b)
+		if err != nil {
+			return 0, err
+		}
+
+		return 1 - dist, nil
+	}
+
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("vectors have different dimensions")
+	}
+
+	dist, _, err := i.distancerProvider.New(a).Distance(b)
+	if err != nil {
+		return 0, errors.Wrap(err, "certainty")
+	}
+
+	return certaintyFromDistance(i.Config.Distance, dist), nil
+}
+
+// certaintyFromDistance maps the unbounded distances of all metrics other
+// than cosine to a certainty. The mapping decreases strictly with the
+// distance, so ordering by certainty is the same as ordering by distance.
+func certaintyFromDistance(metric string, dist float32) float32 {
+	switch metric {
+	case schemaUC.DistanceDot:
+		// the distance is the negative dot product, the certainty is the
+		// logistic function of the dot product, so orthogonal vectors have a
+		// certainty of 0.5
+		return float32(1 / (1 + math.Exp(float64(dist))))
+	default:
+		// l2-squared, manhattan and hamming distances are never negative, a
+		// distance of 1 is a certainty of 0.5
+		return 1 / (1 + dist)
+	}
+}
@@ -97,29 +97,30 @@ func (d *DB) ActionByID(ctx context.Context, id strfmt.UUID,
 
 func (d *DB) MultiGet(ctx context.Context,
 	query []multi.Identifier) ([]search.Result, error) {
-	byIndex := map[string][]multi.Identifier{}
+	byIndex := map[*Index][]multi.Identifier{}
+	indices := d.allIndices()
 
 	for i, q := range query {
 		// store original position to make assembly easier later
 		q.OriginalPosition = i
 
-		for _, index := range d.indices {
+		for _, index := range indices {
 			if index.Config.Kind != q.Kind ||
 				index.Config.ClassName != schema.ClassName(q.ClassName) {
 				continue
 			}
 
-			queue := byIndex[index.ID()]
+			queue := byIndex[index]
 			queue = append(queue, q)
-			byIndex[index.ID()] = queue
+			byIndex[index] = queue
 		}
 	}
 
 	out := make(search.Results, len(query))
-	for indexID, queries := range byIndex {
-		indexRes, err := d.indices[indexID].multiObjectByID(ctx, queries)
+	for index, queries := range byIndex {
+		indexRes, err := index.multiObjectByID(ctx, queries)
 		if err != nil {
-			return nil, errors.Wrapf(err, "index %q", indexID)
+			return nil, errors.Wrapf(err, "index %q", index.ID())
 		}
 
 		for i, obj := range indexRes {
@@ -140,7 +141,7 @@ func (d *DB) objectByID(ctx context.Context, kind kind.Kind, id strfmt.UUID,
 	var result *search.Result
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range d.indices {
+	for _, index := range d.allIndices() {
 		if index.Config.Kind != kind {
 			continue
 		}
@@ -177,7 +178,7 @@ func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range d.indices {
+	for _, index := range d.allIndices() {
 		ok, err := index.exists(ctx, id)
 		if err != nil {
 			return false, errors.Wrapf(err, "search index %s", index.ID())
@@ -445,7 +445,7 @@ func TestCRUD(t *testing.T) {
 
 	t.Run("searching all things", func(t *testing.T) {
 		// as the test suits grow we might have to extend the limit
-		res, err := repo.ThingSearch(context.Background(), 100, nil, traverser.UnderscoreProperties{})
+		res, err := repo.ThingSearch(context.Background(), &filters.Pagination{Limit: 100}, nil, nil, traverser.UnderscoreProperties{})
 		require.Nil(t, err)
 
 		item, ok := findID(res, thingID)
@@ -540,7 +540,7 @@ func TestCRUD(t *testing.T) {
 	// })
 
 	t.Run("searching all actions", func(t *testing.T) {
-		res, err := repo.ActionSearch(context.Background(), 10, nil, traverser.UnderscoreProperties{})
+		res, err := repo.ActionSearch(context.Background(), &filters.Pagination{Limit: 10}, nil, nil, traverser.UnderscoreProperties{})
 		require.Nil(t, err)
 
 		item, ok := findID(res, actionID)
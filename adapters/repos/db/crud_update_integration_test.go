@@ -98,7 +98,7 @@ func TestUpdateJourney(t *testing.T) {
 		})
 
 	searchInv := func(t *testing.T, op filters.Operator, value int) []interface{} {
-		res, err := repo.ThingSearch(context.Background(), 100,
+		res, err := repo.ThingSearch(context.Background(), &filters.Pagination{Limit: 100}, nil,
 			&filters.LocalFilter{
 				Root: &filters.Clause{
 					Operator: op,
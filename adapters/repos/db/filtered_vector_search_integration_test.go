@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilteredVectorSearch(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class: "FilteredVectorSearch",
+		VectorIndexConfig: &models.VectorIndexConfig{
+			Distance: "l2-squared",
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "bucket",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	require.Nil(t,
+		migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	// every object is placed in one of 50 buckets, so that a filter on a
+	// single bucket is very restrictive, whereas a filter on most buckets
+	// allows too many objects for a brute force search
+	const (
+		objectCount = 1500
+		buckets     = 50
+	)
+
+	type object struct {
+		id     strfmt.UUID
+		bucket int
+		vector []float32
+	}
+
+	objects := make([]object, objectCount)
+	for i := range objects {
+		objects[i] = object{
+			id:     strfmt.UUID(uuid.New().String()),
+			bucket: i % buckets,
+			vector: []float32{rand.Float32(), rand.Float32()},
+		}
+
+		err := repo.PutThing(context.Background(), &models.Thing{
+			Class:  class.Class,
+			ID:     objects[i].id,
+			Schema: map[string]interface{}{"bucket": int64(objects[i].bucket)},
+		}, objects[i].vector)
+		require.Nil(t, err)
+	}
+
+	query := []float32{0.5, 0.5}
+	expected := func(allowed func(bucket int) bool, k int) []strfmt.UUID {
+		type candidate struct {
+			id   strfmt.UUID
+			dist float64
+		}
+
+		var candidates []candidate
+		for _, obj := range objects {
+			if !allowed(obj.bucket) {
+				continue
+			}
+
+			dx := float64(obj.vector[0] - query[0])
+			dy := float64(obj.vector[1] - query[1])
+			candidates = append(candidates, candidate{
+				id:   obj.id,
+				dist: math.Pow(dx, 2) + math.Pow(dy, 2),
+			})
+		}
+
+		sort.Slice(candidates, func(a, b int) bool {
+			return candidates[a].dist < candidates[b].dist
+		})
+
+		var out []strfmt.UUID
+		for i := 0; i < k && i < len(candidates); i++ {
+			out = append(out, candidates[i].id)
+		}
+		return out
+	}
+
+	bucketFilter := func(operator filters.Operator, value int) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: operator,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: "bucket",
+				},
+				Value: &filters.Value{
+					Value: value,
+					Type:  schema.DataTypeInt,
+				},
+			},
+		}
+	}
+
+	search := func(filter *filters.LocalFilter, k int) []strfmt.UUID {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: k},
+			SearchVector: query,
+			Filters:      filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	t.Run("with a restrictive filter, results are exact", func(t *testing.T) {
+		ids := search(bucketFilter(filters.OperatorEqual, 7), 10)
+		assert.Equal(t, expected(func(b int) bool { return b == 7 }, 10), ids)
+	})
+
+	t.Run("with a filter allowing fewer than k objects", func(t *testing.T) {
+		ids := search(bucketFilter(filters.OperatorEqual, 7), 40)
+		assert.Equal(t, expected(func(b int) bool { return b == 7 }, 40), ids)
+		assert.Len(t, ids, objectCount/buckets)
+	})
+
+	t.Run("with a permissive filter, k allowed results are found", func(t *testing.T) {
+		allowed := func(b int) bool { return b < 45 }
+		ids := search(bucketFilter(filters.OperatorLessThan, 45), 25)
+		require.Len(t, ids, 25)
+
+		// the graph walk is approximate, so only check that every result
+		// matches the filter and that most of the exact results were found
+		allowedIDs := map[strfmt.UUID]struct{}{}
+		for _, obj := range objects {
+			if allowed(obj.bucket) {
+				allowedIDs[obj.id] = struct{}{}
+			}
+		}
+		for _, id := range ids {
+			assert.Contains(t, allowedIDs, id)
+		}
+
+		found := 0
+		for _, id := range expected(allowed, 25) {
+			for _, got := range ids {
+				if got == id {
+					found++
+					break
+				}
+			}
+		}
+		assert.GreaterOrEqual(t, found, 20)
+	})
+}
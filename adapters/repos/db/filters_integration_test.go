@@ -144,12 +144,41 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("modelName", "sprinter", neq, dtString),
 				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
 			},
-			// TODO:gh-1150 support like operator
-			// {
-			// 	name:        "modelName = spr*er",
-			// 	filter:      buildFilter("modelName", "sprinter", like, dtString),
-			// 	expectedIDs: []strfmt.UUID{carSprinterID},
-			// },
+			{
+				name:        "modelName like spr*er",
+				filter:      buildFilter("modelName", "spr*er", like, dtString),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name:        "modelName like p*",
+				filter:      buildFilter("modelName", "p*", like, dtString),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "modelName like *s",
+				filter:      buildFilter("modelName", "*s", like, dtString),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name:        "modelName like e6?s",
+				filter:      buildFilter("modelName", "e6?s", like, dtString),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name:        "modelName like ?olo",
+				filter:      buildFilter("modelName", "?olo", like, dtString),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "modelName like spr (no wildcard)",
+				filter:      buildFilter("modelName", "spr", like, dtString),
+				expectedIDs: []strfmt.UUID{},
+			},
+			{
+				name:        "contact like *@fastcars.example.com",
+				filter:      buildFilter("contact", "*@fastcars.example.com", like, dtString),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
 			{
 				name:        "weight == 3499.90",
 				filter:      buildFilter("weight", 3499.90, eq, dtNumber),
@@ -185,17 +214,32 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("weight", 2069.5, gte, dtNumber),
 				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
 			},
-			// TODO gh-1150 support date
-			// {
-			// 	name:        "before 1980",
-			// 	filter:      buildFilter("released", "1980-01-01T00:00:00+02:00", lt, dtDate),
-			// 	expectedIDs: []strfmt.UUID{carPoloID},
-			// },
-			// {
-			// 	name:        "from 1995 on",
-			// 	filter:      buildFilter("released", "1995-08-17T12:47:00+02:00", gte, dtDate),
-			// 	expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
-			// },
+			{
+				name:        "before 1980",
+				filter:      buildFilter("released", "1980-01-01T00:00:00+02:00", lt, dtDate),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "from 1995 on",
+				filter:      buildFilter("released", "1995-08-17T12:47:00+02:00", gte, dtDate),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
+			},
+			{
+				name:        "after 1995",
+				filter:      buildFilter("released", "1995-08-17T12:47:00+02:00", gt, dtDate),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name:        "exactly at the same instant in a different timezone",
+				filter:      buildFilter("released", "1995-08-17T10:47:00Z", eq, dtDate),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "until 1995 with a parsed date",
+				filter: buildFilter("released",
+					time.Date(1995, 8, 17, 10, 47, 0, 0, time.UTC), lte, dtDate),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
 			{
 				name:        "exactly matching a specific contact email",
 				filter:      buildFilter("contact", "john@heavycars.example.com", eq, dtString),
@@ -212,6 +256,18 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("description", "engine", eq, dtText),
 				expectedIDs: []strfmt.UUID{carPoloID},
 			},
+			{
+				name:        "full-text like Eng*",
+				filter:      buildFilter("description", "Eng*", like, dtText),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				// the sprinter matches both "car" and "heavycars", but must only be
+				// contained once
+				name:        "full-text like *car*",
+				filter:      buildFilter("description", "*car*", like, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID, carPoloID},
+			},
 			// {
 			// 	name: "within 600km of San Francisco",
 			// 	filter: buildFilter("parkedAt", filters.GeoRange{
@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatVectorIndex(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:      "FlatVectorIndex",
+		ShardCount: 2,
+		VectorIndexConfig: &models.VectorIndexConfig{
+			Type:     "flat",
+			Distance: "l2-squared",
+			// smaller than the shards, so that most vectors are read from disk
+			VectorCacheMaxObjects: 500,
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "even",
+				DataType: []string{string(schema.DataTypeBoolean)},
+			},
+		},
+	}
+	require.Nil(t,
+		migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	type object struct {
+		even   bool
+		vector []float32
+	}
+
+	objects := map[strfmt.UUID]object{}
+	put := func(id strfmt.UUID, even bool) {
+		obj := object{
+			even:   even,
+			vector: []float32{rand.Float32(), rand.Float32()},
+		}
+		objects[id] = obj
+
+		err := repo.PutThing(context.Background(), &models.Thing{
+			Class:  class.Class,
+			ID:     id,
+			Schema: map[string]interface{}{"even": obj.even},
+		}, obj.vector)
+		require.Nil(t, err)
+	}
+
+	query := []float32{0.5, 0.5}
+	expected := func(onlyEven bool, k int) []strfmt.UUID {
+		var candidates []strfmt.UUID
+		for id, obj := range objects {
+			if onlyEven && !obj.even {
+				continue
+			}
+			candidates = append(candidates, id)
+		}
+
+		dist := func(id strfmt.UUID) float32 {
+			v := objects[id].vector
+			dx, dy := v[0]-query[0], v[1]-query[1]
+			return dx*dx + dy*dy
+		}
+		sort.Slice(candidates, func(a, b int) bool {
+			return dist(candidates[a]) < dist(candidates[b])
+		})
+
+		if len(candidates) > k {
+			candidates = candidates[:k]
+		}
+		return candidates
+	}
+
+	evenFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "even",
+			},
+			Value: &filters.Value{
+				Value: true,
+				Type:  schema.DataTypeBoolean,
+			},
+		},
+	}
+
+	search := func(repo *DB, filter *filters.LocalFilter, k int) []strfmt.UUID {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: k},
+			SearchVector: query,
+			Filters:      filter,
+		})
+		require.Nil(t, err)
+
+		var ids []strfmt.UUID
+		for i := range res {
+			ids = append(ids, res[i].ID)
+		}
+		return ids
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i := 0; i < 3000; i++ {
+			put(strfmt.UUID(uuid.New().String()), i%2 == 0)
+		}
+	})
+
+	t.Run("vector search is exact", func(t *testing.T) {
+		assert.Equal(t, expected(false, 25), search(repo, nil, 25))
+	})
+
+	t.Run("filtered vector search is exact", func(t *testing.T) {
+		assert.Equal(t, expected(true, 25), search(repo, evenFilter, 25))
+	})
+
+	t.Run("deleted and updated objects are reflected", func(t *testing.T) {
+		closest := expected(false, 6)
+		for _, id := range closest[:3] {
+			require.Nil(t, repo.DeleteThing(context.Background(), class.Class, id))
+			delete(objects, id)
+		}
+
+		// a new vector moves the object to a new doc id
+		for _, id := range closest[3:] {
+			put(id, objects[id].even)
+		}
+
+		assert.Equal(t, expected(false, 25), search(repo, nil, 25))
+		assert.Equal(t, expected(true, 25), search(repo, evenFilter, 25))
+	})
+
+	t.Run("the vector cache is bounded", func(t *testing.T) {
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+
+		nodes := 0
+		for name := range idx.Shards {
+			stats, err := repo.VectorIndexStats(context.Background(), kind.Thing,
+				schema.ClassName(class.Class), name, false)
+			require.Nil(t, err)
+			assert.Equal(t, 500, stats.VectorCacheMaxObjects)
+			assert.LessOrEqual(t, stats.VectorCacheCount, 500)
+			nodes += stats.Nodes
+		}
+		assert.Equal(t, len(objects), nodes)
+	})
+
+	t.Run("no hnsw files are written", func(t *testing.T) {
+		matches, err := filepath.Glob(dirName + "/*.hnsw.*")
+		require.Nil(t, err)
+		assert.Len(t, matches, 0)
+	})
+
+	t.Run("the stored vectors are searched after a restart", func(t *testing.T) {
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.Nil(t, idx.shutdown())
+
+		restarted := New(logger, Config{RootPath: dirName})
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(30*time.Second))
+
+		assert.Equal(t, expected(false, 25), search(restarted, nil, 25))
+		assert.Equal(t, expected(true, 25), search(restarted, evenFilter, 25))
+	})
+}
@@ -11,11 +11,18 @@
 
 package helpers
 
-import "fmt"
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
 
 var (
-	ObjectsBucket []byte = []byte("objects")
-	IndexIDBucket []byte = []byte("index_ids")
+	ObjectsBucket     []byte = []byte("objects")
+	IndexIDBucket     []byte = []byte("index_ids")
+	DocLengthsBucket  []byte = []byte("doc_lengths")
+	PropLengthsBucket []byte = []byte("prop_lengths")
+	VectorQueueBucket []byte = []byte("vector_queue")
 )
 
 // BucketFromPropName creates the byte-representation used as the bucket name
@@ -30,3 +37,12 @@ func BucketFromPropName(propName string) []byte {
 func MetaCountProp(propName string) string {
 	return fmt.Sprintf("%s__meta_count", propName)
 }
+
+// DocLengthKey creates the key under which the length (i.e. number of terms)
+// of a single doc's prop is stored in the DocLengthsBucket
+func DocLengthKey(propName string, docID uint32) []byte {
+	buf := bytes.NewBuffer([]byte(propName))
+	buf.WriteByte(0)
+	binary.Write(buf, binary.LittleEndian, &docID)
+	return buf.Bytes()
+}
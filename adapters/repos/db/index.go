@@ -12,13 +12,23 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"hash/fnv"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/aggregator"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/sorter"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -26,6 +36,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus"
@@ -35,55 +46,315 @@ import (
 // class. An index can be further broken up into self-contained units, called
 // Shards, to allow for easy distribution across Nodes
 type Index struct {
-	Shards    map[string]*Shard
-	Config    IndexConfig
-	getSchema schemaUC.SchemaGetter
-	logger    logrus.FieldLogger
+	Shards            map[string]*Shard
+	Config            IndexConfig
+	getSchema         schemaUC.SchemaGetter
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+
+	// all writes hold a read lock, a backup holds the write lock, so that the
+	// files don't change while they are copied
+	backupLock sync.RWMutex
 }
 
-func (i Index) ID() string {
+func (i *Index) ID() string {
 	return indexID(i.Config.Kind, i.Config.ClassName)
 }
 
-// NewIndex - for now - always creates a single-shard index
+// NewIndex creates an index with as many shards as set in the config. Objects
+// are assigned to their shard by hashing their UUID, see shardForID
 func NewIndex(config IndexConfig, sg schemaUC.SchemaGetter,
 	logger logrus.FieldLogger) (*Index, error) {
-	index := &Index{
-		Config:    config,
-		Shards:    map[string]*Shard{},
-		getSchema: sg,
-		logger:    logger,
+	if config.ShardCount <= 0 {
+		config.ShardCount = 1
+	}
+
+	// the vector index can't be built without these settings, so they fall
+	// back to the schema defaults if they were not set explicitly
+	var defaults models.Class
+	if config.MaxConnections <= 0 {
+		config.MaxConnections = schemaUC.VectorMaxConnections(&defaults)
+	}
+	if config.EFConstruction <= 0 {
+		config.EFConstruction = schemaUC.VectorEFConstruction(&defaults)
 	}
 
-	// use explicit shard name "single" to indicate it's currently the only
-	// supported config
-	singleShard, err := NewShard("single", index)
+	dp, err := distanceProviderFromName(config.Distance)
 	if err != nil {
-		return nil, errors.Wrapf(err, "init index %s", index.ID())
+		return nil, errors.Wrapf(err, "init index %s", indexID(config.Kind, config.ClassName))
 	}
 
-	index.Shards["single"] = singleShard
-	return index, nil
-}
+	index := &Index{
+		Config:            config,
+		Shards:            map[string]*Shard{},
+		getSchema:         sg,
+		logger:            logger,
+		distancerProvider: dp,
+	}
 
-func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
+	for pos := 0; pos < config.ShardCount; pos++ {
+		name := index.shardName(pos)
+		shard, err := NewShard(name, index)
+		if err != nil {
+			// the shards which are open already would otherwise keep their files
+			// locked, so the index could never be opened again
+			index.shutdown()
+			return nil, errors.Wrapf(err, "init index %s", index.ID())
+		}
+
+		index.Shards[name] = shard
+	}
 
-	return shard.addProperty(ctx, prop)
+	return index, nil
 }
 
 type IndexConfig struct {
-	RootPath  string
-	Kind      kind.Kind
-	ClassName schema.ClassName
+	RootPath              string
+	Kind                  kind.Kind
+	ClassName             schema.ClassName
+	ShardCount            int
+	VectorIndexType       string
+	Distance              string
+	MaxConnections        int
+	EFConstruction        int
+	EF                    int
+	VectorCacheMaxObjects int
+	CleanupInterval       time.Duration
+	PQ                    hnsw.PQConfig
+	AsyncIndexing         bool
+	PrometheusMetrics     *monitoring.PrometheusMetrics // nil if monitoring is turned off
+}
+
+// updateVectorIndexConfig applies the updated settings to the vector indices
+// of all shards. The index config is updated as well, so that it always
+// reflects the currently active settings
+func (i *Index) updateVectorIndexConfig(cfg hnsw.UpdatableConfig) error {
+	err := i.forEachShardInParallel(func(pos int, shard *Shard) error {
+		if err := shard.vectorIndex.UpdateConfig(cfg); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "update vector index config")
+	}
+
+	i.Config.EF = cfg.EF
+	i.Config.VectorCacheMaxObjects = cfg.VectorCacheMaxObjects
+	i.Config.PQ = cfg.PQ
+	return nil
 }
 
 func indexID(kind kind.Kind, class schema.ClassName) string {
 	return strings.ToLower(fmt.Sprintf("%s_%s", kind, class))
 }
 
+// shardName builds the name of the shard at the specified position. Indices
+// with only one shard keep using the name "single", so that their files are
+// compatible with those written before multi-shard indices were introduced
+func (i *Index) shardName(pos int) string {
+	if i.Config.ShardCount == 1 {
+		return "single"
+	}
+
+	return fmt.Sprintf("shard%d", pos)
+}
+
+// shardForID determines which shard owns the object with the specified id.
+// The position is derived from a FNV-1a hash of the binary UUID, so that it
+// is stable across restarts for as long as the shard count does not change
+func (i *Index) shardForID(id strfmt.UUID) (*Shard, error) {
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid id %q", id)
+	}
+
+	if i.Config.ShardCount == 1 {
+		return i.Shards[i.shardName(0)], nil
+	}
+
+	h := fnv.New32a()
+	h.Write(parsed[:])
+	pos := int(h.Sum32() % uint32(i.Config.ShardCount))
+
+	return i.Shards[i.shardName(pos)], nil
+}
+
+func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
+	for _, shard := range i.Shards {
+		if err := shard.addProperty(ctx, prop); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) dropProperty(ctx context.Context, propName string) error {
+	for _, shard := range i.Shards {
+		if err := shard.dropProperty(ctx, propName); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) renameProperty(ctx context.Context, from, to string) error {
+	for _, shard := range i.Shards {
+		if err := shard.renameProperty(ctx, from, to); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+// shutdown closes all shards, the index must not be used afterwards
+func (i *Index) shutdown() error {
+	for _, shard := range i.Shards {
+		if err := shard.shutdown(); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+// drop closes all shards and removes their files from disk
+func (i *Index) drop() error {
+	for _, shard := range i.Shards {
+		if err := shard.drop(); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+// backup writes a copy of all files of the index into the specified root
+// path. Writes are blocked until the backup is complete, so the copies of all
+// shards are consistent with each other.
+func (i *Index) backup(rootPath string) error {
+	i.backupLock.Lock()
+	defer i.backupLock.Unlock()
+
+	for _, shard := range i.Shards {
+		if err := shard.backup(rootPath); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+// rename closes the index and moves the files of all shards, so that they
+// belong to the class with the new name. The returned index is opened on the
+// moved files and has the new class name set in every object. The schema
+// must already contain the renamed class.
+//
+// The index is closed even if the rename fails. Whenever possible the files
+// are then moved back and the returned index is the original one, opened
+// again under its previous name. Otherwise the returned index is nil and the
+// class can only be served again after the files were fixed manually.
+func (i *Index) rename(ctx context.Context,
+	newClassName schema.ClassName) (*Index, error) {
+	if err := i.shutdown(); err != nil {
+		// some shards may still be open, so the index can't be opened again
+		return nil, errors.Wrapf(err, "close index %s", i.ID())
+	}
+
+	renamed, restorable, err := i.moveAndOpen(ctx, newClassName)
+	if err == nil {
+		return renamed, nil
+	}
+
+	if !restorable {
+		return nil, err
+	}
+
+	restored, restoreErr := i.reopen(ctx)
+	if restoreErr != nil {
+		return nil, fmt.Errorf("%v, restore index %s: %v", err, i.ID(), restoreErr)
+	}
+
+	return restored, err
+}
+
+// moveAndOpen moves the files of all shards of the closed index to the class
+// and opens them. If that fails, it reports whether the files are back in
+// their previous location, so that the index can be opened again.
+func (i *Index) moveAndOpen(ctx context.Context,
+	className schema.ClassName) (*Index, bool, error) {
+	config := i.Config
+	config.ClassName = className
+	newID := indexID(config.Kind, className)
+
+	var moved []*Shard
+	moveBack := func() bool {
+		ok := true
+		for _, shard := range moved {
+			if err := renameShardFiles(config.RootPath, shardID(newID, shard.name),
+				shard.ID(), shard.geoPropNames()); err != nil {
+				i.logger.WithField("action", "rename_index").
+					WithField("shard", shard.ID()).
+					WithError(err).
+					Error("move shard files back to their previous location")
+				ok = false
+			}
+		}
+		return ok
+	}
+
+	for _, shard := range i.Shards {
+		if err := renameShardFiles(config.RootPath, shard.ID(),
+			shardID(newID, shard.name), shard.geoPropNames()); err != nil {
+			return nil, moveBack(), errors.Wrapf(err, "shard %s", shard.ID())
+		}
+		moved = append(moved, shard)
+	}
+
+	renamed, err := NewIndex(config, i.getSchema, i.logger)
+	if err != nil {
+		return nil, moveBack(), errors.Wrapf(err, "reopen index as %s", newID)
+	}
+
+	for _, shard := range renamed.Shards {
+		if err := shard.setClassOfObjects(ctx, className.String()); err != nil {
+			if closeErr := renamed.shutdown(); closeErr != nil {
+				return nil, false, errors.Wrapf(err, "shard %s (close renamed index: %v)",
+					shard.ID(), closeErr)
+			}
+			return nil, moveBack(), errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return renamed, false, nil
+}
+
+// reopen opens the files of the closed index again. Objects which were
+// assigned another class by a failed rename get their class back.
+func (i *Index) reopen(ctx context.Context) (*Index, error) {
+	reopened, err := NewIndex(i.Config, i.getSchema, i.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shard := range reopened.Shards {
+		if err := shard.setClassOfObjects(ctx, i.Config.ClassName.String()); err != nil {
+			reopened.shutdown()
+			return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return reopened, nil
+}
+
 func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
+	i.backupLock.RLock()
+	defer i.backupLock.RUnlock()
+
 	if i.Config.Kind != object.Kind {
 		return fmt.Errorf("cannot import object of kind %s into index of kind %s",
 			object.Kind, i.Config.Kind)
@@ -94,10 +365,12 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 			object.Class(), i.Config.ClassName)
 	}
 
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
-	err := shard.putObject(ctx, object)
+	shard, err := i.shardForID(object.ID())
 	if err != nil {
+		return err
+	}
+
+	if err := shard.putObject(ctx, object); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
@@ -107,27 +380,102 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object) map[int]error {
-	// TODO: pick the right shard(s) instead of using the "single" shard
-	shard := i.Shards["single"]
-	return shard.putObjectBatch(ctx, objects)
+	i.backupLock.RLock()
+	defer i.backupLock.RUnlock()
+
+	type shardQueue struct {
+		objects       []*storobj.Object
+		originalIndex []int
+	}
+
+	errs := map[int]error{}
+	byShard := map[string]shardQueue{}
+	for pos, obj := range objects {
+		shard, err := i.shardForID(obj.ID())
+		if err != nil {
+			errs[pos] = err
+			continue
+		}
+
+		queue := byShard[shard.name]
+		queue.objects = append(queue.objects, obj)
+		queue.originalIndex = append(queue.originalIndex, pos)
+		byShard[shard.name] = queue
+	}
+
+	m := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for shardName, queue := range byShard {
+		wg.Add(1)
+		go func(shard *Shard, queue shardQueue) {
+			defer wg.Done()
+			shardErrs := shard.putObjectBatch(ctx, queue.objects)
+			m.Lock()
+			for pos, err := range shardErrs {
+				errs[queue.originalIndex[pos]] = err
+			}
+			m.Unlock()
+		}(i.Shards[shardName], queue)
+	}
+	wg.Wait()
+
+	return errs
 }
 
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) addReferencesBatch(ctx context.Context,
 	refs kinds.BatchReferences) map[int]error {
-	// TODO: pick the right shard(s) instead of using the "single" shard
-	shard := i.Shards["single"]
-	return shard.addReferencesBatch(ctx, refs)
+	i.backupLock.RLock()
+	defer i.backupLock.RUnlock()
+
+	type shardQueue struct {
+		refs          kinds.BatchReferences
+		originalIndex []int
+	}
+
+	errs := map[int]error{}
+	byShard := map[string]shardQueue{}
+	for pos, ref := range refs {
+		shard, err := i.shardForID(ref.From.TargetID)
+		if err != nil {
+			errs[pos] = err
+			continue
+		}
+
+		queue := byShard[shard.name]
+		queue.refs = append(queue.refs, ref)
+		queue.originalIndex = append(queue.originalIndex, pos)
+		byShard[shard.name] = queue
+	}
+
+	m := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for shardName, queue := range byShard {
+		wg.Add(1)
+		go func(shard *Shard, queue shardQueue) {
+			defer wg.Done()
+			shardErrs := shard.addReferencesBatch(ctx, queue.refs)
+			m.Lock()
+			for pos, err := range shardErrs {
+				errs[queue.originalIndex[pos]] = err
+			}
+			m.Unlock()
+		}(i.Shards[shardName], queue)
+	}
+	wg.Wait()
+
+	return errs
 }
 
 func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	props traverser.SelectProperties, meta bool) (*storobj.Object, error) {
 	// TODO: don't ignore meta
 
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	shard, err := i.shardForID(id)
+	if err != nil {
+		return nil, err
+	}
 
-	shard := i.Shards["single"]
 	obj, err := shard.objectByID(ctx, id, props, meta)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -138,23 +486,46 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 
 func (i *Index) multiObjectByID(ctx context.Context,
 	query []multi.Identifier) ([]*storobj.Object, error) {
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	type shardQueue struct {
+		query         []multi.Identifier
+		originalIndex []int
+	}
 
-	shard := i.Shards["single"]
-	objects, err := shard.multiObjectByID(ctx, query)
-	if err != nil {
-		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	byShard := map[string]shardQueue{}
+	for pos, q := range query {
+		shard, err := i.shardForID(strfmt.UUID(q.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		queue := byShard[shard.name]
+		queue.query = append(queue.query, q)
+		queue.originalIndex = append(queue.originalIndex, pos)
+		byShard[shard.name] = queue
 	}
 
-	return objects, nil
+	out := make([]*storobj.Object, len(query))
+	for shardName, queue := range byShard {
+		shard := i.Shards[shardName]
+		objects, err := shard.multiObjectByID(ctx, queue.query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		for pos, obj := range objects {
+			out[queue.originalIndex[pos]] = obj
+		}
+	}
+
+	return out, nil
 }
 
 func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	shard, err := i.shardForID(id)
+	if err != nil {
+		return false, err
+	}
 
-	shard := i.Shards["single"]
 	ok, err := shard.exists(ctx, id)
 	if err != nil {
 		return false, errors.Wrapf(err, "shard %s", shard.ID())
@@ -164,38 +535,182 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 }
 
 func (i *Index) objectSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter,
+	filters *filters.LocalFilter, after strfmt.UUID, sort []filters.Sort,
 	meta bool) ([]*storobj.Object, error) {
 	// TODO: don't ignore meta
-	// TODO: search across all shards, rather than hard-coded "single" shard
 
-	shard := i.Shards["single"]
-	res, err := shard.objectSearch(ctx, limit, filters, meta)
+	perShard := make([][]*storobj.Object, len(i.Shards))
+	err := i.forEachShardInParallel(func(pos int, shard *Shard) error {
+		res, err := shard.objectSearch(ctx, limit, filters, after, sort, meta)
+		if err != nil {
+			return err
+		}
+
+		perShard[pos] = res
+		return nil
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		return nil, err
+	}
+
+	var out []*storobj.Object
+	for _, res := range perShard {
+		out = append(out, res...)
+	}
+
+	if len(sort) > 0 && len(perShard) > 1 {
+		out = sorter.Objects(out, sort)
+	} else if filters == nil && len(perShard) > 1 {
+		// unfiltered lists are ordered by id on each shard, so they need to be
+		// merged in the same order to keep pages stable
+		out = sortByID(out)
 	}
 
-	return res, nil
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out, nil
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	limit int, filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
+	limit int, filters *filters.LocalFilter, sort []filters.Sort,
+	meta bool) ([]*storobj.Object, error) {
 	// TODO: don't ignore meta
-	// TODO: search across all shards, rather than hard-coded "single" shard
 
-	shard := i.Shards["single"]
-	res, err := shard.objectVectorSearch(ctx, searchVector, limit, filters, meta)
+	perShard := make([][]*storobj.Object, len(i.Shards))
+	err := i.forEachShardInParallel(func(pos int, shard *Shard) error {
+		res, err := shard.objectVectorSearch(ctx, searchVector, limit, filters, meta)
+		if err != nil {
+			return err
+		}
+
+		perShard[pos] = res
+		return nil
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		return nil, err
+	}
+
+	if len(perShard) == 1 && len(sort) == 0 {
+		// results of a single shard are already in the right order
+		return perShard[0], nil
 	}
 
-	return res, nil
+	var out []*storobj.Object
+	for _, res := range perShard {
+		out = append(out, res...)
+	}
+
+	out, err = i.sortByDistanceToVector(out, searchVector, sort)
+	if err != nil {
+		return nil, errors.Wrap(err, "merge results of all shards")
+	}
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out, nil
+}
+
+func (i *Index) objectKeywordSearch(ctx context.Context, limit int,
+	filters *filters.LocalFilter, keywordRanking *traverser.KeywordRankingParams,
+	meta bool) ([]*storobj.Object, error) {
+	perShard := make([][]*storobj.Object, len(i.Shards))
+	err := i.forEachShardInParallel(func(pos int, shard *Shard) error {
+		res, err := shard.objectKeywordSearch(ctx, limit, filters, keywordRanking, meta)
+		if err != nil {
+			return err
+		}
+
+		perShard[pos] = res
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*storobj.Object
+	for _, res := range perShard {
+		out = append(out, res...)
+	}
+
+	if len(perShard) > 1 {
+		// each shard calculates the statistics on its own docs, so the scores
+		// are only approximately comparable, the same is true for sharded
+		// full-text search engines
+		sort.SliceStable(out, func(a, b int) bool {
+			return out[a].Score() > out[b].Score()
+		})
+	}
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out, nil
+}
+
+// sortByDistanceToVector is used to merge the (already sorted) results of
+// the individual shards into a single result set. If sort is set, the
+// properties are used to break ties between equally distant objects.
+func (i *Index) sortByDistanceToVector(objects []*storobj.Object,
+	vector []float32, sort []filters.Sort) ([]*storobj.Object, error) {
+	d := i.distancerProvider.New(vector)
+	distances := make([]float32, len(objects))
+	for pos, obj := range objects {
+		dist, _, err := d.Distance(obj.Vector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "distance to object %s", obj.ID())
+		}
+
+		distances[pos] = dist
+	}
+
+	return sorter.ObjectsByDistance(objects, distances, sort), nil
+}
+
+// sortByID orders objects the same way as they are ordered in the objects
+// bucket, i.e. by the binary representation of their id
+func sortByID(objects []*storobj.Object) []*storobj.Object {
+	ids := make([][]byte, len(objects))
+	for pos, obj := range objects {
+		parsed := uuid.MustParse(obj.ID().String())
+		ids[pos] = parsed[:]
+	}
+
+	sort.Sort(objectsByID{objects: objects, ids: ids})
+	return objects
+}
+
+type objectsByID struct {
+	objects []*storobj.Object
+	ids     [][]byte
+}
+
+func (o objectsByID) Len() int {
+	return len(o.objects)
+}
+
+func (o objectsByID) Less(a, b int) bool {
+	return bytes.Compare(o.ids[a], o.ids[b]) < 0
+}
+
+func (o objectsByID) Swap(a, b int) {
+	o.objects[a], o.objects[b] = o.objects[b], o.objects[a]
+	o.ids[a], o.ids[b] = o.ids[b], o.ids[a]
 }
 
 func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
-	// TODO: search across all shards, rather than hard-coded "single" shard
+	i.backupLock.RLock()
+	defer i.backupLock.RUnlock()
+
+	shard, err := i.shardForID(id)
+	if err != nil {
+		return err
+	}
 
-	shard := i.Shards["single"]
 	if err := shard.deleteObject(ctx, id); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -203,10 +718,87 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	return nil
 }
 
+// findObjectIDs returns the ids of all objects matching the filter across
+// all shards, ordered by id
+func (i *Index) findObjectIDs(ctx context.Context,
+	filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	perShard := make([][]strfmt.UUID, len(i.Shards))
+	err := i.forEachShardInParallel(func(pos int, shard *Shard) error {
+		res, err := shard.findObjectIDs(ctx, filters)
+		if err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		perShard[pos] = res
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []strfmt.UUID
+	for _, res := range perShard {
+		out = append(out, res...)
+	}
+
+	sort.Slice(out, func(a, b int) bool { return out[a] < out[b] })
+	return out, nil
+}
+
+// return value map[int]error gives the error for the index as it received it
+func (i *Index) deleteObjectBatch(ctx context.Context,
+	ids []strfmt.UUID) map[int]error {
+	i.backupLock.RLock()
+	defer i.backupLock.RUnlock()
+
+	type shardQueue struct {
+		ids           []strfmt.UUID
+		originalIndex []int
+	}
+
+	errs := map[int]error{}
+	byShard := map[string]shardQueue{}
+	for pos, id := range ids {
+		shard, err := i.shardForID(id)
+		if err != nil {
+			errs[pos] = err
+			continue
+		}
+
+		queue := byShard[shard.name]
+		queue.ids = append(queue.ids, id)
+		queue.originalIndex = append(queue.originalIndex, pos)
+		byShard[shard.name] = queue
+	}
+
+	m := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for shardName, queue := range byShard {
+		wg.Add(1)
+		go func(shard *Shard, queue shardQueue) {
+			defer wg.Done()
+			shardErrs := shard.deleteObjectBatch(ctx, queue.ids)
+			m.Lock()
+			for pos, err := range shardErrs {
+				errs[queue.originalIndex[pos]] = err
+			}
+			m.Unlock()
+		}(i.Shards[shardName], queue)
+	}
+	wg.Wait()
+
+	return errs
+}
+
 func (i *Index) mergeObject(ctx context.Context, merge kinds.MergeDocument) error {
-	// TODO: search across all shards, rather than hard-coded "single" shard
+	i.backupLock.RLock()
+	defer i.backupLock.RUnlock()
+
+	shard, err := i.shardForID(merge.ID)
+	if err != nil {
+		return err
+	}
 
-	shard := i.Shards["single"]
 	if err := shard.mergeObject(ctx, merge); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -218,14 +810,62 @@ func (i *Index) aggregate(ctx context.Context,
 	params traverser.AggregateParams) (*aggregation.Result, error) {
 	// TODO: don't ignore meta
 
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	if len(i.Shards) == 1 {
+		shard := i.Shards[i.shardName(0)]
+		res, err := shard.aggregate(ctx, params)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		return res, nil
+	}
+
+	perShard := make([]*aggregation.Result, len(i.Shards))
+	err := i.forEachShardInParallel(func(pos int, shard *Shard) error {
+		res, err := shard.aggregate(ctx, aggregator.ParamsForShard(params))
+		if err != nil {
+			return err
+		}
 
-	shard := i.Shards["single"]
-	obj, err := shard.aggregate(ctx, params)
+		perShard[pos] = res
+		return nil
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		return nil, err
 	}
 
-	return obj, nil
+	return aggregator.NewShardCombiner(params).Do(perShard), nil
+}
+
+// forEachShardInParallel calls f once for every shard. The position passed
+// to f is stable (ordered by shard name), so callers can use it to store
+// per-shard results in a pre-allocated slice. The first error is returned
+// and wrapped with the ID of the shard that produced it.
+func (i *Index) forEachShardInParallel(f func(pos int, shard *Shard) error) error {
+	names := make([]string, 0, len(i.Shards))
+	for name := range i.Shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, len(names))
+	wg := &sync.WaitGroup{}
+	for pos, name := range names {
+		wg.Add(1)
+		go func(pos int, shard *Shard) {
+			defer wg.Done()
+			if err := f(pos, shard); err != nil {
+				errs[pos] = errors.Wrapf(err, "shard %s", shard.ID())
+			}
+		}(pos, i.Shards[name])
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
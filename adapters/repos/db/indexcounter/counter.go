@@ -27,8 +27,7 @@ type Counter struct {
 }
 
 func New(shardID string, rootPath string) (*Counter, error) {
-	fileName := fmt.Sprintf("%s/%s.indexcount", rootPath, shardID)
-	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0o666)
+	f, err := os.OpenFile(fileName(shardID, rootPath), os.O_RDWR|os.O_CREATE, 0o666)
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +52,18 @@ func New(shardID string, rootPath string) (*Counter, error) {
 	}, nil
 }
 
+func fileName(shardID string, rootPath string) string {
+	return fmt.Sprintf("%s/%s.indexcount", rootPath, shardID)
+}
+
+// Get returns the number of ids handed out so far. As ids are never reused,
+// it is an upper bound for the number of objects in the shard
+func (c *Counter) Get() uint32 {
+	c.Lock()
+	defer c.Unlock()
+	return c.count
+}
+
 func (c *Counter) GetAndInc() (uint32, error) {
 	c.Lock()
 	defer c.Unlock()
@@ -66,3 +77,43 @@ func (c *Counter) GetAndInc() (uint32, error) {
 	c.f.Seek(0, 0)
 	return before, nil
 }
+
+// Close closes the underlying file, the counter must not be used afterwards
+func (c *Counter) Close() error {
+	c.Lock()
+	defer c.Unlock()
+	return c.f.Close()
+}
+
+// Drop closes the counter and removes its file from disk
+func (c *Counter) Drop() error {
+	if err := c.Close(); err != nil {
+		return errors.Wrap(err, "close counter")
+	}
+
+	if err := os.Remove(c.f.Name()); err != nil {
+		return errors.Wrap(err, "remove counter file")
+	}
+
+	return nil
+}
+
+// Backup writes the current count into a counter file for the specified
+// shard in the specified root path, a counter created from that path
+// continues where this one is now
+func (c *Counter) Backup(shardID string, rootPath string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	f, err := os.Create(fileName(shardID, rootPath))
+	if err != nil {
+		return errors.Wrap(err, "create counter backup file")
+	}
+	defer f.Close()
+
+	if err := binary.Write(f, binary.LittleEndian, &c.count); err != nil {
+		return errors.Wrap(err, "write counter backup file")
+	}
+
+	return f.Sync()
+}
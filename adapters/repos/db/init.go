@@ -13,10 +13,14 @@ package db
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 )
 
 // On init we get the current schema and create one index object per class.
@@ -30,33 +34,59 @@ func (d *DB) init() error {
 	things := d.schemaGetter.GetSchemaSkipAuth().Things
 	if things != nil {
 		for _, class := range things.Classes {
-			idx, err := NewIndex(IndexConfig{
-				Kind:      kind.Thing,
-				ClassName: schema.ClassName(class.Class),
-				RootPath:  d.config.RootPath,
-			}, d.schemaGetter, d.logger)
+			idx, err := NewIndex(d.indexConfigForClass(kind.Thing, class),
+				d.schemaGetter, d.logger)
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
 
-			d.indices[idx.ID()] = idx
+			d.setIndex(idx)
 		}
 	}
 
 	actions := d.schemaGetter.GetSchemaSkipAuth().Actions
 	if actions != nil {
 		for _, class := range actions.Classes {
-			idx, err := NewIndex(IndexConfig{
-				Kind:      kind.Action,
-				ClassName: schema.ClassName(class.Class),
-				RootPath:  d.config.RootPath,
-			}, d.schemaGetter, d.logger)
+			idx, err := NewIndex(d.indexConfigForClass(kind.Action, class),
+				d.schemaGetter, d.logger)
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
 
-			d.indices[idx.ID()] = idx
+			d.setIndex(idx)
 		}
 	}
 	return nil
 }
+
+// indexConfigForClass translates the settings of a class into an
+// IndexConfig, setting defaults for everything the user did not specify
+func (d *DB) indexConfigForClass(k kind.Kind, class *models.Class) IndexConfig {
+	return IndexConfig{
+		Kind:                  k,
+		ClassName:             schema.ClassName(class.Class),
+		RootPath:              d.config.RootPath,
+		ShardCount:            schemaUC.ShardCount(class),
+		VectorIndexType:       schemaUC.VectorIndexType(class),
+		Distance:              schemaUC.VectorDistance(class),
+		MaxConnections:        schemaUC.VectorMaxConnections(class),
+		EFConstruction:        schemaUC.VectorEFConstruction(class),
+		EF:                    schemaUC.VectorEF(class),
+		VectorCacheMaxObjects: schemaUC.VectorCacheMaxObjects(class),
+		CleanupInterval: time.Duration(
+			schemaUC.VectorCleanupIntervalSeconds(class)) * time.Second,
+		PQ:                pqConfigForClass(class),
+		AsyncIndexing:     schemaUC.VectorAsyncIndexing(class),
+		PrometheusMetrics: d.config.PrometheusMetrics,
+	}
+}
+
+func pqConfigForClass(class *models.Class) hnsw.PQConfig {
+	return hnsw.PQConfig{
+		Enabled:       schemaUC.VectorPQEnabled(class),
+		Segments:      schemaUC.VectorPQSegments(class),
+		Centroids:     schemaUC.VectorPQCentroids(class),
+		TrainingLimit: schemaUC.VectorPQTrainingLimit(class),
+		RescoreLimit:  schemaUC.VectorPQRescoreLimit(class),
+	}
+}
@@ -14,10 +14,10 @@ package inverted
 import (
 	"bytes"
 	"encoding/binary"
-	"strings"
-	"unicode"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
 type Countable struct {
@@ -29,6 +29,10 @@ type Property struct {
 	Name         string
 	Items        []Countable
 	HasFrequency bool
+
+	// Length is the number of terms (including duplicates) of props with a
+	// frequency. It is required to normalize scores by document length.
+	Length int
 }
 
 type Analyzer struct {
@@ -37,42 +41,36 @@ type Analyzer struct {
 // Text removes non alpha-numeric and splits into words, then aggregates
 // duplicates
 func (a *Analyzer) Text(in string) []Countable {
-	parts := strings.FieldsFunc(in, func(c rune) bool {
-		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
-	})
-
-	terms := map[string]uint32{}
-	total := 0
-	for _, word := range parts {
-		word = strings.ToLower(word)
-		count, ok := terms[word]
-		if !ok {
-			terms[word] = 0
-		}
-		terms[word] = count + 1
-		total++
-	}
-
-	out := make([]Countable, len(terms))
-	i := 0
-	for term, count := range terms {
-		out[i] = Countable{
-			Data:          []byte(term),
-			TermFrequency: float32(count) / float32(total),
-		}
-		i++
-	}
+	return a.countTerms(a.TextTerms(in))
+}
 
-	return out
+// TextTerms splits the input into lowercased words in the order in which
+// they appear, duplicates are kept. This is the default analysis of text
+// props, see textAnalyzer for props with a text analyzer config.
+func (a *Analyzer) TextTerms(in string) []string {
+	return newTextAnalyzer(&models.Property{
+		DataType: []string{string(schema.DataTypeText)},
+	}).Terms(in)
 }
 
 // String splits only on spaces and does not lowercase, then aggregates
 // duplicates
 func (a *Analyzer) String(in string) []Countable {
-	parts := strings.FieldsFunc(in, func(c rune) bool {
-		return unicode.IsSpace(c)
-	})
+	return a.countTerms(a.StringTerms(in))
+}
 
+// StringTerms splits the input on spaces only in the order in which the terms
+// appear, duplicates are kept. This is the default analysis of string props,
+// see textAnalyzer for props with a text analyzer config.
+func (a *Analyzer) StringTerms(in string) []string {
+	return newTextAnalyzer(&models.Property{
+		DataType: []string{string(schema.DataTypeString)},
+	}).Terms(in)
+}
+
+// countTerms aggregates duplicate terms, the term frequency is relative to
+// the total number of terms
+func (a *Analyzer) countTerms(parts []string) []Countable {
 	terms := map[string]uint32{}
 	total := 0
 	for _, word := range parts {
@@ -127,6 +125,21 @@ func (a *Analyzer) Float(in float64) ([]Countable, error) {
 	}, nil
 }
 
+// Date requires no analysis, so it's actually just a simple conversion to a
+// lexicographically sortable byte slice.
+func (a *Analyzer) Date(in time.Time) ([]Countable, error) {
+	data, err := LexicographicallySortableDate(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Countable{
+		Countable{
+			Data: data,
+		},
+	}, nil
+}
+
 // Bool requires no analysis, so it's actually just a simple conversion to a
 // little-endian ordered byte slice
 func (a *Analyzer) Bool(in bool) ([]Countable, error) {
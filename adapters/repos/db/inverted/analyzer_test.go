@@ -16,6 +16,7 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/stretchr/testify/assert"
@@ -135,6 +136,35 @@ func TestAnalyzer(t *testing.T) {
 		assert.Equal(t, results, afterSort)
 	})
 
+	t.Run("with date it stays sortable", func(t *testing.T) {
+		getData := func(in []Countable, err error) []byte {
+			require.Nil(t, err)
+			return in[0].Data
+		}
+
+		parse := func(in string) time.Time {
+			date, err := time.Parse(time.RFC3339Nano, in)
+			require.Nil(t, err)
+			return date
+		}
+
+		results := [][]byte{
+			getData(a.Date(parse("1700-01-01T00:00:00Z"))),
+			getData(a.Date(parse("1969-12-31T23:59:59.999999999Z"))),
+			getData(a.Date(parse("1970-01-01T00:00:00Z"))),
+			getData(a.Date(parse("1995-08-17T12:47:00+02:00"))),
+			getData(a.Date(parse("1995-08-17T10:47:00.000000001Z"))),
+			getData(a.Date(parse("2017-02-17T09:47:00+02:00"))),
+			getData(a.Date(parse("2017-02-17T09:47:00Z"))),
+			getData(a.Date(parse("2200-01-01T00:00:00Z"))),
+		}
+
+		afterSort := make([][]byte, len(results))
+		copy(afterSort, results)
+		sort.Slice(afterSort, func(a, b int) bool { return bytes.Compare(afterSort[a], afterSort[b]) == -1 })
+		assert.Equal(t, results, afterSort)
+	})
+
 	t.Run("with float it stays sortable", func(t *testing.T) {
 		getData := func(in []Countable, err error) []byte {
 			require.Nil(t, err)
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// likeRegexp is the compiled form of a Like pattern. A "*" matches any
+// sequence of characters (including none), a "?" matches exactly one
+// character, everything else is matched literally.
+type likeRegexp struct {
+	// optimizable is true if the pattern starts with at least one literal
+	// character, in this case the cursor can seek to the prefix instead of
+	// scanning the entire bucket
+	optimizable bool
+	prefix      []byte
+	regexp      *regexp.Regexp
+}
+
+func parseLikeRegexp(pattern []byte) (*likeRegexp, error) {
+	r, err := regexp.Compile(transformLikeStringToRegexp(pattern))
+	if err != nil {
+		return nil, errors.Wrap(err, "compile like pattern")
+	}
+
+	prefix := likePrefix(pattern)
+	return &likeRegexp{
+		optimizable: len(prefix) > 0,
+		prefix:      prefix,
+		regexp:      r,
+	}, nil
+}
+
+func (l *likeRegexp) match(k []byte) bool {
+	return l.regexp.Match(k)
+}
+
+// likePrefix returns the literal part of the pattern prior to the first
+// wildcard
+func likePrefix(pattern []byte) []byte {
+	pos := bytes.IndexAny(pattern, "*?")
+	if pos < 0 {
+		return pattern
+	}
+
+	return pattern[:pos]
+}
+
+func transformLikeStringToRegexp(in []byte) string {
+	var out bytes.Buffer
+	// (?s) lets "." match any character, including newlines
+	out.WriteString("(?s)^")
+	literal := []byte{}
+	flush := func() {
+		out.WriteString(regexp.QuoteMeta(string(literal)))
+		literal = literal[:0]
+	}
+
+	for _, c := range in {
+		switch c {
+		case '*':
+			flush()
+			out.WriteString(".*")
+		case '?':
+			flush()
+			out.WriteString(".")
+		default:
+			literal = append(literal, c)
+		}
+	}
+	flush()
+	out.WriteString("$")
+
+	return out.String()
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeRegexp(t *testing.T) {
+	type test struct {
+		subject        []byte
+		shouldMatch    bool
+		expectedPrefix []byte
+	}
+
+	run := func(t *testing.T, pattern string, tests []test) {
+		res, err := parseLikeRegexp([]byte(pattern))
+		require.Nil(t, err)
+		for _, tc := range tests {
+			t.Run(string(tc.subject), func(t *testing.T) {
+				assert.Equal(t, tc.shouldMatch, res.match(tc.subject))
+				assert.Equal(t, tc.expectedPrefix, res.prefix)
+				assert.Equal(t, len(tc.expectedPrefix) > 0, res.optimizable)
+			})
+		}
+	}
+
+	t.Run("without a wildcard", func(t *testing.T) {
+		run(t, "car", []test{
+			{subject: []byte("car"), shouldMatch: true, expectedPrefix: []byte("car")},
+			{subject: []byte("care"), shouldMatch: false, expectedPrefix: []byte("car")},
+			{subject: []byte("scar"), shouldMatch: false, expectedPrefix: []byte("car")},
+		})
+	})
+
+	t.Run("with a trailing *", func(t *testing.T) {
+		run(t, "car*", []test{
+			{subject: []byte("car"), shouldMatch: true, expectedPrefix: []byte("car")},
+			{subject: []byte("carpet"), shouldMatch: true, expectedPrefix: []byte("car")},
+			{subject: []byte("scar"), shouldMatch: false, expectedPrefix: []byte("car")},
+		})
+	})
+
+	t.Run("with a leading *", func(t *testing.T) {
+		run(t, "*car", []test{
+			{subject: []byte("car"), shouldMatch: true, expectedPrefix: []byte{}},
+			{subject: []byte("scar"), shouldMatch: true, expectedPrefix: []byte{}},
+			{subject: []byte("care"), shouldMatch: false, expectedPrefix: []byte{}},
+		})
+	})
+
+	t.Run("with a ? in the middle", func(t *testing.T) {
+		run(t, "c?r", []test{
+			{subject: []byte("car"), shouldMatch: true, expectedPrefix: []byte("c")},
+			{subject: []byte("cür"), shouldMatch: true, expectedPrefix: []byte("c")},
+			{subject: []byte("cr"), shouldMatch: false, expectedPrefix: []byte("c")},
+			{subject: []byte("caar"), shouldMatch: false, expectedPrefix: []byte("c")},
+		})
+	})
+
+	t.Run("with regexp meta characters", func(t *testing.T) {
+		run(t, "LDS-2.(a)*", []test{
+			{subject: []byte("LDS-2.(a)"), shouldMatch: true, expectedPrefix: []byte("LDS-2.(a)")},
+			{subject: []byte("LDS-2.(a)-b"), shouldMatch: true, expectedPrefix: []byte("LDS-2.(a)")},
+			{subject: []byte("LDS-22(a)"), shouldMatch: false, expectedPrefix: []byte("LDS-2.(a)")},
+		})
+	})
+}
@@ -65,21 +65,17 @@ func (a *Analyzer) Object(input map[string]interface{}, props []*models.Property
 func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}) (*Property, error) {
 	var hasFrequency bool
 	var items []Countable
+	var length int
 	switch schema.DataType(prop.DataType[0]) {
-	case schema.DataTypeText:
+	case schema.DataTypeText, schema.DataTypeString:
 		hasFrequency = true
 		asString, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(asString)
-	case schema.DataTypeString:
-		hasFrequency = true
-		asString, ok := value.(string)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
-		}
-		items = a.String(asString)
+		terms := newTextAnalyzer(prop).Terms(asString)
+		items = a.countTerms(terms)
+		length = len(terms)
 	case schema.DataTypeInt:
 		hasFrequency = false
 		if asFloat, ok := value.(float64); ok {
@@ -122,6 +118,17 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		if err != nil {
 			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
 		}
+	case schema.DataTypeDate:
+		hasFrequency = false
+		asDate, err := ParseDateValue(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
+		}
+
+		items, err = a.Date(asDate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
+		}
 
 	default:
 		// ignore unsupported prop type
@@ -132,6 +139,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		Name:         prop.Name,
 		Items:        items,
 		HasFrequency: hasFrequency,
+		Length:       length,
 	}, nil
 }
 
@@ -67,19 +67,24 @@ func TestAnalyzeObject(t *testing.T) {
 		require.Len(t, res, 2)
 		var actualDescription []Countable
 		var actualEmail []Countable
+		var descriptionLength, emailLength int
 
 		for _, elem := range res {
 			if elem.Name == "email" {
 				actualEmail = elem.Items
+				emailLength = elem.Length
 			}
 
 			if elem.Name == "description" {
 				actualDescription = elem.Items
+				descriptionLength = elem.Length
 			}
 		}
 
 		assert.ElementsMatch(t, expectedEmail, actualEmail, res)
 		assert.ElementsMatch(t, expectedDescription, actualDescription, res)
+		assert.Equal(t, 1, emailLength)
+		assert.Equal(t, 3, descriptionLength)
 	})
 
 	t.Run("with refProps", func(t *testing.T) {
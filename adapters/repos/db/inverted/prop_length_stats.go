@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// PropLengthStats are the document-length statistics of a single property
+// in a shard. They are required to normalize keyword scores by the length of
+// a document relative to the average length of the prop.
+type PropLengthStats struct {
+	// DocCount is the number of docs which have a value for the prop
+	DocCount uint32
+
+	// SumLength is the total number of terms of the prop across all docs
+	SumLength uint64
+}
+
+// Average length of the prop per doc, 0 if there are no docs
+func (s PropLengthStats) Average() float64 {
+	if s.DocCount == 0 {
+		return 0
+	}
+
+	return float64(s.SumLength) / float64(s.DocCount)
+}
+
+// Add a doc with the specified length
+func (s PropLengthStats) Add(length uint32) PropLengthStats {
+	s.DocCount++
+	s.SumLength += uint64(length)
+	return s
+}
+
+// Remove a doc with the specified length
+func (s PropLengthStats) Remove(length uint32) PropLengthStats {
+	if s.DocCount > 0 {
+		s.DocCount--
+	}
+
+	if s.SumLength >= uint64(length) {
+		s.SumLength -= uint64(length)
+	} else {
+		s.SumLength = 0
+	}
+
+	return s
+}
+
+// Bytes | Meaning
+// 0..3  | doc count as uint32 (little endian)
+// 4..11 | sum of lengths as uint64 (little endian)
+func (s PropLengthStats) MarshalBinary() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 12))
+	if err := binary.Write(buf, binary.LittleEndian, &s.DocCount); err != nil {
+		return nil, errors.Wrap(err, "write doc count")
+	}
+
+	if err := binary.Write(buf, binary.LittleEndian, &s.SumLength); err != nil {
+		return nil, errors.Wrap(err, "write sum of lengths")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PropLengthStatsFromBinary parses stats as written by MarshalBinary, an
+// empty input is parsed as empty stats, so that props without any docs
+// need no special treatment
+func PropLengthStatsFromBinary(in []byte) (PropLengthStats, error) {
+	var out PropLengthStats
+	if len(in) == 0 {
+		return out, nil
+	}
+
+	if len(in) != 12 {
+		return out, fmt.Errorf("invalid prop length stats: expected 12 bytes, got %d",
+			len(in))
+	}
+
+	r := bytes.NewReader(in)
+	if err := binary.Read(r, binary.LittleEndian, &out.DocCount); err != nil {
+		return out, errors.Wrap(err, "read doc count")
+	}
+
+	if err := binary.Read(r, binary.LittleEndian, &out.SumLength); err != nil {
+		return out, errors.Wrap(err, "read sum of lengths")
+	}
+
+	return out, nil
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropLengthStats(t *testing.T) {
+	t.Run("empty stats", func(t *testing.T) {
+		stats, err := PropLengthStatsFromBinary(nil)
+		require.Nil(t, err)
+		assert.Equal(t, PropLengthStats{}, stats)
+		assert.Equal(t, float64(0), stats.Average())
+	})
+
+	t.Run("adding and removing docs", func(t *testing.T) {
+		stats := PropLengthStats{}.Add(3).Add(5).Add(10)
+		assert.Equal(t, uint32(3), stats.DocCount)
+		assert.Equal(t, float64(6), stats.Average())
+
+		stats = stats.Remove(10)
+		assert.Equal(t, uint32(2), stats.DocCount)
+		assert.Equal(t, float64(4), stats.Average())
+
+		// removing more than was added never underflows
+		stats = stats.Remove(5).Remove(5).Remove(5)
+		assert.Equal(t, PropLengthStats{}, stats)
+	})
+
+	t.Run("serialization", func(t *testing.T) {
+		before := PropLengthStats{DocCount: 17, SumLength: 1 << 40}
+		data, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := PropLengthStatsFromBinary(data)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		_, err := PropLengthStatsFromBinary([]byte{1, 2, 3})
+		assert.NotNil(t, err)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 
 	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/notimplemented"
 	"github.com/semi-technologies/weaviate/entities/filters"
 )
@@ -69,6 +70,8 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
+	case filters.OperatorLike:
+		return rr.like(ctx, readFn)
 	default:
 		return fmt.Errorf("operator not supported (yet) in standalone "+
 			"mode, see %s for details", notimplemented.Link)
@@ -169,3 +172,48 @@ func (rr *RowReader) notEqual(ctx context.Context, readFn ReadFn) error {
 
 	return nil
 }
+
+// like reads all rows whose key matches the wildcard pattern. If the pattern
+// starts with a literal prefix, only the keys starting with this prefix are
+// read, otherwise the entire bucket needs to be scanned.
+func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
+	like, err := parseLikeRegexp(rr.value)
+	if err != nil {
+		return errors.Wrapf(err, "parse like value")
+	}
+
+	c := rr.bucket.Cursor()
+	var k, v []byte
+	if like.optimizable {
+		k, v = c.Seek(like.prefix)
+	} else {
+		k, v = c.First()
+	}
+
+	for ; k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if like.optimizable && !bytes.HasPrefix(k, like.prefix) {
+			// keys are sorted, so once the prefix no longer matches, no
+			// further key can match
+			break
+		}
+
+		if !like.match(k) {
+			continue
+		}
+
+		continueReading, err := readFn(k, v)
+		if err != nil {
+			return err
+		}
+
+		if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
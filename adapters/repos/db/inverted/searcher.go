@@ -25,7 +25,9 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 )
 
 type Searcher struct {
@@ -311,6 +313,12 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 
 	// if filter.Value.Filter.
 
+	if filter.Value.Type == schema.DataTypeText ||
+		filter.Value.Type == schema.DataTypeString {
+		return fs.extractTextProp(fs.textProp(className, props[0], filter.Value.Type),
+			filter.Value.Value, filter.Operator)
+	}
+
 	return fs.extractPrimitiveProp(props[0], filter.Value.Type, filter.Value.Value,
 		filter.Operator)
 }
@@ -320,12 +328,6 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	var extractValueFn func(in interface{}) ([]byte, error)
 	var hasFrequency bool
 	switch dt {
-	case schema.DataTypeText:
-		extractValueFn = fs.extractTextValue
-		hasFrequency = true
-	case schema.DataTypeString:
-		extractValueFn = fs.extractStringValue
-		hasFrequency = true
 	case schema.DataTypeBoolean:
 		extractValueFn = fs.extractBoolValue
 		hasFrequency = false
@@ -335,6 +337,9 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	case schema.DataTypeNumber:
 		extractValueFn = fs.extractNumberValue
 		hasFrequency = false
+	case schema.DataTypeDate:
+		extractValueFn = fs.extractDateValue
+		hasFrequency = false
 	case "":
 		return nil, fmt.Errorf("data type cannot be empty")
 	default:
@@ -342,6 +347,11 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 			"see %s for details", dt, notimplemented.Link)
 	}
 
+	if operator == filters.OperatorLike {
+		return nil, fmt.Errorf("operator like is only supported on data types "+
+			"%q and %q, got %q", schema.DataTypeText, schema.DataTypeString, dt)
+	}
+
 	byteValue, err := extractValueFn(value)
 	if err != nil {
 		return nil, err
@@ -355,6 +365,78 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	}, nil
 }
 
+// extractTextProp analyzes the value with the analyzer of the prop, so it
+// matches the terms which were indexed. If the value is analyzed into
+// multiple terms, an equal filter matches the docs which contain all of them.
+func (fs *Searcher) extractTextProp(prop *models.Property, value interface{},
+	operator filters.Operator) (*propValuePair, error) {
+	asString, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected value to be string, got %T", value)
+	}
+
+	analyzer := newTextAnalyzer(prop)
+	if operator == filters.OperatorLike {
+		if schemaUC.TextTokenizer(prop) == schemaUC.TokenizerNgram {
+			return nil, fmt.Errorf("operator like is not supported on prop %q, "+
+				"as it uses the %q tokenizer", prop.Name, schemaUC.TokenizerNgram)
+		}
+
+		terms := analyzer.LikePattern(asString)
+		if len(terms) != 1 {
+			return nil, fmt.Errorf("like operator requires exactly one search term, got: %v", terms)
+		}
+
+		return textPropValuePair(prop.Name, terms[0], operator), nil
+	}
+
+	terms := uniqueTerms(analyzer.Terms(asString))
+	switch {
+	case len(terms) == 0:
+		return nil, fmt.Errorf("no search terms left after analyzing %q, "+
+			"it only consists of stopwords or separators", asString)
+	case len(terms) == 1:
+		return textPropValuePair(prop.Name, terms[0], operator), nil
+	case operator != filters.OperatorEqual:
+		return nil, fmt.Errorf("only a single search term is allowed with "+
+			"operator %s, got: %v", operator.Name(), terms)
+	}
+
+	out := &propValuePair{
+		operator: filters.OperatorAnd,
+		children: make([]*propValuePair, len(terms)),
+	}
+	for i, term := range terms {
+		out.children[i] = textPropValuePair(prop.Name, term, operator)
+	}
+
+	return out, nil
+}
+
+func textPropValuePair(propName, term string,
+	operator filters.Operator) *propValuePair {
+	return &propValuePair{
+		value:        []byte(term),
+		hasFrequency: true,
+		prop:         propName,
+		operator:     operator,
+	}
+}
+
+// textProp returns the prop from the schema, so its analyzer can be used. If
+// it can't be found, a prop with the default analyzer of the data type is
+// returned.
+func (fs *Searcher) textProp(className schema.ClassName, propName string,
+	dt schema.DataType) *models.Property {
+	if c := fs.schema.FindClassByName(className); c != nil {
+		if prop, err := schema.GetPropertyByName(c, propName); err == nil {
+			return prop
+		}
+	}
+
+	return &models.Property{Name: propName, DataType: []string{string(dt)}}
+}
+
 func (fs *Searcher) extractReferenceCount(propName string, value interface{},
 	operator filters.Operator) (*propValuePair, error) {
 	byteValue, err := fs.extractIntCountValue(value)
@@ -443,6 +525,24 @@ type docPointer struct {
 	frequency *float32
 }
 
+// removeDuplicates keeps the first occurrence of each docID, the count is
+// adjusted accordingly
+func (d *docPointers) removeDuplicates() {
+	seen := make(map[uint32]struct{}, len(d.docIDs))
+	out := d.docIDs[:0]
+	for _, p := range d.docIDs {
+		if _, ok := seen[p.id]; ok {
+			continue
+		}
+
+		seen[p.id] = struct{}{}
+		out = append(out, p)
+	}
+
+	d.docIDs = out
+	d.count = uint32(len(out))
+}
+
 func (d docPointers) IDs() []uint32 {
 	out := make([]uint32, len(d.docIDs))
 	for i, elem := range d.docIDs {
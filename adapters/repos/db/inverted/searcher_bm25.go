@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+const (
+	// bm25K1 controls how quickly the score saturates with an increasing
+	// term frequency
+	bm25K1 = 1.2
+
+	// bm25B controls how strongly the score is normalized by document length
+	bm25B = 0.75
+)
+
+// BM25 ranks all docs which contain at least one of the query terms in one of
+// the specified properties using the Okapi BM25 ranking function. The query
+// is analyzed with the same analyzer that was used to index the respective
+// property. If no properties are specified, all text and string properties
+// of the class are searched. The scores of the individual properties are
+// summed up.
+//
+// The docIDs are returned in descending order of their score, scores[i] is
+// the score of docIDs[i]. If allow is set, only docIDs on the allow list are
+// returned, the statistics however are always calculated across all docs.
+func (f *Searcher) BM25(ctx context.Context, className schema.ClassName,
+	query string, properties []string, allow helpers.AllowList,
+	limit int) ([]uint32, []float32, error) {
+	props, err := f.keywordProps(className, properties)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scores := map[uint32]float64{}
+	err = f.db.View(func(tx *bolt.Tx) error {
+		for _, prop := range props {
+			if err := f.bm25Prop(ctx, tx, prop, query, allow, scores); err != nil {
+				return errors.Wrapf(err, "prop %q", prop.Name)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "bm25 bolt view tx")
+	}
+
+	ids, topScores := topScores(scores, limit)
+	return ids, topScores, nil
+}
+
+func (f *Searcher) bm25Prop(ctx context.Context, tx *bolt.Tx,
+	prop *models.Property, query string, allow helpers.AllowList,
+	scores map[uint32]float64) error {
+	bucketName := helpers.BucketFromPropName(prop.Name)
+	b := tx.Bucket(bucketName)
+	if b == nil {
+		// not indexed
+		return nil
+	}
+
+	stats, err := PropLengthStatsFromBinary(
+		tx.Bucket(helpers.PropLengthsBucket).Get([]byte(prop.Name)))
+	if err != nil {
+		return errors.Wrap(err, "read prop length stats")
+	}
+
+	lengths := tx.Bucket(helpers.DocLengthsBucket)
+	avgLength := stats.Average()
+
+	for _, term := range queryTerms(prop, query) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		row, err := f.parseInvertedIndexRow(rowID(bucketName, []byte(term)),
+			b.Get([]byte(term)), -1, true)
+		if err != nil {
+			return errors.Wrapf(err, "parse inverted index row of term %q", term)
+		}
+
+		df := float64(len(row.docIDs))
+		if df == 0 {
+			continue
+		}
+
+		// docs which were imported before lengths were recorded are not
+		// contained in the stats, the count can therefore be lower than the
+		// number of docs containing the term
+		n := math.Max(float64(stats.DocCount), df)
+		idf := bm25IDF(n, df)
+
+		for _, p := range row.docIDs {
+			if allow != nil && !allow.Contains(p.id) {
+				continue
+			}
+
+			docLength, ok := docLength(lengths, prop.Name, p.id)
+			if !ok {
+				docLength = avgLength
+			}
+
+			avg := avgLength
+			if avg == 0 {
+				avg = docLength
+			}
+
+			tf := termCount(*p.frequency, docLength)
+			scores[p.id] += bm25Score(tf, docLength, avg, idf)
+		}
+	}
+
+	return nil
+}
+
+// keywordProps returns the specified props or all text and string props if
+// none are specified
+func (f *Searcher) keywordProps(className schema.ClassName,
+	properties []string) ([]*models.Property, error) {
+	c := f.schema.FindClassByName(className)
+	if c == nil {
+		return nil, fmt.Errorf("class %q not found in schema", className)
+	}
+
+	if len(properties) == 0 {
+		var out []*models.Property
+		for _, prop := range c.Properties {
+			if isKeywordProp(prop) {
+				out = append(out, prop)
+			}
+		}
+
+		return out, nil
+	}
+
+	out := make([]*models.Property, len(properties))
+	for i, propName := range properties {
+		prop, err := schema.GetPropertyByName(c, propName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isKeywordProp(prop) {
+			return nil, fmt.Errorf("prop %q is of type %q, only props of type "+
+				"text and string can be used for a keyword search", prop.Name,
+				prop.DataType[0])
+		}
+
+		out[i] = prop
+	}
+
+	return out, nil
+}
+
+func isKeywordProp(prop *models.Property) bool {
+	if len(prop.DataType) != 1 {
+		return false
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeString:
+		return true
+	default:
+		return false
+	}
+}
+
+// queryTerms analyzes the query the same way as the values of the prop were
+// analyzed when they were indexed. Each term is only contained once.
+func queryTerms(prop *models.Property, query string) []string {
+	return uniqueTerms(newTextAnalyzer(prop).Terms(query))
+}
+
+// uniqueTerms removes duplicates, the order of the first occurrences is kept
+func uniqueTerms(terms []string) []string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+
+		seen[term] = struct{}{}
+		out = append(out, term)
+	}
+
+	return out
+}
+
+func docLength(b *bolt.Bucket, propName string, docID uint32) (float64, bool) {
+	data := b.Get(helpers.DocLengthKey(propName, docID))
+	if len(data) == 0 {
+		return 0, false
+	}
+
+	var length uint32
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian,
+		&length); err != nil {
+		return 0, false
+	}
+
+	return float64(length), true
+}
+
+// termCount restores the absolute number of occurrences of a term from the
+// relative frequency stored in the inverted index
+func termCount(frequency float32, docLength float64) float64 {
+	return math.Max(1, math.Round(float64(frequency)*docLength))
+}
+
+// bm25IDF is the inverse document frequency of a term which is contained in
+// df out of n docs. The +1 makes sure it never becomes negative, even if a
+// term is contained in more than half of all docs.
+func bm25IDF(n, df float64) float64 {
+	return math.Log(1 + (n-df+0.5)/(df+0.5))
+}
+
+func bm25Score(tf, docLength, avgLength, idf float64) float64 {
+	// without any lengths, e.g. if all docs were imported before lengths were
+	// recorded, there is nothing to normalize by
+	norm := float64(1)
+	if avgLength > 0 {
+		norm = 1 - bm25B + bm25B*docLength/avgLength
+	}
+
+	return idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+}
+
+// topScores orders the docIDs by descending score, equal scores are ordered
+// by docID so that the results are stable
+func topScores(scores map[uint32]float64,
+	limit int) ([]uint32, []float32) {
+	ids := make([]uint32, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(a, b int) bool {
+		if scores[ids[a]] != scores[ids[b]] {
+			return scores[ids[a]] > scores[ids[b]]
+		}
+
+		return ids[a] < ids[b]
+	})
+
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	out := make([]float32, len(ids))
+	for i, id := range ids {
+		out[i] = float32(scores[id])
+	}
+
+	return ids, out
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBM25Scoring(t *testing.T) {
+	t.Run("idf decreases with the doc frequency, but is never negative", func(t *testing.T) {
+		rare := bm25IDF(100, 1)
+		common := bm25IDF(100, 50)
+		everywhere := bm25IDF(100, 100)
+
+		assert.Greater(t, rare, common)
+		assert.Greater(t, common, everywhere)
+		assert.Greater(t, everywhere, float64(0))
+	})
+
+	t.Run("score saturates with the term frequency", func(t *testing.T) {
+		one := bm25Score(1, 10, 10, 1)
+		two := bm25Score(2, 10, 10, 1)
+		three := bm25Score(3, 10, 10, 1)
+		many := bm25Score(100, 10, 10, 1)
+
+		assert.Greater(t, two, one)
+		assert.Greater(t, two-one, three-two)
+		assert.Less(t, many, bm25K1+1)
+	})
+
+	t.Run("longer docs score lower", func(t *testing.T) {
+		assert.Greater(t, bm25Score(1, 5, 10, 1), bm25Score(1, 20, 10, 1))
+	})
+
+	t.Run("without any lengths the score is not normalized", func(t *testing.T) {
+		assert.Equal(t, bm25Score(1, 10, 10, 1), bm25Score(1, 0, 0, 1))
+	})
+
+	t.Run("restoring the term count from the frequency", func(t *testing.T) {
+		assert.Equal(t, float64(3), termCount(float32(3)/7, 7))
+		assert.Equal(t, float64(1), termCount(0.01, 7))
+	})
+
+	t.Run("top scores are ordered by score then id", func(t *testing.T) {
+		ids, scores := topScores(map[uint32]float64{
+			7: 0.5,
+			3: 2,
+			5: 0.5,
+			1: 0.1,
+		}, 3)
+
+		assert.Equal(t, []uint32{3, 5, 7}, ids)
+		assert.Equal(t, []float32{2, 0.5, 0.5}, scores)
+	})
+}
+
+func TestBM25QueryTerms(t *testing.T) {
+	text := &models.Property{Name: "text", DataType: []string{"text"}}
+	str := &models.Property{Name: "str", DataType: []string{"string"}}
+
+	assert.Equal(t, []string{"quick", "fox"}, queryTerms(text, "Quick, FOX! quick"))
+	assert.Equal(t, []string{"Quick,", "FOX!", "quick"}, queryTerms(str, "Quick, FOX! quick"))
+}
+
+func TestBM25WithoutDocLengths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bm25")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := bolt.Open(path.Join(dir, "bm25.db"), 0o600, nil)
+	require.Nil(t, err)
+	defer db.Close()
+
+	prop := &models.Property{Name: "description", DataType: []string{"text"}}
+	class := &models.Class{
+		Class:      "Car",
+		Properties: []*models.Property{prop},
+	}
+
+	// a row with a frequency for docs 1 and 2, but neither doc lengths nor
+	// prop length stats, as written before lengths were recorded
+	row := bytes.NewBuffer(make([]byte, 4))
+	binary.Write(row, binary.LittleEndian, uint32(2))
+	for _, docID := range []uint32{1, 2} {
+		binary.Write(row, binary.LittleEndian, docID)
+		binary.Write(row, binary.LittleEndian, float32(0.5))
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{helpers.DocLengthsBucket, helpers.PropLengthsBucket} {
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+		}
+
+		b, err := tx.CreateBucket(helpers.BucketFromPropName(prop.Name))
+		if err != nil {
+			return err
+		}
+
+		return b.Put([]byte("car"), row.Bytes())
+	})
+	require.Nil(t, err)
+
+	s := schema.Schema{Things: &models.Schema{Classes: []*models.Class{class}}}
+	searcher := NewSearcher(db, s, NewRowCacher(0), nil)
+	ids, scores, err := searcher.BM25(context.Background(), "Car", "car",
+		nil, nil, 10)
+	require.Nil(t, err)
+
+	assert.Equal(t, []uint32{1, 2}, ids)
+	require.Len(t, scores, 2)
+	for _, score := range scores {
+		assert.False(t, math.IsNaN(float64(score)))
+		assert.Greater(t, score, float32(0))
+	}
+}
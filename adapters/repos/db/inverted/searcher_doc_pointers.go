@@ -61,6 +61,13 @@ func (fs *Searcher) docPointersInverted(prop []byte, b *bolt.Bucket, limit int,
 		return pointers, errors.Wrap(err, "read row")
 	}
 
+	if pv.operator == filters.OperatorLike {
+		// unlike on the range operators a single doc can be contained in several
+		// of the matched rows, e.g. a text prop containing both "car" and "cart"
+		// matches "car*" twice
+		pointers.removeDuplicates()
+	}
+
 	newChecksum, err := fs.combineChecksums(hashes)
 	if err != nil {
 		return pointers, errors.Wrap(err, "greater than: calculate new checksum")
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// SortedDocIDs reads the inverted index of the property to sort by in the
+// requested order, so that the docIDs are sorted by their value without
+// having to load any object. This only works for properties whose keys in
+// the inverted index are lexicographically sortable, i.e. int, number and
+// boolean props.
+//
+// Reading stops at the end of the row in which the limit was reached, so all
+// docIDs which share the value at the cutoff are contained and ties can still
+// be broken by the caller. If allow is set, only docIDs on the allow list are
+// returned.
+//
+// If ok is false, the sort cannot be served from the inverted index alone,
+// either because the property type is not sortable or because fewer than
+// limit docIDs were found, which means that objects without a value for the
+// property would have to be added. The caller must fall back to sorting the
+// objects themselves in this case.
+func (f *Searcher) SortedDocIDs(ctx context.Context, className schema.ClassName,
+	sort filters.Sort, allow helpers.AllowList, limit int) ([]uint32, bool, error) {
+	if !f.onSortableProp(className, sort.Property()) {
+		return nil, false, nil
+	}
+
+	var out []uint32
+	complete := false
+	err := f.db.View(func(tx *bolt.Tx) error {
+		bucketName := helpers.BucketFromPropName(sort.Property())
+		b := tx.Bucket(bucketName)
+		if b == nil {
+			// not indexed
+			return nil
+		}
+
+		seen := map[uint32]struct{}{}
+		c := b.Cursor()
+		first, next := c.First, c.Next
+		if sort.Desc() {
+			first, next = c.Last, c.Prev
+		}
+
+		for k, v := first(); k != nil; k, v = next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			row, err := f.parseInvertedIndexRow(rowID(bucketName, k), v, -1, false)
+			if err != nil {
+				return errors.Wrap(err, "parse inverted index row")
+			}
+
+			for _, p := range row.docIDs {
+				if allow != nil && !allow.Contains(p.id) {
+					continue
+				}
+
+				if _, ok := seen[p.id]; ok {
+					// arrays have one entry per element, the first one is the relevant
+					// one for the requested order
+					continue
+				}
+
+				seen[p.id] = struct{}{}
+				out = append(out, p.id)
+			}
+
+			if len(out) >= limit {
+				complete = true
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "sorted doc ids bolt view tx")
+	}
+
+	return out, complete, nil
+}
+
+func (fs *Searcher) onSortableProp(className schema.ClassName, propName string) bool {
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return false
+	}
+
+	for _, prop := range c.Properties {
+		if prop.Name != propName {
+			continue
+		}
+
+		switch schema.DataType(prop.DataType[0]) {
+		case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return false
+}
@@ -15,45 +15,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"strings"
-	"unicode"
 
 	"github.com/pkg/errors"
 )
 
-func (fs Searcher) extractTextValue(in interface{}) ([]byte, error) {
-	value, ok := in.(string)
-	if !ok {
-		return nil, fmt.Errorf("expected value to be string, got %T", in)
-	}
-	parts := strings.FieldsFunc(value, func(c rune) bool {
-		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
-	})
-
-	// TODO: gh-1150 allow multi search term
-	if len(parts) > 1 {
-		return nil, fmt.Errorf("only single search term allowed at the moment, got: %v", parts)
-	}
-
-	return []byte(parts[0]), nil
-}
-
-func (fs Searcher) extractStringValue(in interface{}) ([]byte, error) {
-	value, ok := in.(string)
-	if !ok {
-		return nil, fmt.Errorf("expected value to be string, got %T", in)
-	}
-
-	parts := strings.FieldsFunc(value, func(c rune) bool {
-		return unicode.IsSpace(c)
-	})
-
-	// TODO: gh-1150 allow multi search term
-	if len(parts) > 1 {
-		return nil, fmt.Errorf("only single search term allowed at the moment, got: %v", parts)
-	}
-
-	return []byte(parts[0]), nil
+func isLikeWildcard(c rune) bool {
+	return c == '*' || c == '?'
 }
 
 func (fs Searcher) extractNumberValue(in interface{}) ([]byte, error) {
@@ -65,6 +32,15 @@ func (fs Searcher) extractNumberValue(in interface{}) ([]byte, error) {
 	return LexicographicallySortableFloat64(value)
 }
 
+func (fs Searcher) extractDateValue(in interface{}) ([]byte, error) {
+	value, err := ParseDateValue(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return LexicographicallySortableDate(value)
+}
+
 // assumes an untyped int and stores as string-formatted int64
 func (fs Searcher) extractIntValue(in interface{}) ([]byte, error) {
 	value, ok := in.(int)
@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -153,3 +154,61 @@ func ParseLexicographicallySortableUint32(in []byte) (uint32, error) {
 
 	return value, nil
 }
+
+// LexicographicallySortableDate stores the date as the seconds since the unix
+// epoch followed by the nanoseconds within that second, 12 bytes in total.
+// Unlike nanoseconds since the epoch, which only cover the years 1678 to
+// 2262, this covers every date time.Time can represent, such as historical
+// dates.
+func LexicographicallySortableDate(in time.Time) ([]byte, error) {
+	seconds, err := LexicographicallySortableInt64(in.Unix())
+	if err != nil {
+		return nil, errors.Wrap(err, "serialize seconds of date")
+	}
+
+	// always between 0 and 999,999,999, so they sort like the date after the
+	// seconds
+	nanos, err := LexicographicallySortableUint32(uint32(in.Nanosecond()))
+	if err != nil {
+		return nil, errors.Wrap(err, "serialize nanoseconds of date")
+	}
+
+	return append(seconds, nanos...), nil
+}
+
+// ParseLexicographicallySortableDate reverses the changes in
+// LexicographicallySortableDate, the date is returned in UTC
+func ParseLexicographicallySortableDate(in []byte) (time.Time, error) {
+	if len(in) != 12 {
+		return time.Time{}, fmt.Errorf("date must be 12 bytes long, got: %d", len(in))
+	}
+
+	seconds, err := ParseLexicographicallySortableInt64(in[:8])
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "deserialize seconds of date")
+	}
+
+	nanos, err := ParseLexicographicallySortableUint32(in[8:])
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "deserialize nanoseconds of date")
+	}
+
+	return time.Unix(seconds, int64(nanos)).UTC(), nil
+}
+
+// ParseDateValue accepts both a parsed date, as well as an RFC3339 formatted
+// string, which is what a date prop looks like after being stored as JSON
+func ParseDateValue(in interface{}) (time.Time, error) {
+	switch typed := in.(type) {
+	case time.Time:
+		return typed, nil
+	case string:
+		date, err := time.Parse(time.RFC3339Nano, typed)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("expected date to be RFC3339 formatted, got %q", typed)
+		}
+		return date, nil
+	default:
+		return time.Time{}, fmt.Errorf("expected date to be time.Time or string, got %T", in)
+	}
+}
@@ -12,9 +12,11 @@
 package inverted
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,6 +75,72 @@ func TestSerialization(t *testing.T) {
 		}
 	})
 
+	t.Run("date", func(t *testing.T) {
+		subjects := []time.Time{
+			time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(1066, 10, 14, 9, 0, 0, 0, time.UTC),
+			time.Date(1492, 10, 12, 0, 0, 0, 999999999, time.UTC),
+			time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(1969, 12, 31, 23, 59, 59, 500, time.UTC),
+			time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(1995, 8, 17, 10, 47, 0, 123, time.UTC),
+			time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2500, 1, 1, 0, 0, 0, 1, time.UTC),
+			time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		}
+
+		for _, sub := range subjects {
+			t.Run(fmt.Sprintf("with %s", sub), func(t *testing.T) {
+				bytes, err := LexicographicallySortableDate(sub)
+				require.Nil(t, err)
+
+				parsed, err := ParseLexicographicallySortableDate(bytes)
+				require.Nil(t, err)
+
+				assert.Equal(t, sub, parsed, "before and after must match")
+			})
+		}
+
+		t.Run("historical and distant dates keep their order", func(t *testing.T) {
+			for i := 1; i < len(subjects); i++ {
+				before, err := LexicographicallySortableDate(subjects[i-1])
+				require.Nil(t, err)
+
+				after, err := LexicographicallySortableDate(subjects[i])
+				require.Nil(t, err)
+
+				assert.Equal(t, -1, bytes.Compare(before, after),
+					"%s must sort before %s", subjects[i-1], subjects[i])
+			}
+		})
+
+		t.Run("from an invalid length", func(t *testing.T) {
+			_, err := ParseLexicographicallySortableDate(make([]byte, 8))
+			assert.NotNil(t, err)
+		})
+
+		t.Run("from a string in another timezone", func(t *testing.T) {
+			date, err := ParseDateValue("1995-08-17T12:47:00+02:00")
+			require.Nil(t, err)
+
+			bytes, err := LexicographicallySortableDate(date)
+			require.Nil(t, err)
+
+			parsed, err := ParseLexicographicallySortableDate(bytes)
+			require.Nil(t, err)
+
+			assert.Equal(t, time.Date(1995, 8, 17, 10, 47, 0, 0, time.UTC), parsed)
+		})
+
+		t.Run("from an invalid value", func(t *testing.T) {
+			_, err := ParseDateValue("17.08.1995")
+			assert.NotNil(t, err)
+
+			_, err = ParseDateValue(1995)
+			assert.NotNil(t, err)
+		})
+	})
+
 	t.Run("uint32", func(t *testing.T) {
 		subjects := []uint32{
 			0,
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/blevesearch/snowballstem"
+	"github.com/blevesearch/snowballstem/english"
+	"github.com/blevesearch/snowballstem/german"
+	"github.com/semi-technologies/weaviate/entities/models"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+)
+
+// textAnalyzer splits the values of a single text or string prop into terms
+// as configured in the prop's text analyzer config. Values are tokenized,
+// then optionally lowercased, stripped of stopwords and stemmed. The ngram
+// tokenizer finally splits the remaining words into n-grams.
+type textAnalyzer struct {
+	tokenizer           string
+	lowercase           bool
+	stopwords           map[string]struct{}
+	additionalStopwords []string
+	stemmer             string
+	ngramMin            int
+	ngramMax            int
+}
+
+func newTextAnalyzer(prop *models.Property) *textAnalyzer {
+	return &textAnalyzer{
+		tokenizer:           schemaUC.TextTokenizer(prop),
+		lowercase:           schemaUC.TextLowercase(prop),
+		stopwords:           stopwordPresets[schemaUC.TextStopwords(prop)],
+		additionalStopwords: schemaUC.TextAdditionalStopwords(prop),
+		stemmer:             schemaUC.TextStemmer(prop),
+		ngramMin:            schemaUC.TextNgramMinLength(prop),
+		ngramMax:            schemaUC.TextNgramMaxLength(prop),
+	}
+}
+
+// Terms analyzes the input into terms in the order in which they appear,
+// duplicates are kept
+func (a *textAnalyzer) Terms(in string) []string {
+	words := a.tokenize(in, false)
+
+	out := words[:0]
+	for _, word := range words {
+		if a.lowercase {
+			word = strings.ToLower(word)
+		}
+
+		if a.isStopword(word) {
+			continue
+		}
+
+		out = append(out, a.stem(word))
+	}
+
+	if a.tokenizer == schemaUC.TokenizerNgram {
+		return ngrams(out, a.ngramMin, a.ngramMax)
+	}
+
+	return out
+}
+
+// LikePattern analyzes the pattern of a like filter. Wildcards are kept and
+// the pattern is lowercased if the values are. As the pattern can't be
+// matched against partial words, neither stopwords nor stemming are applied.
+func (a *textAnalyzer) LikePattern(in string) []string {
+	words := a.tokenize(in, true)
+	if !a.lowercase {
+		return words
+	}
+
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+
+	return words
+}
+
+func (a *textAnalyzer) tokenize(in string, keepWildcards bool) []string {
+	switch a.tokenizer {
+	case schemaUC.TokenizerWhitespace:
+		return strings.FieldsFunc(in, unicode.IsSpace)
+	case schemaUC.TokenizerField:
+		trimmed := strings.TrimSpace(in)
+		if trimmed == "" {
+			return nil
+		}
+		return []string{trimmed}
+	default:
+		return strings.FieldsFunc(in, func(c rune) bool {
+			if keepWildcards && isLikeWildcard(c) {
+				return false
+			}
+			return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+		})
+	}
+}
+
+// isStopword is case-insensitive, so stopwords are removed even if the terms
+// aren't lowercased
+func (a *textAnalyzer) isStopword(word string) bool {
+	if a.stopwords == nil && len(a.additionalStopwords) == 0 {
+		return false
+	}
+
+	lower := strings.ToLower(word)
+	if _, ok := a.stopwords[lower]; ok {
+		return true
+	}
+
+	for _, stopword := range a.additionalStopwords {
+		if strings.ToLower(stopword) == lower {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *textAnalyzer) stem(word string) string {
+	var stemFn func(env *snowballstem.Env) bool
+	switch a.stemmer {
+	case schemaUC.LanguageEnglish:
+		stemFn = english.Stem
+	case schemaUC.LanguageGerman:
+		stemFn = german.Stem
+	default:
+		return word
+	}
+
+	env := snowballstem.NewEnv(word)
+	stemFn(env)
+	return env.Current()
+}
+
+// ngrams splits each word into all of its character n-grams with a length
+// between min and max. Words shorter than min are kept as they are.
+func ngrams(words []string, min, max int) []string {
+	var out []string
+	for _, word := range words {
+		runes := []rune(word)
+		if len(runes) < min {
+			out = append(out, word)
+			continue
+		}
+
+		for n := min; n <= max && n <= len(runes); n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				out = append(out, string(runes[i:i+n]))
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextAnalyzer(t *testing.T) {
+	lowercase := true
+	noLowercase := false
+
+	type test struct {
+		name     string
+		dataType string
+		config   *models.TextAnalyzerConfig
+		input    string
+		expected []string
+	}
+
+	tests := []test{
+		{
+			name:     "text prop with defaults",
+			dataType: "text",
+			input:    "Hello, my name is John-Doe",
+			expected: []string{"hello", "my", "name", "is", "john", "doe"},
+		},
+		{
+			name:     "string prop with defaults",
+			dataType: "string",
+			input:    "Hello, my name is John-Doe",
+			expected: []string{"Hello,", "my", "name", "is", "John-Doe"},
+		},
+		{
+			name:     "text prop without lowercasing",
+			dataType: "text",
+			config:   &models.TextAnalyzerConfig{Lowercase: &noLowercase},
+			input:    "Hello, my name is John-Doe",
+			expected: []string{"Hello", "my", "name", "is", "John", "Doe"},
+		},
+		{
+			name:     "string prop with lowercasing",
+			dataType: "string",
+			config:   &models.TextAnalyzerConfig{Lowercase: &lowercase},
+			input:    "Hello, my name is John-Doe",
+			expected: []string{"hello,", "my", "name", "is", "john-doe"},
+		},
+		{
+			name:     "whitespace tokenizer",
+			dataType: "text",
+			config:   &models.TextAnalyzerConfig{Tokenizer: "whitespace"},
+			input:    "e-mail  me\tat john@example.com",
+			expected: []string{"e-mail", "me", "at", "john@example.com"},
+		},
+		{
+			name:     "field tokenizer",
+			dataType: "string",
+			config:   &models.TextAnalyzerConfig{Tokenizer: "field"},
+			input:    "  New York City ",
+			expected: []string{"New York City"},
+		},
+		{
+			name:     "field tokenizer on an empty value",
+			dataType: "string",
+			config:   &models.TextAnalyzerConfig{Tokenizer: "field"},
+			input:    "   ",
+			expected: nil,
+		},
+		{
+			name:     "english stopwords and stemming",
+			dataType: "text",
+			config: &models.TextAnalyzerConfig{
+				Stopwords: "english",
+				Stemmer:   "english",
+			},
+			input:    "The cars are running in the parks",
+			expected: []string{"car", "run", "park"},
+		},
+		{
+			name:     "german stopwords and stemming",
+			dataType: "text",
+			config: &models.TextAnalyzerConfig{
+				Stopwords: "german",
+				Stemmer:   "german",
+			},
+			input:    "Die Häuser und die Gärten",
+			expected: []string{"haus", "gart"},
+		},
+		{
+			name:     "stopwords are case-insensitive",
+			dataType: "string",
+			config: &models.TextAnalyzerConfig{
+				Stopwords:           "english",
+				AdditionalStopwords: []string{"Weaviate"},
+			},
+			input:    "The Power of weaviate",
+			expected: []string{"Power"},
+		},
+		{
+			name:     "ngram tokenizer",
+			dataType: "text",
+			config: &models.TextAnalyzerConfig{
+				Tokenizer:      "ngram",
+				NgramMinLength: 2,
+				NgramMaxLength: 3,
+			},
+			input:    "Wien a",
+			expected: []string{"wi", "ie", "en", "wie", "ien", "a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := newTextAnalyzer(&models.Property{
+				Name:         "prop",
+				DataType:     []string{test.dataType},
+				TextAnalyzer: test.config,
+			})
+
+			assert.Equal(t, test.expected, a.Terms(test.input))
+		})
+	}
+
+	t.Run("like patterns keep wildcards and skip stemming", func(t *testing.T) {
+		a := newTextAnalyzer(&models.Property{
+			Name:     "prop",
+			DataType: []string{"text"},
+			TextAnalyzer: &models.TextAnalyzerConfig{
+				Stemmer: "english",
+			},
+		})
+
+		assert.Equal(t, []string{"runn*"}, a.LikePattern("Runn*"))
+	})
+}
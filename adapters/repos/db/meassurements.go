@@ -14,18 +14,52 @@ package db
 import (
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/sirupsen/logrus"
 )
 
-// TODO: these are currently only used for trace logging, but they would also
-// be a perfect point to introduce application monitoring
-
+// Metrics are used for trace logging. If monitoring is turned on, some of
+// them are also recorded for scraping by prometheus.
 type Metrics struct {
-	logger logrus.FieldLogger
+	logger        logrus.FieldLogger
+	batchSize     prometheus.Observer
+	batchDuration prometheus.Observer
+	objectCount   prometheus.Gauge
+
+	prom      *monitoring.PrometheusMetrics
+	className string
+	shardName string
+}
+
+// NewMetrics labels all collectors with the class and shard. Only trace
+// logging happens if prom is nil.
+func NewMetrics(logger logrus.FieldLogger, prom *monitoring.PrometheusMetrics,
+	className, shardName string) *Metrics {
+	m := &Metrics{
+		logger:    logger,
+		prom:      prom,
+		className: className,
+		shardName: shardName,
+	}
+	if prom == nil {
+		return m
+	}
+
+	m.batchSize = prom.BatchSize.WithLabelValues(className, shardName)
+	m.batchDuration = prom.BatchDuration.WithLabelValues(className, shardName)
+	m.objectCount = prom.ObjectCount.WithLabelValues(className, shardName)
+	return m
 }
 
-func NewMetrics(logger logrus.FieldLogger) *Metrics {
-	return &Metrics{logger: logger}
+// DeleteShard removes the series of the shard, including those of its vector
+// index. Nothing must be recorded for the shard afterwards.
+func (m *Metrics) DeleteShard() {
+	m.prom.DeleteShard(m.className, m.shardName)
+}
+
+func (m *Metrics) enabled() bool {
+	return m.objectCount != nil
 }
 
 func (m *Metrics) BatchObject(start time.Time, size int) {
@@ -34,6 +68,31 @@ func (m *Metrics) BatchObject(start time.Time, size int) {
 		WithField("batch_size", size).
 		WithField("took", took).
 		Tracef("object batch took %s", took)
+
+	if m.enabled() {
+		m.batchSize.Observe(float64(size))
+		m.batchDuration.Observe(took.Seconds())
+	}
+}
+
+func (m *Metrics) SetObjectCount(count int) {
+	if m.enabled() {
+		m.objectCount.Set(float64(count))
+	}
+}
+
+// ObjectsAdded is called with the number of objects which did not exist
+// before, updates of existing objects don't change the count
+func (m *Metrics) ObjectsAdded(count int) {
+	if m.enabled() {
+		m.objectCount.Add(float64(count))
+	}
+}
+
+func (m *Metrics) ObjectDeleted() {
+	if m.enabled() {
+		m.objectCount.Dec()
+	}
 }
 
 func (m *Metrics) ObjectStore(start time.Time) {
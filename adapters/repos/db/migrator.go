@@ -16,9 +16,11 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,11 +30,8 @@ type Migrator struct {
 }
 
 func (m *Migrator) AddClass(ctx context.Context, kind kind.Kind, class *models.Class) error {
-	idx, err := NewIndex(IndexConfig{
-		Kind:      kind,
-		ClassName: schema.ClassName(class.Class),
-		RootPath:  m.db.config.RootPath,
-	}, m.db.schemaGetter, m.logger)
+	idx, err := NewIndex(m.db.indexConfigForClass(kind, class),
+		m.db.schemaGetter, m.logger)
 	if err != nil {
 		return errors.Wrap(err, "create index")
 	}
@@ -44,16 +43,72 @@ func (m *Migrator) AddClass(ctx context.Context, kind kind.Kind, class *models.C
 		}
 	}
 
-	m.db.indices[idx.ID()] = idx
+	m.db.setIndex(idx)
 	return nil
 }
 
+// DropClass closes the class' index and removes all of its files from disk
 func (m *Migrator) DropClass(ctx context.Context, kind kind.Kind, className string) error {
-	return fmt.Errorf("dropping a class not (yet) supported")
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot drop a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	// unregistered first, so that the index is not used while it is dropped
+	m.db.deleteIndex(idx.ID())
+	if err := idx.drop(); err != nil {
+		return errors.Wrapf(err, "drop idx '%s'", idx.ID())
+	}
+
+	return nil
 }
 
+// UpdateClass renames the class' index if a new class name is set. Keywords
+// are not used by the standalone db, so there is nothing to do for them.
 func (m *Migrator) UpdateClass(ctx context.Context, kind kind.Kind, className string, newClassName *string, newKeywords *models.Keywords) error {
-	return fmt.Errorf("updating a class not (yet) supported")
+	if newClassName == nil || *newClassName == className {
+		return nil
+	}
+
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot update a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	if m.db.GetIndex(kind, schema.ClassName(*newClassName)) != nil {
+		return fmt.Errorf("cannot rename index for %s/%s: an index for %s already exists",
+			kind.Name(), className, *newClassName)
+	}
+
+	// the index is closed by the rename, so it is replaced even if the rename
+	// fails: either by the index reopened under its previous name or, if that
+	// was not possible, by nothing, so that the closed index is not used
+	renamed, err := idx.rename(ctx, schema.ClassName(*newClassName))
+	m.db.replaceIndex(idx.ID(), renamed)
+	if err != nil {
+		return errors.Wrapf(err, "rename idx '%s'", idx.ID())
+	}
+
+	return nil
+}
+
+// UpdateVectorIndexConfig applies the settings which can be changed on a live
+// class to all shards of the class' index
+func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context, kind kind.Kind, className string, updated *models.VectorIndexConfig) error {
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot update vector index config of a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	class := &models.Class{Class: className, VectorIndexConfig: updated}
+	return idx.updateVectorIndexConfig(hnsw.UpdatableConfig{
+		EF:                    schemaUC.VectorEF(class),
+		VectorCacheMaxObjects: schemaUC.VectorCacheMaxObjects(class),
+		PQ:                    pqConfigForClass(class),
+	})
 }
 
 func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
@@ -67,15 +122,53 @@ func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className st
 }
 
 func (m *Migrator) DropProperty(ctx context.Context, kind kind.Kind, className string, propertyName string) error {
-	return fmt.Errorf("dropping a property not (yet) supported")
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot drop property of a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	return idx.dropProperty(ctx, propertyName)
 }
 
+// UpdateProperty renames the property if a new name is set. Keywords are not
+// used by the standalone db, so there is nothing to do for them.
 func (m *Migrator) UpdateProperty(ctx context.Context, kind kind.Kind, className string, propName string, newName *string, newKeywords *models.Keywords) error {
-	return fmt.Errorf("changing a property not (yet) supported")
+	if newName == nil || *newName == propName {
+		return nil
+	}
+
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot update property of a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	return idx.renameProperty(ctx, propName, *newName)
 }
 
+// UpdatePropertyAddDataType extends the index layout of the property, e.g.
+// the meta count bucket of a ref prop. The schema must already contain the
+// new data type.
 func (m *Migrator) UpdatePropertyAddDataType(ctx context.Context, kind kind.Kind, className string, propName string, newDataType string) error {
-	return fmt.Errorf("changing a property not (yet) supported")
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot update property of a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	sch := m.db.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return fmt.Errorf("class %s/%s not found in schema", kind.Name(), className)
+	}
+
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return err
+	}
+
+	return idx.addProperty(ctx, prop)
 }
 
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
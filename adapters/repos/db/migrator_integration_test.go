@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "MigrationCar",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:     "description",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+			&models.Property{
+				Name:     "location",
+				DataType: []string{string(schema.DataTypeGeoCoordinates)},
+			},
+			&models.Property{
+				Name:     "parkedAt",
+				DataType: []string{"MigrationGarage"},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i := range ids {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class: class.Class,
+				ID:    ids[i],
+				Schema: map[string]interface{}{
+					"name":        fmt.Sprintf("car%d", i),
+					"description": "a car which needs to be migrated",
+					"location": &models.GeoCoordinates{
+						Latitude:  ptFloat32(52.5),
+						Longitude: ptFloat32(13.4),
+					},
+				},
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+	})
+
+	searchByName := func(t *testing.T, className, propName string) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(className),
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{
+						Value: "car3",
+						Type:  schema.DataTypeString,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID
+		}
+		return out
+	}
+
+	t.Run("renaming a property", func(t *testing.T) {
+		class.Properties[0].Name = "title"
+		newName := "title"
+		err := migrator.UpdateProperty(context.Background(), kind.Thing,
+			class.Class, "name", &newName, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []strfmt.UUID{ids[3]},
+			searchByName(t, class.Class, "title"))
+
+		res, err := repo.ThingByID(context.Background(), ids[3], nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Schema.(map[string]interface{})
+		assert.Equal(t, "car3", props["title"])
+		assert.NotContains(t, props, "name")
+	})
+
+	t.Run("dropping a property", func(t *testing.T) {
+		err := migrator.DropProperty(context.Background(), kind.Thing,
+			class.Class, "description")
+		require.Nil(t, err)
+		class.Properties = append(class.Properties[:1], class.Properties[2:]...)
+
+		res, err := repo.ThingByID(context.Background(), ids[3], nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.NotContains(t, res.Schema.(map[string]interface{}), "description")
+	})
+
+	t.Run("adding a data type to a ref property", func(t *testing.T) {
+		class.Properties[2].DataType = append(class.Properties[2].DataType,
+			"MigrationParkingLot")
+		err := migrator.UpdatePropertyAddDataType(context.Background(),
+			kind.Thing, class.Class, "parkedAt", "MigrationParkingLot")
+		require.Nil(t, err)
+
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		for _, shard := range idx.Shards {
+			shard.db.View(func(tx *bolt.Tx) error {
+				assert.NotNil(t, tx.Bucket(helpers.BucketFromPropName(
+					helpers.MetaCountProp("parkedAt"))))
+				return nil
+			})
+		}
+	})
+
+	t.Run("a rename which fails keeps the index under its previous name", func(t *testing.T) {
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+
+		// a non-empty directory at the destination of the commit logs can't be
+		// replaced, so the rename fails after other files were moved already
+		blockedID := indexID(kind.Thing, "BlockedCar")
+		for name := range idx.Shards {
+			blocker := fmt.Sprintf("%s/%s.hnsw.commitlog.d/blocker", dirName,
+				shardID(blockedID, name))
+			require.Nil(t, os.MkdirAll(blocker, 0o777))
+		}
+
+		newName := "BlockedCar"
+		err := migrator.UpdateClass(context.Background(), kind.Thing,
+			class.Class, &newName, nil)
+		assert.NotNil(t, err)
+
+		assert.Nil(t, repo.GetIndex(kind.Thing, schema.ClassName(newName)))
+		assert.NotNil(t, repo.GetIndex(kind.Thing, schema.ClassName(class.Class)))
+		assert.Equal(t, []strfmt.UUID{ids[3]},
+			searchByName(t, class.Class, "title"))
+
+		blockedFiles, err := filepath.Glob(dirName + "/thing_blockedcar_*")
+		require.Nil(t, err)
+		for _, file := range blockedFiles {
+			assert.Contains(t, file, ".hnsw.commitlog.d",
+				"only the blocking directories remain")
+			require.Nil(t, os.RemoveAll(file))
+		}
+	})
+
+	t.Run("migrating while the indices are read", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for ctx.Err() == nil {
+				_, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+					Kind:       kind.Thing,
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 10},
+				})
+				assert.Nil(t, err)
+			}
+		}()
+
+		for i := 0; i < 5; i++ {
+			other := &models.Class{Class: fmt.Sprintf("MigrationTruck%d", i)}
+			require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, other))
+			require.Nil(t, migrator.DropClass(context.Background(), kind.Thing,
+				other.Class))
+		}
+
+		cancel()
+		<-done
+	})
+
+	t.Run("renaming the class", func(t *testing.T) {
+		oldName := class.Class
+		class.Class = "MigratedCar"
+		err := migrator.UpdateClass(context.Background(), kind.Thing,
+			oldName, &class.Class, nil)
+		require.Nil(t, err)
+
+		assert.Nil(t, repo.GetIndex(kind.Thing, schema.ClassName(oldName)))
+		assert.NotNil(t, repo.GetIndex(kind.Thing, schema.ClassName(class.Class)))
+
+		oldFiles, err := filepath.Glob(dirName + "/thing_migrationcar_*")
+		require.Nil(t, err)
+		assert.Len(t, oldFiles, 0)
+
+		assert.Equal(t, []strfmt.UUID{ids[3]},
+			searchByName(t, class.Class, "title"))
+
+		res, err := repo.ThingByID(context.Background(), ids[3], nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, class.Class, res.ClassName)
+
+		vectorRes, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 1},
+			SearchVector: []float32{0, 1, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, vectorRes, 1)
+		assert.Equal(t, ids[9], vectorRes[0].ID)
+	})
+
+	t.Run("dropping the class", func(t *testing.T) {
+		err := migrator.DropClass(context.Background(), kind.Thing, class.Class)
+		require.Nil(t, err)
+
+		assert.Nil(t, repo.GetIndex(kind.Thing, schema.ClassName(class.Class)))
+
+		files, err := filepath.Glob(dirName + "/thing_migratedcar_*")
+		require.Nil(t, err)
+		assert.Len(t, files, 0)
+	})
+}
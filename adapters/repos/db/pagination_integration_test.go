@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagination(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	shardedClass := &models.Class{
+		Class:      "PaginatedShardedClass",
+		ShardCount: 3,
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "position",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	otherClass := &models.Class{
+		Class: "PaginatedOtherClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "position",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the classes", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, shardedClass))
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, otherClass))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{shardedClass, otherClass},
+		},
+	}
+
+	shardedCount := 50
+	otherCount := 20
+	shardedIDs := make([]strfmt.UUID, shardedCount)
+	otherIDs := make([]strfmt.UUID, otherCount)
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i := range shardedIDs {
+			shardedIDs[i] = strfmt.UUID(uuid.New().String())
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  shardedClass.Class,
+				ID:     shardedIDs[i],
+				Schema: map[string]interface{}{"position": int64(i)},
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+
+		for i := range otherIDs {
+			otherIDs[i] = strfmt.UUID(uuid.New().String())
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  otherClass.Class,
+				ID:     otherIDs[i],
+				Schema: map[string]interface{}{"position": int64(i)},
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+	})
+
+	sortedSharded := sortedIDs(shardedIDs)
+	sortedAll := sortedIDs(append(append([]strfmt.UUID{}, shardedIDs...), otherIDs...))
+
+	t.Run("paging through a sharded class with an offset", func(t *testing.T) {
+		var found []strfmt.UUID
+		for offset := 0; offset < shardedCount; offset += 7 {
+			res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+				Kind:       kind.Thing,
+				ClassName:  shardedClass.Class,
+				Pagination: &filters.Pagination{Offset: offset, Limit: 7},
+			})
+			require.Nil(t, err)
+			found = append(found, resultIDs(res)...)
+		}
+
+		assert.Equal(t, sortedSharded, found)
+	})
+
+	t.Run("paging through a sharded class with a cursor", func(t *testing.T) {
+		var found []strfmt.UUID
+		after := strfmt.UUID("")
+		for {
+			res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+				Kind:       kind.Thing,
+				ClassName:  shardedClass.Class,
+				Pagination: &filters.Pagination{After: after, Limit: 7},
+			})
+			require.Nil(t, err)
+			if len(res) == 0 {
+				break
+			}
+
+			found = append(found, resultIDs(res)...)
+			after = res[len(res)-1].ID
+		}
+
+		assert.Equal(t, sortedSharded, found)
+	})
+
+	t.Run("paging through all things with a cursor", func(t *testing.T) {
+		var found []strfmt.UUID
+		after := strfmt.UUID("")
+		for {
+			res, err := repo.ThingSearch(context.Background(),
+				&filters.Pagination{After: after, Limit: 9}, nil, nil,
+				traverser.UnderscoreProperties{})
+			require.Nil(t, err)
+			if len(res) == 0 {
+				break
+			}
+
+			found = append(found, resultIDs(res)...)
+			after = res[len(res)-1].ID
+		}
+
+		assert.Equal(t, sortedAll, found)
+	})
+
+	t.Run("paging through all things with an offset", func(t *testing.T) {
+		var found []strfmt.UUID
+		for offset := 0; offset < shardedCount+otherCount; offset += 9 {
+			res, err := repo.ThingSearch(context.Background(),
+				&filters.Pagination{Offset: offset, Limit: 9}, nil, nil,
+				traverser.UnderscoreProperties{})
+			require.Nil(t, err)
+			found = append(found, resultIDs(res)...)
+		}
+
+		assert.Equal(t, sortedAll, found)
+	})
+
+	t.Run("an offset beyond the last object", func(t *testing.T) {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  shardedClass.Class,
+			Pagination: &filters.Pagination{Offset: shardedCount, Limit: 10},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("a vector search with an offset", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    shardedClass.Class,
+			Pagination:   &filters.Pagination{Offset: 3, Limit: 3},
+			SearchVector: []float32{0, 1, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		// the larger the position, the closer the vector is to the query
+		assert.Equal(t, shardedIDs[shardedCount-4], res[0].ID)
+		assert.Equal(t, shardedIDs[shardedCount-5], res[1].ID)
+		assert.Equal(t, shardedIDs[shardedCount-6], res[2].ID)
+	})
+}
+
+func sortedIDs(in []strfmt.UUID) []strfmt.UUID {
+	out := make([]strfmt.UUID, len(in))
+	copy(out, in)
+	sort.Slice(out, func(a, b int) bool {
+		return out[a] < out[b]
+	})
+	return out
+}
+
+func resultIDs(in []search.Result) []strfmt.UUID {
+	out := make([]strfmt.UUID, len(in))
+	for i, res := range in {
+		out[i] = res.ID
+	}
+	return out
+}
@@ -12,10 +12,13 @@
 package db
 
 import (
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -24,7 +27,11 @@ type DB struct {
 	logger       logrus.FieldLogger
 	schemaGetter schemaUC.SchemaGetter
 	config       Config
-	indices      map[string]*Index
+
+	// indices are added, removed and renamed by the migrator while they are
+	// read concurrently, so every access must hold the indexLock
+	indices   map[string]*Index
+	indexLock sync.RWMutex
 }
 
 func (d *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
@@ -44,11 +51,15 @@ func New(logger logrus.FieldLogger, config Config) *DB {
 }
 
 type Config struct {
-	RootPath string
+	RootPath          string
+	PrometheusMetrics *monitoring.PrometheusMetrics // nil if monitoring is turned off
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
 func (d *DB) GetIndex(kind kind.Kind, className schema.ClassName) *Index {
+	d.indexLock.RLock()
+	defer d.indexLock.RUnlock()
+
 	id := indexID(kind, className)
 	index, ok := d.indices[id]
 	if !ok {
@@ -57,3 +68,46 @@ func (d *DB) GetIndex(kind kind.Kind, className schema.ClassName) *Index {
 
 	return index
 }
+
+// allIndices returns all indices in a stable order. It is a copy, so that it
+// can be iterated without holding the indexLock.
+func (d *DB) allIndices() []*Index {
+	d.indexLock.RLock()
+	out := make([]*Index, 0, len(d.indices))
+	for _, index := range d.indices {
+		out = append(out, index)
+	}
+	d.indexLock.RUnlock()
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].ID() < out[b].ID()
+	})
+
+	return out
+}
+
+func (d *DB) setIndex(index *Index) {
+	d.indexLock.Lock()
+	defer d.indexLock.Unlock()
+
+	d.indices[index.ID()] = index
+}
+
+func (d *DB) deleteIndex(id string) {
+	d.indexLock.Lock()
+	defer d.indexLock.Unlock()
+
+	delete(d.indices, id)
+}
+
+// replaceIndex removes the index with the id and adds the replacement, unless
+// it is nil, in a single step
+func (d *DB) replaceIndex(id string, replacement *Index) {
+	d.indexLock.Lock()
+	defer d.indexLock.Unlock()
+
+	delete(d.indices, id)
+	if replacement != nil {
+		d.indices[replacement.ID()] = replacement
+	}
+}
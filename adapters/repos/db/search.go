@@ -14,9 +14,12 @@ package db
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/refcache"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/sorter"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -49,13 +52,27 @@ func (db *DB) ClassSearch(ctx context.Context,
 		return nil, fmt.Errorf("invalid params, pagination object is nil")
 	}
 
-	res, err := idx.objectSearch(ctx, params.Pagination.Limit, params.Filters, false)
+	if params.KeywordRanking != nil {
+		res, err := idx.objectKeywordSearch(ctx, pageEnd(params.Pagination),
+			params.Filters, params.KeywordRanking, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "object keyword search at index %s", idx.ID())
+		}
+
+		return db.enrichRefsForList(ctx,
+			storobj.SearchResults(skipOffset(res, params.Pagination)),
+			params.Properties, params.UnderscoreProperties.RefMeta)
+	}
+
+	res, err := idx.objectSearch(ctx, pageEnd(params.Pagination), params.Filters,
+		params.Pagination.After, params.Sort, false)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object search at index %s", idx.ID())
 	}
 
-	return db.enrichRefsForList(ctx, storobj.SearchResults(res), params.Properties,
-		params.UnderscoreProperties.RefMeta)
+	return db.enrichRefsForList(ctx,
+		storobj.SearchResults(skipOffset(res, params.Pagination)),
+		params.Properties, params.UnderscoreProperties.RefMeta)
 }
 
 func (db *DB) VectorClassSearch(ctx context.Context,
@@ -71,13 +88,32 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 	}
 
 	res, err := idx.objectVectorSearch(ctx, params.SearchVector,
-		params.Pagination.Limit, params.Filters, false)
+		pageEnd(params.Pagination), params.Filters, params.Sort, false)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
 
-	return db.enrichRefsForList(ctx, storobj.SearchResults(res), params.Properties,
-		params.UnderscoreProperties.RefMeta)
+	return db.enrichRefsForList(ctx,
+		storobj.SearchResults(skipOffset(res, params.Pagination)),
+		params.Properties, params.UnderscoreProperties.RefMeta)
+}
+
+// FindObjectIDs lists the ids of all objects of the class which match the
+// filters. Other than a class search it is not limited by pagination.
+func (db *DB) FindObjectIDs(ctx context.Context, k kind.Kind,
+	className schema.ClassName, filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	idx := db.GetIndex(k, className)
+	if idx == nil {
+		return nil, fmt.Errorf("tried to find ids in non-existing index for %s/%s",
+			k, className)
+	}
+
+	ids, err := idx.findObjectIDs(ctx, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "find objects in index %s", idx.ID())
+	}
+
+	return ids, nil
 }
 
 func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
@@ -86,24 +122,33 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
 
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range db.indices {
+	for _, index := range db.allIndices() {
 		// TODO support all underscore props
-		res, err := index.objectVectorSearch(ctx, vector, limit, filters, false)
+		res, err := index.objectVectorSearch(ctx, vector, limit, filters, nil, false)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
 
-		found = append(found, storobj.SearchResults(res)...)
+		for _, obj := range storobj.SearchResults(res) {
+			obj.Certainty, err = index.certainty(vector, obj.Vector)
+			if err != nil {
+				return nil, errors.Wrapf(err, "search index %s", index.ID())
+			}
+
+			found = append(found, obj)
+		}
+
 		if len(found) >= limit {
 			// we are done
 			break
 		}
 	}
 
-	found, err := found.SortByDistanceToVector(vector)
-	if err != nil {
-		return nil, errors.Wrapf(err, "re-sort when merging indices")
-	}
+	// the distances of indices with different metrics are not comparable,
+	// their certainties are
+	sort.SliceStable(found, func(a, b int) bool {
+		return found[a].Certainty > found[b].Certainty
+	})
 
 	if len(found) > limit {
 		found = found[:limit]
@@ -114,46 +159,88 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
 	return found, nil
 }
 
-func (d *DB) ThingSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
+func (d *DB) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter,
 	underscore traverser.UnderscoreProperties) (search.Results, error) {
-	return d.objectSearch(ctx, kind.Thing, limit, filters, underscore)
+	return d.objectSearch(ctx, kind.Thing, pagination, sort, filters, underscore)
 }
 
-func (d *DB) ActionSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
+func (d *DB) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter,
 	underscore traverser.UnderscoreProperties) (search.Results, error) {
-	return d.objectSearch(ctx, kind.Action, limit, filters, underscore)
+	return d.objectSearch(ctx, kind.Action, pagination, sort, filters, underscore)
 }
 
-func (d *DB) objectSearch(ctx context.Context, kind kind.Kind, limit int,
+func (d *DB) objectSearch(ctx context.Context, kind kind.Kind,
+	pagination *filters.Pagination, sort []filters.Sort,
 	filters *filters.LocalFilter,
 	underscore traverser.UnderscoreProperties) (search.Results, error) {
-	var found search.Results
+	var found []*storobj.Object
+
+	limit := pageEnd(pagination)
 
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range d.indices {
-		if index.Config.Kind != kind {
-			continue
-		}
-
+	for _, index := range d.indicesOfKind(kind) {
 		// TODO support all underscore props
-		res, err := index.objectSearch(ctx, limit, filters, underscore.Classification)
+		res, err := index.objectSearch(ctx, limit, filters, pagination.After,
+			sort, underscore.Classification)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
 
-		found = append(found, storobj.SearchResults(res)...)
-		if len(found) >= limit {
-			// we are done
+		found = append(found, res...)
+		if len(sort) == 0 && filters != nil && len(found) >= limit {
+			// we are done, filtered results are simply concatenated in the order
+			// of the indices
 			break
 		}
 	}
 
+	if len(sort) > 0 {
+		found = sorter.Objects(found, sort)
+	} else if filters == nil {
+		// unfiltered lists are ordered by id across all indices, so that the
+		// last id of a page can be used as the cursor for the next one
+		found = sortByID(found)
+	}
+
 	if len(found) > limit {
 		found = found[:limit]
 	}
 
-	return found, nil
+	return storobj.SearchResults(skipOffset(found, pagination)), nil
+}
+
+// indicesOfKind returns the indices of the specified kind in a stable order,
+// so that consecutive pages are built from the same sequence of indices
+func (d *DB) indicesOfKind(k kind.Kind) []*Index {
+	var out []*Index
+	for _, index := range d.allIndices() {
+		if index.Config.Kind != k {
+			continue
+		}
+
+		out = append(out, index)
+	}
+
+	return out
+}
+
+// pageEnd is the number of results that need to be retrieved to be able to
+// serve the requested page
+func pageEnd(pagination *filters.Pagination) int {
+	return pagination.Offset + pagination.Limit
+}
+
+// skipOffset removes the results which are part of previous pages
+func skipOffset(objects []*storobj.Object,
+	pagination *filters.Pagination) []*storobj.Object {
+	if pagination.Offset >= len(objects) {
+		return nil
+	}
+
+	return objects[pagination.Offset:]
 }
 
 func (d *DB) enrichRefsForList(ctx context.Context, objs search.Results,
@@ -13,6 +13,7 @@ package db
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -22,23 +23,28 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/indexcounter"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/flat"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/geo"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 )
 
 // Shard is the smallest completely-contained index unit. A shard mananages
-// database files for all the objects it owns. How a shard is determined for a
-// target object (e.g. Murmur hash, etc.) is still open at this point
+// database files for all the objects it owns. Which shard owns an object is
+// determined by the index based on a hash of the object's UUID
 type Shard struct {
 	index            *Index // a reference to the underlying index, which in turn contains schema information
 	name             string
 	db               *bolt.DB // one db file per shard, uses buckets for separation between data storage, index storage, etc.
 	counter          *indexcounter.Counter
 	vectorIndex      VectorIndex
+	vectorQueue      *vectorQueue // nil unless the class indexes vectors asynchronously
 	invertedRowCache *inverted.RowCacher
 	metrics          *Metrics
 	propertyIndices  propertyspecific.Indices
+	geoIndices       map[string]*geo.Index // by prop name, kept open for the lifetime of the shard
 }
 
 func NewShard(shardName string, index *Index) (*Shard, error) {
@@ -46,30 +52,21 @@ func NewShard(shardName string, index *Index) (*Shard, error) {
 		index:            index,
 		name:             shardName,
 		invertedRowCache: inverted.NewRowCacher(50 * 1024 * 1024),
-		metrics:          NewMetrics(index.logger),
+		metrics: NewMetrics(index.logger, index.Config.PrometheusMetrics,
+			index.Config.ClassName.String(), shardName),
 	}
 
-	vi, err := hnsw.New(hnsw.Config{
-		Logger:   index.logger,
-		RootPath: s.index.Config.RootPath,
-		ID:       s.ID(),
-		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), 10*time.Second,
-				index.logger)
-		},
-		MaximumConnections:       60,
-		EFConstruction:           128,
-		VectorForIDThunk:         s.vectorByIndexID,
-		TombstoneCleanupInterval: 5 * time.Minute,
-	})
+	err := s.initDBFile()
 	if err != nil {
-		return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: shard db", s.ID())
 	}
-	s.vectorIndex = vi
 
-	err = s.initDBFile()
-	if err != nil {
-		return nil, errors.Wrapf(err, "init shard %q: shard db", s.ID())
+	if err := s.initVectorIndex(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: vector index", s.ID())
+	}
+
+	if err := s.initObjectCount(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: object count", s.ID())
 	}
 
 	counter, err := indexcounter.New(s.ID(), index.Config.RootPath)
@@ -83,15 +80,104 @@ func NewShard(shardName string, index *Index) (*Shard, error) {
 		return nil, errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
 	}
 
+	if index.Config.AsyncIndexing {
+		s.vectorQueue = newVectorQueue(s)
+		if err := s.vectorQueue.load(); err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: load vector queue", s.ID())
+		}
+		s.vectorQueue.start()
+	}
+
 	return s, nil
 }
 
+func (s *Shard) initVectorIndex() error {
+	switch s.index.Config.VectorIndexType {
+	case schemaUC.VectorIndexTypeFlat:
+		vi, err := flat.New(flat.Config{
+			ID:                    s.ID(),
+			DistanceProvider:      s.index.distancerProvider,
+			VectorForIDThunk:      s.vectorByIndexID,
+			DocIDsThunk:           s.allDocIDs,
+			VectorCacheMaxObjects: s.index.Config.VectorCacheMaxObjects,
+		})
+		if err != nil {
+			return errors.Wrap(err, "flat index")
+		}
+
+		s.vectorIndex = vi
+		return nil
+	default:
+		vectorIndexMetrics := hnsw.NewMetrics(s.index.Config.PrometheusMetrics,
+			s.index.Config.ClassName.String(), s.name)
+		vi, err := hnsw.New(hnsw.Config{
+			Logger:   s.index.logger,
+			RootPath: s.index.Config.RootPath,
+			ID:       s.ID(),
+			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+				return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), 10*time.Second,
+					s.index.logger, vectorIndexMetrics)
+			},
+			MaximumConnections:       s.index.Config.MaxConnections,
+			EFConstruction:           s.index.Config.EFConstruction,
+			EF:                       s.index.Config.EF,
+			VectorCacheMaxObjects:    s.index.Config.VectorCacheMaxObjects,
+			VectorForIDThunk:         s.vectorByIndexID,
+			TombstoneCleanupInterval: s.index.Config.CleanupInterval,
+			SnapshotInterval:         10 * time.Second,
+			DistanceProvider:         s.index.distancerProvider,
+			PQ:                       s.index.Config.PQ,
+			Metrics:                  vectorIndexMetrics,
+		})
+		if err != nil {
+			return errors.Wrap(err, "hnsw index")
+		}
+
+		s.vectorIndex = vi
+		return nil
+	}
+}
+
+// allDocIDs lists the doc ids of all objects of the shard, they are the
+// candidates of a flat vector index. Only the keys of the index id lookup are
+// read, no object is loaded.
+func (s *Shard) allDocIDs() ([]uint32, error) {
+	var out []uint32
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(helpers.IndexIDBucket)
+		out = make([]uint32, 0, b.Stats().KeyN)
+		return b.ForEach(func(k, v []byte) error {
+			// the little endian doc id is written behind 4 empty bytes, see
+			// addIndexIDLookup
+			if len(k) < 4 {
+				return errors.Errorf("invalid index id key %x", k)
+			}
+
+			out = append(out, binary.LittleEndian.Uint32(k[len(k)-4:]))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "bolt view tx")
+	}
+
+	return out, nil
+}
+
 func (s *Shard) ID() string {
-	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
+	return shardID(s.index.ID(), s.name)
+}
+
+func shardID(indexID, shardName string) string {
+	return fmt.Sprintf("%s_%s", indexID, shardName)
 }
 
 func (s *Shard) DBPath() string {
-	return fmt.Sprintf("%s/%s.db", s.index.Config.RootPath, s.ID())
+	return shardDBPath(s.index.Config.RootPath, s.ID())
+}
+
+func shardDBPath(rootPath, shardID string) string {
+	return fmt.Sprintf("%s/%s.db", rootPath, shardID)
 }
 
 func (s *Shard) initDBFile() error {
@@ -109,6 +195,18 @@ func (s *Shard) initDBFile() error {
 			return errors.Wrapf(err, "create indexID bucket '%s'", string(helpers.IndexIDBucket))
 		}
 
+		if _, err := tx.CreateBucketIfNotExists(helpers.DocLengthsBucket); err != nil {
+			return errors.Wrapf(err, "create doc lengths bucket '%s'", string(helpers.DocLengthsBucket))
+		}
+
+		if _, err := tx.CreateBucketIfNotExists(helpers.PropLengthsBucket); err != nil {
+			return errors.Wrapf(err, "create prop lengths bucket '%s'", string(helpers.PropLengthsBucket))
+		}
+
+		if _, err := tx.CreateBucketIfNotExists(helpers.VectorQueueBucket); err != nil {
+			return errors.Wrapf(err, "create vector queue bucket '%s'", string(helpers.VectorQueueBucket))
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -119,6 +217,19 @@ func (s *Shard) initDBFile() error {
 	return nil
 }
 
+// initObjectCount sets the initial value of the object count metric, from
+// then on it is updated on every put and delete
+func (s *Shard) initObjectCount() error {
+	if !s.metrics.enabled() {
+		return nil
+	}
+
+	return s.db.View(func(tx *bolt.Tx) error {
+		s.metrics.SetObjectCount(tx.Bucket(helpers.ObjectsBucket).Stats().KeyN)
+		return nil
+	})
+}
+
 func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 	if err := s.db.Update(func(tx *bolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(helpers.BucketFromPropName(prop.Name))
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"os"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+)
+
+// backup writes a copy of all shard files into the specified root path, the
+// files are named just like the originals. The caller must make sure that
+// there are no writes to the shard while the backup is taken.
+func (s *Shard) backup(rootPath string) error {
+	if err := s.backupDBFile(rootPath); err != nil {
+		return errors.Wrap(err, "shard db")
+	}
+
+	if err := s.counter.Backup(s.ID(), rootPath); err != nil {
+		return errors.Wrap(err, "index counter")
+	}
+
+	if err := s.vectorIndex.Backup(rootPath); err != nil {
+		return errors.Wrap(err, "vector index")
+	}
+
+	for prop, geoIndex := range s.geoIndices {
+		if err := geoIndex.Backup(rootPath); err != nil {
+			return errors.Wrapf(err, "geo index of prop %q", prop)
+		}
+	}
+
+	return nil
+}
+
+// backupDBFile copies the bolt file within a read transaction, so the copy is
+// consistent even if the file is written to in the meantime
+func (s *Shard) backupDBFile(rootPath string) error {
+	f, err := os.Create(shardDBPath(rootPath, s.ID()))
+	if err != nil {
+		return errors.Wrap(err, "create backup file")
+	}
+	defer f.Close()
+
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(f)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "write backup file")
+	}
+
+	return f.Sync()
+}
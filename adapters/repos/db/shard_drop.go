@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+)
+
+// shutdown stops the background routines of the shard and closes all of its
+// files. The shard must not be used afterwards.
+func (s *Shard) shutdown() error {
+	if s.vectorQueue != nil {
+		s.vectorQueue.shutdown()
+	}
+	s.metrics.DeleteShard()
+
+	if err := s.vectorIndex.Shutdown(); err != nil {
+		return errors.Wrap(err, "shut down vector index")
+	}
+
+	for propName, geoIndex := range s.geoIndices {
+		if err := geoIndex.Shutdown(); err != nil {
+			return errors.Wrapf(err, "shut down geo index of prop %q", propName)
+		}
+	}
+
+	if err := s.counter.Close(); err != nil {
+		return errors.Wrap(err, "close index counter")
+	}
+
+	if err := s.db.Close(); err != nil {
+		return errors.Wrap(err, "close bolt db")
+	}
+
+	return nil
+}
+
+// drop closes the shard and removes all of its files from disk, i.e. the
+// bolt db, the index counter, the commit logs of the vector index and those
+// of all geo indices
+func (s *Shard) drop() error {
+	if s.vectorQueue != nil {
+		s.vectorQueue.shutdown()
+	}
+	s.metrics.DeleteShard()
+
+	if err := s.vectorIndex.Drop(); err != nil {
+		return errors.Wrap(err, "drop vector index")
+	}
+
+	for propName, geoIndex := range s.geoIndices {
+		if err := geoIndex.Drop(); err != nil {
+			return errors.Wrapf(err, "drop geo index of prop %q", propName)
+		}
+	}
+
+	if err := s.counter.Drop(); err != nil {
+		return errors.Wrap(err, "drop index counter")
+	}
+
+	if err := s.db.Close(); err != nil {
+		return errors.Wrap(err, "close bolt db")
+	}
+
+	if err := os.Remove(s.DBPath()); err != nil {
+		return errors.Wrap(err, "remove bolt db")
+	}
+
+	return nil
+}
+
+// dropProperty removes the inverted index of the prop, its lengths and its
+// geo index, if it has one. The prop is also removed from every object, so
+// that it is no longer returned on reads.
+func (s *Shard) dropProperty(ctx context.Context, propName string) error {
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range propBuckets(propName) {
+			err := tx.DeleteBucket(bucket)
+			if err != nil && err != bolt.ErrBucketNotFound {
+				return errors.Wrapf(err, "delete bucket %q", string(bucket))
+			}
+		}
+
+		if err := deleteDocLengthsOfProp(tx, propName); err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(helpers.PropLengthsBucket).
+			Delete([]byte(propName)); err != nil {
+			return errors.Wrap(err, "delete prop length stats")
+		}
+
+		return updateStoredObjects(tx, func(obj *storobj.Object) bool {
+			props, ok := obj.Schema().(map[string]interface{})
+			if !ok {
+				return false
+			}
+
+			if _, ok := props[propName]; !ok {
+				return false
+			}
+
+			delete(props, propName)
+			return true
+		})
+	}); err != nil {
+		return errors.Wrap(err, "bolt update tx")
+	}
+
+	if geoIndex, ok := s.geoIndices[propName]; ok {
+		if err := geoIndex.Drop(); err != nil {
+			return errors.Wrapf(err, "drop geo index of prop %q", propName)
+		}
+		delete(s.geoIndices, propName)
+	}
+
+	return nil
+}
+
+// propBuckets returns the names of all buckets which can exist for the prop
+// in the inverted index
+func propBuckets(propName string) [][]byte {
+	return [][]byte{
+		helpers.BucketFromPropName(propName),
+		helpers.BucketFromPropName(helpers.MetaCountProp(propName)),
+	}
+}
+
+func deleteDocLengthsOfProp(tx *bolt.Tx, propName string) error {
+	lengths := tx.Bucket(helpers.DocLengthsBucket)
+	keys := docLengthKeysOfProp(lengths, propName)
+	for _, key := range keys {
+		if err := lengths.Delete(key); err != nil {
+			return errors.Wrap(err, "delete doc length")
+		}
+	}
+
+	return nil
+}
+
+// docLengthKeysOfProp collects the keys first, as the bucket must not be
+// altered while iterating over it
+func docLengthKeysOfProp(lengths *bolt.Bucket, propName string) [][]byte {
+	prefix := append([]byte(propName), 0)
+	var keys [][]byte
+
+	c := lengths.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+
+	return keys
+}
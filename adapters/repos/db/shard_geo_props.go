@@ -25,6 +25,7 @@ import (
 
 func (s *Shard) initPerPropertyIndices() error {
 	s.propertyIndices = propertyspecific.Indices{}
+	s.geoIndices = map[string]*geo.Index{}
 	sch := s.index.getSchema.GetSchemaSkipAuth()
 	c := sch.FindClassByName(s.index.Config.ClassName)
 	if c == nil {
@@ -42,13 +43,24 @@ func (s *Shard) initPerPropertyIndices() error {
 }
 
 func (s *Shard) initGeoProp(prop *models.Property) error {
-	geo.NewIndex(geo.Config{
+	if _, ok := s.geoIndices[prop.Name]; ok {
+		// already initialized, for example when a data type is added to an
+		// existing prop
+		return nil
+	}
+
+	idx, err := geo.NewIndex(geo.Config{
 		ID:                 geoPropID(s.ID(), prop.Name),
 		RootPath:           s.index.Config.RootPath,
 		CoordinatesForID:   s.makeCoordinatesForID(prop.Name),
 		DisablePersistence: false,
 		Logger:             s.index.logger,
 	})
+	if err != nil {
+		return errors.Wrapf(err, "init geo index for prop %q", prop.Name)
+	}
+
+	s.geoIndices[prop.Name] = idx
 	return nil
 }
 
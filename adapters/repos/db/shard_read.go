@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"sort"
 
 	"github.com/boltdb/bolt"
 	"github.com/go-openapi/strfmt"
@@ -162,9 +163,14 @@ func (s *Shard) vectorByIndexID(ctx context.Context, indexID int32) ([]float32,
 }
 
 func (s *Shard) objectSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
+	filters *filters.LocalFilter, after strfmt.UUID, sort []filters.Sort,
+	meta bool) ([]*storobj.Object, error) {
+	if len(sort) > 0 {
+		return s.sortedObjectSearch(ctx, limit, filters, sort, meta)
+	}
+
 	if filters == nil {
-		return s.objectList(ctx, limit, meta)
+		return s.objectList(ctx, limit, after, meta)
 	}
 
 	return inverted.NewSearcher(s.db, s.index.getSchema.GetSchemaSkipAuth(),
@@ -172,6 +178,61 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 		Object(ctx, limit, filters, meta, s.index.Config.ClassName)
 }
 
+// findObjectIDs returns the ids of all objects of the shard which match the
+// filter. The doc ids are resolved through the index id lookup, so that no
+// object has to be loaded. Doc IDs on the inverted index which no longer
+// resolve to an object are skipped.
+func (s *Shard) findObjectIDs(ctx context.Context,
+	filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	allowList, err := inverted.NewSearcher(s.db, s.index.getSchema.GetSchemaSkipAuth(),
+		s.invertedRowCache, s.propertyIndices).
+		DocIDs(ctx, filters, false, s.index.Config.ClassName)
+	if err != nil {
+		return nil, errors.Wrap(err, "build inverted filter allow list")
+	}
+
+	if len(allowList) == 0 {
+		return nil, nil
+	}
+
+	docIDs := make([]uint32, 0, len(allowList))
+	for docID := range allowList {
+		docIDs = append(docIDs, docID)
+	}
+	sort.Slice(docIDs, func(a, b int) bool { return docIDs[a] < docIDs[b] })
+
+	ids := make([]strfmt.UUID, 0, len(docIDs))
+	err = s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(helpers.IndexIDBucket)
+		if b == nil {
+			return errors.Errorf("no index id bucket found")
+		}
+
+		for _, docID := range docIDs {
+			keyBuf := bytes.NewBuffer(make([]byte, 4))
+			binary.Write(keyBuf, binary.LittleEndian, &docID)
+			idBytes := b.Get(keyBuf.Bytes())
+			if len(idBytes) == 0 {
+				continue
+			}
+
+			id, err := uuid.FromBytes(idBytes)
+			if err != nil {
+				return errors.Wrapf(err, "parse uuid of doc id %d", docID)
+			}
+
+			ids = append(ids, strfmt.UUID(id.String()))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve doc ids")
+	}
+
+	return ids, nil
+}
+
 func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 	limit int, filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
 	var allowList helpers.AllowList
@@ -185,13 +246,35 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 		allowList = list
 	}
-	ids, err := s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+
+	if allowList != nil && s.shouldBruteForce(allowList) {
+		res, err := s.bruteForceVectorSearch(searchVector, limit, allowList)
+		if err != nil {
+			return nil, errors.Wrap(err, "brute force vector search")
+		}
+
+		return res, nil
+	}
+
+	indexedAllowList := allowList
+	var queued helpers.AllowList
+	if s.vectorQueue != nil {
+		queued, indexedAllowList = s.splitQueuedDocIDs(allowList)
+	}
+
+	ids, err := s.filteredVectorSearch(searchVector, limit, indexedAllowList)
 	if err != nil {
 		return nil, errors.Wrap(err, "vector search")
 	}
 
-	if len(ids) == 0 {
-		return nil, nil
+	if ids == nil && allowList != nil {
+		// the graph walk could not find enough allowed results
+		res, err := s.bruteForceVectorSearch(searchVector, limit, allowList)
+		if err != nil {
+			return nil, errors.Wrap(err, "brute force vector search")
+		}
+
+		return res, nil
 	}
 
 	var out []*storobj.Object
@@ -200,29 +283,64 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 	for i, id := range ids {
 		idsUint[i] = uint32(id)
 	}
-	if err := s.db.View(func(tx *bolt.Tx) error {
-		res, err := inverted.ObjectsFromDocIDsInTx(tx, idsUint)
+	if len(idsUint) > 0 {
+		if err := s.db.View(func(tx *bolt.Tx) error {
+			res, err := inverted.ObjectsFromDocIDsInTx(tx, idsUint)
+			if err != nil {
+				return errors.Wrap(err, "resolve doc ids to objects")
+			}
+
+			out = res
+			return nil
+		}); err != nil {
+			return nil, errors.Wrap(err, "docID to []*storobj.Object after vector search")
+		}
+	}
+
+	if len(queued) > 0 {
+		out, err = s.mergeQueuedVectorSearch(searchVector, limit, out, queued)
 		if err != nil {
-			return errors.Wrap(err, "resolve doc ids to objects")
+			return nil, errors.Wrap(err, "vector search of queued vectors")
 		}
+	}
 
-		out = res
-		return nil
-	}); err != nil {
-		return nil, errors.Wrap(err, "docID to []*storobj.Object after vector search")
+	if len(out) == 0 {
+		return nil, nil
 	}
 
 	return out, nil
 }
 
-func (s *Shard) objectList(ctx context.Context, limit int,
+// objectList returns the objects ordered by their id as this is the order of
+// the objects bucket. If after is set, the cursor is positioned right behind
+// it, so that paging through a shard does not require reading the previous
+// pages again.
+func (s *Shard) objectList(ctx context.Context, limit int, after strfmt.UUID,
 	meta bool) ([]*storobj.Object, error) {
+	var afterBytes []byte
+	if after != "" {
+		parsed, err := uuid.Parse(after.String())
+		if err != nil {
+			return nil, errors.Wrap(err, "parse cursor")
+		}
+
+		afterBytes, _ = parsed.MarshalBinary()
+	}
+
 	out := make([]*storobj.Object, limit)
 	i := 0
 	err := s.db.View(func(tx *bolt.Tx) error {
 		cursor := tx.Bucket(helpers.ObjectsBucket).Cursor()
 
-		for k, v := cursor.First(); k != nil && i < limit; k, v = cursor.Next() {
+		k, v := cursor.First()
+		if afterBytes != nil {
+			k, v = cursor.Seek(afterBytes)
+			if k != nil && bytes.Equal(k, afterBytes) {
+				k, v = cursor.Next()
+			}
+		}
+
+		for ; k != nil && i < limit; k, v = cursor.Next() {
 			obj, err := storobj.FromBinary(v)
 			if err != nil {
 				return errors.Wrapf(err, "unmarhsal item %d", i)
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// objectKeywordSearch returns the limit objects with the highest keyword
+// score in descending order. The score is attached to each object.
+func (s *Shard) objectKeywordSearch(ctx context.Context, limit int,
+	filter *filters.LocalFilter, keywordRanking *traverser.KeywordRankingParams,
+	meta bool) ([]*storobj.Object, error) {
+	searcher := inverted.NewSearcher(s.db, s.index.getSchema.GetSchemaSkipAuth(),
+		s.invertedRowCache, s.propertyIndices)
+
+	var allowList helpers.AllowList
+	if filter != nil {
+		list, err := searcher.DocIDs(ctx, filter, meta, s.index.Config.ClassName)
+		if err != nil {
+			return nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	ids, scores, err := searcher.BM25(ctx, s.index.Config.ClassName,
+		keywordRanking.Query, keywordRanking.Properties, allowList, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "bm25 search")
+	}
+
+	scoresByID := make(map[uint32]float32, len(ids))
+	for i, id := range ids {
+		scoresByID[id] = scores[i]
+	}
+
+	var out []*storobj.Object
+	err = s.db.View(func(tx *bolt.Tx) error {
+		res, err := inverted.ObjectsFromDocIDsInTx(tx, ids)
+		out = res
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve ranked doc ids")
+	}
+
+	for _, obj := range out {
+		obj.SetScore(scoresByID[obj.IndexID()])
+	}
+
+	return out, nil
+}
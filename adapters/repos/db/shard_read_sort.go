@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/sorter"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
+)
+
+// sortedObjectSearch returns the first limit objects according to the
+// specified sort. If the first property to sort by is stored in a
+// lexicographically sortable way in the inverted index, only the required
+// objects are read in order from the inverted index. Otherwise all matching
+// objects of the shard are loaded and sorted in memory.
+func (s *Shard) sortedObjectSearch(ctx context.Context, limit int,
+	filter *filters.LocalFilter, sort []filters.Sort,
+	meta bool) ([]*storobj.Object, error) {
+	searcher := inverted.NewSearcher(s.db, s.index.getSchema.GetSchemaSkipAuth(),
+		s.invertedRowCache, s.propertyIndices)
+
+	var allowList helpers.AllowList
+	if filter != nil {
+		list, err := searcher.DocIDs(ctx, filter, meta, s.index.Config.ClassName)
+		if err != nil {
+			return nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	ids, ok, err := searcher.SortedDocIDs(ctx, s.index.Config.ClassName, sort[0],
+		allowList, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "read sorted doc ids")
+	}
+
+	var objects []*storobj.Object
+	if ok {
+		err = s.db.View(func(tx *bolt.Tx) error {
+			res, err := inverted.ObjectsFromDocIDsInTx(tx, ids)
+			objects = res
+			return err
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "resolve sorted doc ids")
+		}
+
+		// docIDs on the inverted index could point to objects which no longer
+		// exist, in this case there might be too few results left
+		ok = len(objects) >= limit
+	}
+
+	if !ok {
+		objects, err = s.allObjects(allowList)
+		if err != nil {
+			return nil, errors.Wrap(err, "load objects to sort")
+		}
+	}
+
+	objects = sorter.Objects(objects, sort)
+	if len(objects) > limit {
+		objects = objects[:limit]
+	}
+
+	return objects, nil
+}
+
+// allObjects loads all objects which are on the allow list or all objects of
+// the shard if there is no allow list
+func (s *Shard) allObjects(allowList helpers.AllowList) ([]*storobj.Object, error) {
+	var out []*storobj.Object
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if allowList != nil {
+			pointers := make([]uint32, 0, len(allowList))
+			for docID := range allowList {
+				pointers = append(pointers, docID)
+			}
+
+			res, err := inverted.ObjectsFromDocIDsInTx(tx, pointers)
+			out = res
+			return err
+		}
+
+		return tx.Bucket(helpers.ObjectsBucket).ForEach(func(k, v []byte) error {
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				return errors.Wrapf(err, "unmarshal object %x", k)
+			}
+
+			out = append(out, obj)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "bolt view tx")
+	}
+
+	return out, nil
+}
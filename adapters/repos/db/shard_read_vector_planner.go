@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+)
+
+// A filtered vector search is answered by calculating the distance to every
+// allowed object, rather than walking the graph, if the allow list is small
+// enough. Below the absolute minimum this is always cheaper than a graph
+// walk; above it, the allow list also has to be small compared to the shard,
+// as the graph walk then has to skip most of the nodes it visits. None of
+// this applies to a flat vector index, which is exact and only compares the
+// allowed vectors anyway, reading only the vectors which are not cached.
+const (
+	bruteForceMinAllowListSize  = 1000
+	bruteForceMaxAllowListRatio = 0.1
+)
+
+func (s *Shard) shouldBruteForce(allowList helpers.AllowList) bool {
+	if s.index.Config.VectorIndexType == schemaUC.VectorIndexTypeFlat {
+		return false
+	}
+
+	if len(allowList) <= bruteForceMinAllowListSize {
+		return true
+	}
+
+	size := s.counter.Get()
+	return float64(len(allowList)) <= bruteForceMaxAllowListRatio*float64(size)
+}
+
+// filteredVectorSearch walks the graph. With an allow list, the walk can
+// end before k allowed results were found. In this case the search is
+// repeated with a doubled limit, which also doubles the ef, as the ef is
+// never smaller than the limit. The widening stops once the limit exceeds
+// the allow list, as a graph walk which cannot find enough results with an
+// ef that large is unlikely to ever find them. Nil is returned in that case,
+// so that the caller can fall back to a brute force search. A flat index is
+// only searched once, as its results are complete.
+func (s *Shard) filteredVectorSearch(searchVector []float32, limit int,
+	allowList helpers.AllowList) ([]int, error) {
+	if allowList == nil ||
+		s.index.Config.VectorIndexType == schemaUC.VectorIndexTypeFlat {
+		return s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+	}
+
+	want := limit
+	if len(allowList) < want {
+		want = len(allowList)
+	}
+
+	for searchLimit := limit; ; searchLimit *= 2 {
+		ids, err := s.vectorIndex.SearchByVector(searchVector, searchLimit,
+			allowList)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ids) >= want {
+			if len(ids) > limit {
+				ids = ids[:limit]
+			}
+			return ids, nil
+		}
+
+		if searchLimit >= len(allowList) {
+			return nil, nil
+		}
+	}
+}
+
+// bruteForceVectorSearch calculates the distance between the search vector
+// and every allowed object. The result is exact and contains limit objects
+// unless fewer objects are allowed.
+func (s *Shard) bruteForceVectorSearch(searchVector []float32, limit int,
+	allowList helpers.AllowList) ([]*storobj.Object, error) {
+	type objectAndDist struct {
+		obj  *storobj.Object
+		dist float32
+	}
+
+	pointers := make([]uint32, 0, len(allowList))
+	for docID := range allowList {
+		pointers = append(pointers, docID)
+	}
+
+	distancer := s.index.distancerProvider.New(searchVector)
+	var candidates []objectAndDist
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return inverted.ScanObjectsFromDocIDsInTx(tx, pointers,
+			func(obj *storobj.Object) (bool, error) {
+				if len(obj.Vector) == 0 {
+					return true, nil
+				}
+
+				dist, _, err := distancer.Distance(obj.Vector)
+				if err != nil {
+					return false, errors.Wrapf(err, "distance to %s", obj.ID())
+				}
+
+				candidates = append(candidates, objectAndDist{obj: obj, dist: dist})
+				return true, nil
+			})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "scan allowed objects")
+	}
+
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].dist < candidates[b].dist
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	out := make([]*storobj.Object, len(candidates))
+	for i, candidate := range candidates {
+		out[i] = candidate.obj
+	}
+
+	return out, nil
+}
+
+// splitQueuedDocIDs separates the allowed doc IDs whose vectors are still
+// waiting in the vector queue from those which are already indexed. Without
+// an allow list, all queued doc IDs are returned and the graph walk stays
+// unrestricted.
+func (s *Shard) splitQueuedDocIDs(allowList helpers.AllowList) (queued,
+	indexed helpers.AllowList) {
+	all := s.vectorQueue.docIDs()
+	if allowList == nil {
+		return all, nil
+	}
+
+	queued = helpers.AllowList{}
+	indexed = helpers.AllowList{}
+	for docID := range allowList {
+		if all.Contains(docID) {
+			queued.Insert(docID)
+		} else {
+			indexed.Insert(docID)
+		}
+	}
+
+	return queued, indexed
+}
+
+// mergeQueuedVectorSearch completes the results of a graph walk with the
+// closest objects whose vectors are still queued. As a doc ID might have been
+// inserted into the graph while the search was running, it can be part of
+// both lists.
+func (s *Shard) mergeQueuedVectorSearch(searchVector []float32, limit int,
+	indexed []*storobj.Object, queued helpers.AllowList) ([]*storobj.Object, error) {
+	type objectAndDist struct {
+		obj  *storobj.Object
+		dist float32
+	}
+
+	fromQueue, err := s.bruteForceVectorSearch(searchVector, limit, queued)
+	if err != nil {
+		return nil, err
+	}
+
+	distancer := s.index.distancerProvider.New(searchVector)
+	seen := map[uint32]struct{}{}
+	candidates := make([]objectAndDist, 0, len(indexed)+len(fromQueue))
+	for _, obj := range append(indexed, fromQueue...) {
+		if _, ok := seen[obj.IndexID()]; ok {
+			continue
+		}
+		seen[obj.IndexID()] = struct{}{}
+
+		dist, _, err := distancer.Distance(obj.Vector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "distance to %s", obj.ID())
+		}
+
+		candidates = append(candidates, objectAndDist{obj: obj, dist: dist})
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].dist < candidates[b].dist
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	out := make([]*storobj.Object, len(candidates))
+	for i, candidate := range candidates {
+		out[i] = candidate.obj
+	}
+
+	return out, nil
+}
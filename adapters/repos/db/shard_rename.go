@@ -0,0 +1,242 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// renameShardFiles moves all files of a closed shard, so that they are picked
+// up by a shard with the new id. If a file can't be moved, the files which
+// were moved already are moved back, so that they are never split between
+// both ids.
+func renameShardFiles(rootPath, from, to string, geoProps []string) error {
+	type step struct {
+		name string
+		move func(from, to string) error
+	}
+
+	var steps []step
+	for _, ext := range []string{"db", "indexcount"} {
+		ext := ext
+		steps = append(steps, step{ext + " file", func(from, to string) error {
+			return os.Rename(fmt.Sprintf("%s/%s.%s", rootPath, from, ext),
+				fmt.Sprintf("%s/%s.%s", rootPath, to, ext))
+		}})
+	}
+
+	steps = append(steps, step{"vector index", func(from, to string) error {
+		return hnsw.RenameFiles(rootPath, from, to)
+	}})
+
+	for _, prop := range geoProps {
+		prop := prop
+		steps = append(steps, step{fmt.Sprintf("geo index of prop %q", prop),
+			func(from, to string) error {
+				return hnsw.RenameFiles(rootPath, geoPropID(from, prop),
+					geoPropID(to, prop))
+			}})
+	}
+
+	for i, s := range steps {
+		if err := s.move(from, to); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				steps[j].move(to, from)
+			}
+			return errors.Wrapf(err, "rename %s", s.name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) geoPropNames() []string {
+	names := make([]string, 0, len(s.geoIndices))
+	for name := range s.geoIndices {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// setClassOfObjects overwrites the class name stored with every object, for
+// example after the class was renamed
+func (s *Shard) setClassOfObjects(ctx context.Context, className string) error {
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		return updateStoredObjects(tx, func(obj *storobj.Object) bool {
+			if obj.Class().String() == className {
+				return false
+			}
+
+			obj.SetClass(className)
+			return true
+		})
+	}); err != nil {
+		return errors.Wrap(err, "bolt update tx")
+	}
+
+	return nil
+}
+
+// renameProperty moves the inverted index, the lengths and the geo index of
+// the prop to the new name and renames the prop in every object
+func (s *Shard) renameProperty(ctx context.Context, from, to string) error {
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		fromBuckets, toBuckets := propBuckets(from), propBuckets(to)
+		for i := range fromBuckets {
+			if err := renameBucket(tx, fromBuckets[i], toBuckets[i]); err != nil {
+				return err
+			}
+		}
+
+		if err := renameDocLengthsOfProp(tx, from, to); err != nil {
+			return err
+		}
+
+		propLengths := tx.Bucket(helpers.PropLengthsBucket)
+		if stats := propLengths.Get([]byte(from)); stats != nil {
+			if err := propLengths.Put([]byte(to), append([]byte{}, stats...)); err != nil {
+				return errors.Wrap(err, "store prop length stats")
+			}
+
+			if err := propLengths.Delete([]byte(from)); err != nil {
+				return errors.Wrap(err, "delete prop length stats")
+			}
+		}
+
+		return updateStoredObjects(tx, func(obj *storobj.Object) bool {
+			props, ok := obj.Schema().(map[string]interface{})
+			if !ok {
+				return false
+			}
+
+			value, ok := props[from]
+			if !ok {
+				return false
+			}
+
+			delete(props, from)
+			props[to] = value
+			return true
+		})
+	}); err != nil {
+		return errors.Wrap(err, "bolt update tx")
+	}
+
+	geoIndex, ok := s.geoIndices[from]
+	if !ok {
+		return nil
+	}
+
+	if err := geoIndex.Shutdown(); err != nil {
+		return errors.Wrapf(err, "shut down geo index of prop %q", from)
+	}
+	delete(s.geoIndices, from)
+
+	if err := hnsw.RenameFiles(s.index.Config.RootPath, geoPropID(s.ID(), from),
+		geoPropID(s.ID(), to)); err != nil {
+		return errors.Wrapf(err, "rename geo index of prop %q", from)
+	}
+
+	return s.initGeoProp(&models.Property{Name: to})
+}
+
+// renameBucket copies all entries to a bucket with the new name and deletes
+// the old bucket. Buckets which don't exist are skipped.
+func renameBucket(tx *bolt.Tx, from, to []byte) error {
+	src := tx.Bucket(from)
+	if src == nil {
+		return nil
+	}
+
+	dst, err := tx.CreateBucketIfNotExists(to)
+	if err != nil {
+		return errors.Wrapf(err, "create bucket %q", string(to))
+	}
+
+	if err := src.ForEach(func(k, v []byte) error {
+		return dst.Put(append([]byte{}, k...), append([]byte{}, v...))
+	}); err != nil {
+		return errors.Wrapf(err, "copy bucket %q", string(from))
+	}
+
+	if err := tx.DeleteBucket(from); err != nil {
+		return errors.Wrapf(err, "delete bucket %q", string(from))
+	}
+
+	return nil
+}
+
+func renameDocLengthsOfProp(tx *bolt.Tx, from, to string) error {
+	lengths := tx.Bucket(helpers.DocLengthsBucket)
+	fromPrefix := []byte(from)
+	toPrefix := []byte(to)
+	for _, key := range docLengthKeysOfProp(lengths, from) {
+		newKey := append(append([]byte{}, toPrefix...),
+			bytes.TrimPrefix(key, fromPrefix)...)
+		if err := lengths.Put(newKey, append([]byte{}, lengths.Get(key)...)); err != nil {
+			return errors.Wrap(err, "store doc length")
+		}
+
+		if err := lengths.Delete(key); err != nil {
+			return errors.Wrap(err, "delete doc length")
+		}
+	}
+
+	return nil
+}
+
+// updateStoredObjects calls update for every object in the shard and stores
+// the objects for which it returns true. Changes are collected first, as the
+// bucket must not be altered while iterating over it.
+func updateStoredObjects(tx *bolt.Tx, update func(obj *storobj.Object) bool) error {
+	b := tx.Bucket(helpers.ObjectsBucket)
+	changed := map[string][]byte{}
+	if err := b.ForEach(func(k, v []byte) error {
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal object %x", k)
+		}
+
+		if !update(obj) {
+			return nil
+		}
+
+		data, err := obj.MarshalBinary()
+		if err != nil {
+			return errors.Wrapf(err, "marshal object %x", k)
+		}
+
+		changed[string(k)] = data
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for k, data := range changed {
+		if err := b.Put([]byte(k), data); err != nil {
+			return errors.Wrapf(err, "store object %x", k)
+		}
+	}
+
+	return nil
+}
@@ -96,7 +96,21 @@ func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) m
 					errs[affected] = err
 				}
 				m.Unlock()
+				return
 			}
+
+			added := 0
+			m.Lock()
+			for j, object := range batch {
+				if _, ok := duplicates[i+j]; ok {
+					continue
+				}
+				if !statuses[object.ID()].isUpdate {
+					added++
+				}
+			}
+			m.Unlock()
+			s.metrics.ObjectsAdded(added)
 		}(i, batch)
 	}
 	wg.Wait()
@@ -232,6 +246,93 @@ func (s *Shard) addReferencesBatch(ctx context.Context,
 	return errs
 }
 
+// return value map[int]error gives the error for the index as it received it
+func (s *Shard) deleteObjectBatch(ctx context.Context,
+	ids []strfmt.UUID) map[int]error {
+	maxPerTransaction := 30
+
+	m := &sync.Mutex{}
+	errs := map[int]error{} // int represents original index
+	docIDs := map[int]uint32{}
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < len(ids); i += maxPerTransaction {
+		end := i + maxPerTransaction
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch := ids[i:end]
+		wg.Add(1)
+		go func(i int, batch []strfmt.UUID) {
+			defer wg.Done()
+			var affectedIndices []int
+			deletedInTx := map[int]uint32{}
+			if err := s.db.Batch(func(tx *bolt.Tx) error {
+				if err := ctx.Err(); err != nil {
+					return errors.Wrapf(err, "begin transaction %d of batch", i)
+				}
+
+				// the function could be retried by bolt, so start from scratch
+				affectedIndices = affectedIndices[:0]
+				deletedInTx = map[int]uint32{}
+
+				for j := range batch {
+					// so we can reference potential errors
+					affectedIndices = append(affectedIndices, i+j)
+				}
+
+				for j, id := range batch {
+					uuidParsed, err := uuid.Parse(id.String())
+					if err != nil {
+						return errors.Wrap(err, "invalid id")
+					}
+
+					idBytes, err := uuidParsed.MarshalBinary()
+					if err != nil {
+						return err
+					}
+
+					docID, deleted, err := s.deleteObjectInTx(tx, idBytes)
+					if err != nil {
+						return errors.Wrapf(err, "delete object %s", id)
+					}
+
+					if deleted {
+						deletedInTx[i+j] = docID
+					}
+				}
+				return nil
+			}); err != nil {
+				m.Lock()
+				err = errors.Wrap(err, "bolt batch tx")
+				for _, affected := range affectedIndices {
+					errs[affected] = err
+				}
+				m.Unlock()
+				return
+			}
+
+			m.Lock()
+			for pos, docID := range deletedInTx {
+				docIDs[pos] = docID
+			}
+			m.Unlock()
+		}(i, batch)
+	}
+	wg.Wait()
+
+	for pos, docID := range docIDs {
+		s.metrics.ObjectDeleted()
+
+		if err := s.deleteFromVectorIndex(docID); err != nil {
+			errs[pos] = errors.Wrap(err, "delete from vector index")
+		}
+	}
+
+	return errs
+}
+
 func mergeDocFromBatchReference(ref kinds.BatchReference) kinds.MergeDocument {
 	return kinds.MergeDocument{
 		Kind:       ref.From.Kind,
@@ -32,56 +32,82 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	}
 
 	var docID uint32
+	var deleted bool
 	if err := s.db.Batch(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(helpers.ObjectsBucket)
-		existing := bucket.Get([]byte(idBytes))
-		if existing == nil {
-			// nothing to do
-			return nil
-		}
+		docID, deleted, err = s.deleteObjectInTx(tx, idBytes)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "bolt batch tx")
+	}
 
-		// we need the doc ID so we can clean up inverted indices currently
-		// pointing to this object
-		docID, err = storobj.DocIDFromBinary(existing)
-		if err != nil {
-			return errors.Wrap(err, "get existing doc id from object binary")
-		}
+	if deleted {
+		s.metrics.ObjectDeleted()
+	}
 
-		oldObj, err := storobj.FromBinary(existing)
-		if err != nil {
-			return errors.Wrap(err, "unmarshal existing doc")
-		}
+	if err := s.deleteFromVectorIndex(docID); err != nil {
+		return errors.Wrap(err, "delete from vector index")
+	}
 
-		invertedPointersToDelete, err := s.analyzeObject(oldObj)
-		if err != nil {
-			return errors.Wrap(err, "analyze object")
-		}
+	return nil
+}
 
-		err = s.deleteFromInvertedIndices(tx, invertedPointersToDelete, docID)
-		if err != nil {
-			return errors.Wrap(err, "delete pointers from inverted index")
-		}
+// deleteObjectInTx removes the object and all inverted index pointers to it.
+// It returns the doc ID of the deleted object, so it can be removed from the
+// vector index once the transaction is committed. If the object does not
+// exist, deleted is false.
+func (s *Shard) deleteObjectInTx(tx *bolt.Tx,
+	idBytes []byte) (docID uint32, deleted bool, err error) {
+	bucket := tx.Bucket(helpers.ObjectsBucket)
+	existing := bucket.Get(idBytes)
+	if existing == nil {
+		// nothing to do
+		return 0, false, nil
+	}
 
-		err = bucket.Delete(idBytes)
-		if err != nil {
-			return errors.Wrap(err, "delete object from bucket")
-		}
+	// we need the doc ID so we can clean up inverted indices currently
+	// pointing to this object
+	docID, err = storobj.DocIDFromBinary(existing)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "get existing doc id from object binary")
+	}
 
-		err = s.deleteIndexIDLookup(tx, docID)
-		if err != nil {
-			return errors.Wrap(err, "delete indexID->uuid lookup")
-		}
+	oldObj, err := storobj.FromBinary(existing)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "unmarshal existing doc")
+	}
 
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "bolt batch tx")
+	invertedPointersToDelete, err := s.analyzeObject(oldObj)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "analyze object")
 	}
 
-	if err := s.vectorIndex.Delete(int(docID)); err != nil {
-		return errors.Wrap(err, "delete from vector index")
+	err = s.deleteFromInvertedIndices(tx, invertedPointersToDelete, docID)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "delete pointers from inverted index")
 	}
 
-	return nil
+	err = s.deleteDocLengths(tx, invertedPointersToDelete, docID)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "delete doc lengths")
+	}
+
+	err = bucket.Delete(idBytes)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "delete object from bucket")
+	}
+
+	err = s.deleteIndexIDLookup(tx, docID)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "delete indexID->uuid lookup")
+	}
+
+	if s.vectorQueue != nil {
+		if err := s.vectorQueue.dequeueInTx(tx, docID); err != nil {
+			return 0, false, errors.Wrap(err, "delete from vector queue")
+		}
+	}
+
+	return docID, true, nil
 }
 
 func (s *Shard) deleteIndexIDLookup(tx *bolt.Tx, docID uint32) error {
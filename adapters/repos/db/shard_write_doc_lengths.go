@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+)
+
+// addDocLengths stores the length of each prop with a frequency of the doc
+// and adds it to the per-prop statistics. Together they are used to
+// normalize keyword scores by document length.
+func (s *Shard) addDocLengths(tx *bolt.Tx, props []inverted.Property,
+	docID uint32) error {
+	lengths := tx.Bucket(helpers.DocLengthsBucket)
+	if lengths == nil {
+		return fmt.Errorf("no doc lengths bucket found")
+	}
+
+	for _, prop := range props {
+		if !prop.HasFrequency {
+			continue
+		}
+
+		key := helpers.DocLengthKey(prop.Name, docID)
+		length := uint32(prop.Length)
+		buf := bytes.NewBuffer(make([]byte, 0, 4))
+		binary.Write(buf, binary.LittleEndian, &length)
+		if err := lengths.Put(key, buf.Bytes()); err != nil {
+			return errors.Wrapf(err, "store length of prop %q", prop.Name)
+		}
+
+		if err := s.updatePropLengthStats(tx, prop.Name,
+			func(stats inverted.PropLengthStats) inverted.PropLengthStats {
+				return stats.Add(length)
+			}); err != nil {
+			return errors.Wrapf(err, "prop %q", prop.Name)
+		}
+	}
+
+	return nil
+}
+
+// deleteDocLengths removes the lengths of all props with a frequency of the
+// doc and removes them from the per-prop statistics
+func (s *Shard) deleteDocLengths(tx *bolt.Tx, props []inverted.Property,
+	docID uint32) error {
+	for _, prop := range props {
+		if !prop.HasFrequency {
+			continue
+		}
+
+		if err := s.deleteDocLength(tx, prop.Name, docID); err != nil {
+			return errors.Wrapf(err, "prop %q", prop.Name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) deleteDocLength(tx *bolt.Tx, propName string,
+	docID uint32) error {
+	lengths := tx.Bucket(helpers.DocLengthsBucket)
+	if lengths == nil {
+		return fmt.Errorf("no doc lengths bucket found")
+	}
+
+	key := helpers.DocLengthKey(propName, docID)
+	data := lengths.Get(key)
+	if len(data) == 0 {
+		// docs imported before lengths were recorded have no length, there is
+		// nothing to remove from the stats
+		return nil
+	}
+
+	var length uint32
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian,
+		&length); err != nil {
+		return errors.Wrap(err, "read doc length")
+	}
+
+	if err := lengths.Delete(key); err != nil {
+		return errors.Wrap(err, "delete doc length")
+	}
+
+	return s.updatePropLengthStats(tx, propName,
+		func(stats inverted.PropLengthStats) inverted.PropLengthStats {
+			return stats.Remove(length)
+		})
+}
+
+func (s *Shard) updatePropLengthStats(tx *bolt.Tx, propName string,
+	update func(inverted.PropLengthStats) inverted.PropLengthStats) error {
+	b := tx.Bucket(helpers.PropLengthsBucket)
+	if b == nil {
+		return fmt.Errorf("no prop lengths bucket found")
+	}
+
+	stats, err := inverted.PropLengthStatsFromBinary(b.Get([]byte(propName)))
+	if err != nil {
+		return errors.Wrap(err, "read prop length stats")
+	}
+
+	data, err := update(stats).MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal prop length stats")
+	}
+
+	if err := b.Put([]byte(propName), data); err != nil {
+		return errors.Wrap(err, "store prop length stats")
+	}
+
+	return nil
+}
@@ -86,6 +86,12 @@ func (s *Shard) mergeObjectInTx(tx *bolt.Tx, merge kinds.MergeDocument,
 		return status, errors.Wrap(err, "udpate inverted indices")
 	}
 
+	if s.vectorQueue != nil {
+		if err := s.vectorQueue.enqueueInTx(tx, status); err != nil {
+			return status, errors.Wrap(err, "update vector queue")
+		}
+	}
+
 	return status, nil
 }
 
@@ -46,6 +46,10 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		return errors.Wrap(err, "bolt batch tx")
 	}
 
+	if !status.isUpdate {
+		s.metrics.ObjectsAdded(1)
+	}
+
 	if err := s.updateVectorIndex(object.Vector, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
@@ -61,11 +65,17 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	}
 
 	if status.docIDChanged {
-		if err := s.vectorIndex.Delete(int(status.oldDocID)); err != nil {
+		if err := s.deleteFromVectorIndex(status.oldDocID); err != nil {
 			return errors.Wrapf(err, "delete doc id %q from vector index", status.oldDocID)
 		}
 	}
 
+	if s.vectorQueue != nil {
+		// the doc id was already persisted in the queue as part of the write
+		s.vectorQueue.push(status.docID)
+		return nil
+	}
+
 	if err := s.vectorIndex.Add(int(status.docID), vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %q to vector index", status.docID)
 	}
@@ -73,6 +83,17 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	return nil
 }
 
+// deleteFromVectorIndex removes the doc ID from the vector index. If the
+// vector is still queued, it never reached the index and only needs to be
+// taken out of the queue.
+func (s *Shard) deleteFromVectorIndex(docID uint32) error {
+	if s.vectorQueue != nil && s.vectorQueue.remove(docID) {
+		return nil
+	}
+
+	return s.vectorIndex.Delete(int(docID))
+}
+
 func (s *Shard) putObjectInTx(tx *bolt.Tx, object *storobj.Object,
 	idBytes []byte) (objectInsertStatus, error) {
 	before := time.Now()
@@ -110,6 +131,12 @@ func (s *Shard) putObjectInTx(tx *bolt.Tx, object *storobj.Object,
 	}
 	s.metrics.PutObjectUpdateInverted(before)
 
+	if s.vectorQueue != nil {
+		if err := s.vectorQueue.enqueueInTx(tx, status); err != nil {
+			return status, errors.Wrap(err, "update vector queue")
+		}
+	}
+
 	return status, nil
 }
 
@@ -199,6 +226,13 @@ func (s Shard) updateInvertedIndex(tx *bolt.Tx, object *storobj.Object,
 			return errors.Wrap(err, "analyze previous object")
 		}
 
+		// lengths are always replaced as a whole, as a delta of the terms does
+		// not tell us anything about the length of the prop
+		err = s.deleteDocLengths(tx, previousInvertProps, status.oldDocID)
+		if err != nil {
+			return errors.Wrap(err, "delete obsolete doc lengths")
+		}
+
 		// there are two possible update cases:
 		// Case A: We have the same docID, so we only need to remove/add the deltas
 		// Case B: The doc ID has changed, so we need to delete anything pointing
@@ -233,6 +267,10 @@ func (s Shard) updateInvertedIndex(tx *bolt.Tx, object *storobj.Object,
 	}
 	s.metrics.InvertedExtend(before, len(invertPropsToAdd))
 
+	if err := s.addDocLengths(tx, nextInvertProps, status.docID); err != nil {
+		return errors.Wrap(err, "put doc lengths")
+	}
+
 	return nil
 }
 
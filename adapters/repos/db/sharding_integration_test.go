@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiShardIndex(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:      "ShardedThingClass",
+		ShardCount: 3,
+		// an ef larger than the number of objects per shard makes the search of
+		// each shard exact, so that only the merging of the results is tested
+		VectorIndexConfig: &models.VectorIndexConfig{Ef: 100},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "position",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	require.Len(t, idx.Shards, 3)
+
+	objectCount := 60
+	ids := make([]strfmt.UUID, objectCount)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	t.Run("importing the first half individually", func(t *testing.T) {
+		for i := 0; i < objectCount/2; i++ {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  class.Class,
+				ID:     ids[i],
+				Schema: map[string]interface{}{"position": int64(i)},
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("importing the second half as a batch", func(t *testing.T) {
+		batch := make(kinds.BatchThings, objectCount/2)
+		for i := range batch {
+			pos := objectCount/2 + i
+			batch[i] = kinds.BatchThing{
+				OriginalIndex: i,
+				Thing: &models.Thing{
+					Class:  class.Class,
+					ID:     ids[pos],
+					Schema: map[string]interface{}{"position": int64(pos)},
+				},
+				Vector: []float32{1, float32(pos), 0},
+			}
+		}
+
+		res, err := repo.BatchPutThings(context.Background(), batch)
+		require.Nil(t, err)
+		for _, item := range res {
+			assert.Nil(t, item.Err)
+		}
+	})
+
+	t.Run("every shard holds a part of the objects", func(t *testing.T) {
+		total := 0
+		for _, shard := range idx.Shards {
+			count := 0
+			err := shard.db.View(func(tx *bolt.Tx) error {
+				count = tx.Bucket(helpers.ObjectsBucket).Stats().KeyN
+				return nil
+			})
+			require.Nil(t, err)
+			assert.Greater(t, count, 0, "shard %s is empty", shard.ID())
+			total += count
+		}
+
+		assert.Equal(t, objectCount, total)
+	})
+
+	t.Run("every object can be retrieved by id", func(t *testing.T) {
+		for i, id := range ids {
+			res, err := repo.ThingByID(context.Background(), id,
+				traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+			require.Nil(t, err)
+			require.NotNil(t, res, "object %d not found", i)
+			assert.Equal(t, id, res.ID)
+		}
+	})
+
+	t.Run("an unfiltered search returns objects of all shards", func(t *testing.T) {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 100},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, objectCount)
+	})
+
+	t.Run("a vector search merges the results of all shards", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 3},
+			SearchVector: []float32{0, 1, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		// the larger the position, the closer the vector is to the query
+		assert.Equal(t, ids[objectCount-1], res[0].ID)
+		assert.Equal(t, ids[objectCount-2], res[1].ID)
+		assert.Equal(t, ids[objectCount-3], res[2].ID)
+	})
+
+	t.Run("aggregations combine the results of all shards", func(t *testing.T) {
+		res, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			Kind:             kind.Thing,
+			ClassName:        schema.ClassName(class.Class),
+			IncludeMetaCount: true,
+			Properties: []traverser.AggregateProperty{
+				{
+					Name: "position",
+					Aggregators: []traverser.Aggregator{
+						traverser.MeanAggregator,
+						traverser.MinimumAggregator,
+						traverser.MaximumAggregator,
+						traverser.SumAggregator,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, objectCount, res.Groups[0].Count)
+
+		numerical := res.Groups[0].Properties["position"].NumericalAggregations
+		assert.Equal(t, map[string]float64{
+			"mean":    29.5,
+			"minimum": 0,
+			"maximum": 59,
+			"sum":     1770,
+		}, numerical)
+	})
+
+	t.Run("deleting an object from its shard", func(t *testing.T) {
+		err := repo.DeleteThing(context.Background(), class.Class, ids[0])
+		require.Nil(t, err)
+
+		ok, err := repo.Exists(context.Background(), ids[0])
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
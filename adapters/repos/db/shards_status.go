@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/shards"
+)
+
+// ShardsStatus reports the status of every shard of the class, ordered by
+// the shard names
+func (d *DB) ShardsStatus(ctx context.Context, kind kind.Kind,
+	className schema.ClassName) ([]shards.Status, error) {
+	idx := d.GetIndex(kind, className)
+	if idx == nil {
+		return nil, fmt.Errorf("shards status of class %s: class does not exist", className)
+	}
+
+	out := make([]shards.Status, 0, len(idx.Shards))
+	for _, shard := range idx.Shards {
+		out = append(out, shard.status())
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Name < out[b].Name
+	})
+
+	return out, nil
+}
+
+func (s *Shard) status() shards.Status {
+	out := shards.Status{Name: s.name}
+	if s.vectorQueue != nil {
+		out.VectorQueueLength = s.vectorQueue.length()
+		out.VectorQueueFailures = s.vectorQueue.failed()
+	}
+
+	return out
+}
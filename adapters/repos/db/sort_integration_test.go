@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSorting(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:      "SortableProduct",
+		ShardCount: 3,
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "price",
+				DataType: []string{string(schema.DataTypeNumber)},
+			},
+			&models.Property{
+				Name:     "count",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:     "inStock",
+				DataType: []string{string(schema.DataTypeBoolean)},
+			},
+			&models.Property{
+				Name:     "released",
+				DataType: []string{string(schema.DataTypeDate)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	// price has ties (i%10), count is unique and in the reverse order of i,
+	// name is unique, but in no particular order
+	objectCount := 40
+	ids := make([]strfmt.UUID, objectCount)
+	names := make([]string, objectCount)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i := range ids {
+			ids[i] = strfmt.UUID(uuid.New().String())
+			names[i] = fmt.Sprintf("product-%02d", (i*7)%objectCount)
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class: class.Class,
+				ID:    ids[i],
+				Schema: map[string]interface{}{
+					"price":    float64(i % 10),
+					"count":    int64(objectCount - 1 - i),
+					"name":     names[i],
+					"inStock":  i%2 == 0,
+					"released": start.Add(time.Duration(i) * 24 * time.Hour).Format(time.RFC3339),
+				},
+			}, []float32{1, float32(i % 4), 0})
+			require.Nil(t, err)
+		}
+	})
+
+	byPositions := func(positions ...int) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(positions))
+		for i, pos := range positions {
+			out[i] = ids[pos]
+		}
+		return out
+	}
+
+	search := func(t *testing.T, pagination *filters.Pagination, filter *filters.LocalFilter,
+		sort ...filters.Sort) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: pagination,
+			Filters:    filter,
+			Sort:       sort,
+		})
+		require.Nil(t, err)
+		return resultIDs(res)
+	}
+
+	asc := func(prop string) filters.Sort {
+		return filters.Sort{Path: []string{prop}, Order: filters.SortOrderAsc}
+	}
+
+	desc := func(prop string) filters.Sort {
+		return filters.Sort{Path: []string{prop}, Order: filters.SortOrderDesc}
+	}
+
+	t.Run("by int ascending", func(t *testing.T) {
+		res := search(t, &filters.Pagination{Limit: 5}, nil, asc("count"))
+		assert.Equal(t, byPositions(39, 38, 37, 36, 35), res)
+	})
+
+	t.Run("by int descending with an offset", func(t *testing.T) {
+		res := search(t, &filters.Pagination{Offset: 5, Limit: 5}, nil, desc("count"))
+		assert.Equal(t, byPositions(5, 6, 7, 8, 9), res)
+	})
+
+	t.Run("by number with an int as tie-breaker", func(t *testing.T) {
+		res := search(t, &filters.Pagination{Limit: 8}, nil, desc("price"), asc("count"))
+		assert.Equal(t, byPositions(39, 29, 19, 9, 38, 28, 18, 8), res)
+	})
+
+	t.Run("by string", func(t *testing.T) {
+		sorted := make([]int, objectCount)
+		for i := range sorted {
+			sorted[i] = i
+		}
+		sort.Slice(sorted, func(a, b int) bool {
+			return names[sorted[a]] < names[sorted[b]]
+		})
+
+		res := search(t, &filters.Pagination{Limit: 4}, nil, asc("name"))
+		assert.Equal(t, byPositions(sorted[:4]...), res)
+	})
+
+	t.Run("by date descending", func(t *testing.T) {
+		res := search(t, &filters.Pagination{Limit: 3}, nil, desc("released"))
+		assert.Equal(t, byPositions(39, 38, 37), res)
+	})
+
+	t.Run("by boolean with a filter and a tie-breaker", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorLessThan,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: "price",
+				},
+				Value: &filters.Value{
+					Value: 2.0,
+					Type:  schema.DataTypeNumber,
+				},
+			},
+		}
+
+		res := search(t, &filters.Pagination{Limit: 8}, filter, desc("inStock"), asc("count"))
+		assert.Equal(t, byPositions(30, 20, 10, 0, 31, 21, 11, 1), res)
+	})
+
+	t.Run("vector search with a property as tie-breaker", func(t *testing.T) {
+		// all objects with i%4==0 have an identical vector
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 10},
+			SearchVector: []float32{1, 0, 0},
+			Sort:         []filters.Sort{desc("count")},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, byPositions(0, 4, 8, 12, 16, 20, 24, 28, 32, 36), resultIDs(res))
+	})
+
+	t.Run("listing all things sorted", func(t *testing.T) {
+		res, err := repo.ThingSearch(context.Background(), &filters.Pagination{Limit: 3},
+			[]filters.Sort{desc("count")}, nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Equal(t, byPositions(0, 1, 2), resultIDs(res))
+	})
+}
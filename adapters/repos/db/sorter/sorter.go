@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package sorter orders storage objects by the values of their properties.
+// Objects without a value for a property are always placed after the ones
+// that have a value, regardless of the order.
+package sorter
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
+)
+
+// Objects sorts the objects by the specified properties. The sort is stable,
+// so objects with identical values keep their previous relative order.
+func Objects(objects []*storobj.Object,
+	by []filters.Sort) []*storobj.Object {
+	sort.Stable(objectsByProps{objects: objects, by: by})
+	return objects
+}
+
+// ObjectsByDistance sorts the objects by their distance first. The specified
+// properties are only used to break ties between equally distant objects.
+func ObjectsByDistance(objects []*storobj.Object, distances []float32,
+	by []filters.Sort) []*storobj.Object {
+	sort.Stable(objectsByDistance{objects: objects, distances: distances, by: by})
+	return objects
+}
+
+type objectsByProps struct {
+	objects []*storobj.Object
+	by      []filters.Sort
+}
+
+func (o objectsByProps) Len() int {
+	return len(o.objects)
+}
+
+func (o objectsByProps) Less(a, b int) bool {
+	return compareObjects(o.objects[a], o.objects[b], o.by) < 0
+}
+
+func (o objectsByProps) Swap(a, b int) {
+	o.objects[a], o.objects[b] = o.objects[b], o.objects[a]
+}
+
+type objectsByDistance struct {
+	objects   []*storobj.Object
+	distances []float32
+	by        []filters.Sort
+}
+
+func (o objectsByDistance) Len() int {
+	return len(o.objects)
+}
+
+func (o objectsByDistance) Less(a, b int) bool {
+	if o.distances[a] != o.distances[b] {
+		return o.distances[a] < o.distances[b]
+	}
+
+	return compareObjects(o.objects[a], o.objects[b], o.by) < 0
+}
+
+func (o objectsByDistance) Swap(a, b int) {
+	o.objects[a], o.objects[b] = o.objects[b], o.objects[a]
+	o.distances[a], o.distances[b] = o.distances[b], o.distances[a]
+}
+
+func compareObjects(a, b *storobj.Object, by []filters.Sort) int {
+	for _, s := range by {
+		res := compareValues(propValue(a, s), propValue(b, s), s.Desc())
+		if res != 0 {
+			return res
+		}
+	}
+
+	return 0
+}
+
+// propValue extracts the value to sort by. For arrays the smallest element is
+// used in ascending order and the largest in descending order, which matches
+// the first occurrence of the object when reading the inverted index.
+func propValue(obj *storobj.Object, s filters.Sort) interface{} {
+	props, ok := obj.Schema().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	value := props[s.Property()]
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	var out interface{}
+	for _, elem := range list {
+		if out == nil {
+			out = elem
+			continue
+		}
+
+		res := compareValues(elem, out, false)
+		if (s.Desc() && res > 0) || (!s.Desc() && res < 0) {
+			out = elem
+		}
+	}
+
+	return out
+}
+
+// compareValues returns a negative number if a should be placed before b, a
+// positive one if b should be placed before a and 0 if they are equal
+func compareValues(a, b interface{}, desc bool) int {
+	if a == nil || b == nil {
+		// missing values are always placed last
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+
+	res := compareNonNil(a, b)
+	if desc {
+		return -res
+	}
+
+	return res
+}
+
+func compareNonNil(a, b interface{}) int {
+	if af, ok := asFloat(a); ok {
+		if bf, ok := asFloat(b); ok {
+			return compareFloats(af, bf)
+		}
+	}
+
+	switch av := a.(type) {
+	case bool:
+		if bv, ok := b.(bool); ok {
+			return compareBools(av, bv)
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			return compareStrings(av, bv)
+		}
+	}
+
+	return 0
+}
+
+func asFloat(in interface{}) (float64, bool) {
+	switch v := in.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareStrings compares dates chronologically, as the textual
+// representation might use different time zones, and all other strings
+// lexicographically
+func compareStrings(a, b string) int {
+	at, errA := time.Parse(time.RFC3339Nano, a)
+	bt, errB := time.Parse(time.RFC3339Nano, b)
+	if errA == nil && errB == nil {
+		switch {
+		case at.Before(bt):
+			return -1
+		case at.After(bt):
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(a, b)
+}
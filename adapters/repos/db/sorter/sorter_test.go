@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sorter
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortObjects(t *testing.T) {
+	obj := func(id string, props map[string]interface{}) *storobj.Object {
+		return storobj.FromThing(&models.Thing{
+			ID:     strfmt.UUID(id),
+			Class:  "Product",
+			Schema: props,
+		}, nil)
+	}
+
+	ids := func(in []*storobj.Object) []string {
+		out := make([]string, len(in))
+		for i, o := range in {
+			out[i] = o.ID().String()
+		}
+		return out
+	}
+
+	input := func() []*storobj.Object {
+		return []*storobj.Object{
+			obj("a", map[string]interface{}{
+				"price": 20.0, "name": "Kettle", "inStock": true,
+				"released": "2020-03-01T10:00:00+02:00",
+			}),
+			obj("b", map[string]interface{}{
+				"price": 10.0, "name": "Toaster", "inStock": false,
+				"released": "2020-03-01T09:00:00+00:00",
+			}),
+			obj("c", map[string]interface{}{
+				"price": 20.0, "name": "Blender",
+			}),
+			obj("d", map[string]interface{}{
+				"name": "Fridge", "inStock": true,
+				"released": "2019-12-24T00:00:00Z",
+			}),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		by       []filters.Sort
+		expected []string
+	}{
+		{
+			name:     "number ascending",
+			by:       []filters.Sort{{Path: []string{"price"}, Order: "asc"}},
+			expected: []string{"b", "a", "c", "d"},
+		},
+		{
+			name:     "number descending, missing values last",
+			by:       []filters.Sort{{Path: []string{"price"}, Order: "desc"}},
+			expected: []string{"a", "c", "b", "d"},
+		},
+		{
+			name: "number with a string as tie-breaker",
+			by: []filters.Sort{
+				{Path: []string{"price"}, Order: "desc"},
+				{Path: []string{"name"}, Order: "asc"},
+			},
+			expected: []string{"c", "a", "b", "d"},
+		},
+		{
+			name:     "string ascending",
+			by:       []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			expected: []string{"c", "d", "a", "b"},
+		},
+		{
+			name:     "boolean descending",
+			by:       []filters.Sort{{Path: []string{"inStock"}, Order: "desc"}},
+			expected: []string{"a", "d", "b", "c"},
+		},
+		{
+			name:     "dates in different time zones",
+			by:       []filters.Sort{{Path: []string{"released"}, Order: "asc"}},
+			expected: []string{"d", "a", "b", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ids(Objects(input(), test.by)))
+		})
+	}
+
+	t.Run("by distance with a property as tie-breaker", func(t *testing.T) {
+		objects := input()
+		distances := []float32{0.2, 0.1, 0.2, 0.2}
+		res := ObjectsByDistance(objects, distances, []filters.Sort{
+			{Path: []string{"name"}, Order: "asc"},
+		})
+		assert.Equal(t, []string{"b", "c", "d", "a"}, ids(res))
+	})
+
+	t.Run("arrays use their smallest or largest element", func(t *testing.T) {
+		objects := []*storobj.Object{
+			obj("a", map[string]interface{}{"sizes": []interface{}{3.0, 9.0}}),
+			obj("b", map[string]interface{}{"sizes": []interface{}{5.0, 6.0}}),
+			obj("c", map[string]interface{}{"sizes": []interface{}{1.0, 4.0}}),
+		}
+
+		res := Objects(objects, []filters.Sort{{Path: []string{"sizes"}, Order: "asc"}})
+		assert.Equal(t, []string{"c", "a", "b"}, ids(res))
+
+		res = Objects(objects, []filters.Sort{{Path: []string{"sizes"}, Order: "desc"}})
+		assert.Equal(t, []string{"a", "b", "c"}, ids(res))
+	})
+}
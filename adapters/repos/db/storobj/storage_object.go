@@ -34,6 +34,10 @@ type Object struct {
 	Action            models.Action `json:"action"`
 	Vector            []float32     `json:"vector"`
 	indexID           uint32
+
+	// score is only set on the results of a ranked search, it is never
+	// persisted
+	score *float32
 }
 
 func New(k kind.Kind, docID uint32) *Object {
@@ -90,6 +94,21 @@ func (ko *Object) IndexID() uint32 {
 	return ko.indexID
 }
 
+// SetScore attaches the score of a ranked search to the object, so it can be
+// returned as part of the search result
+func (ko *Object) SetScore(score float32) {
+	ko.score = &score
+}
+
+// Score of a ranked search, 0 if the object is not the result of one
+func (ko *Object) Score() float32 {
+	if ko.score == nil {
+		return 0
+	}
+
+	return *ko.score
+}
+
 func (ko *Object) CreationTimeUnix() int64 {
 	switch ko.Kind {
 	case kind.Thing:
@@ -200,6 +219,11 @@ func (ko *Object) SearchResult() *search.Result {
 	}
 	schema.(map[string]interface{})["uuid"] = ko.ID()
 
+	score := float32(1)
+	if ko.score != nil {
+		score = *ko.score
+	}
+
 	return &search.Result{
 		Kind:      ko.Kind,
 		ID:        ko.ID(),
@@ -210,7 +234,7 @@ func (ko *Object) SearchResult() *search.Result {
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
 		UnderscoreProperties: ko.UnderscoreProperties(),
-		Score:                1, // TODO: actuallly score
+		Score:                score,
 		// TODO: Beacon?
 	}
 }
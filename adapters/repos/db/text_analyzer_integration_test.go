@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextAnalyzers(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:      "GermanArticle",
+		ShardCount: 1,
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "body",
+				DataType: []string{string(schema.DataTypeText)},
+				TextAnalyzer: &models.TextAnalyzerConfig{
+					Stopwords: "german",
+					Stemmer:   "german",
+				},
+			},
+			&models.Property{
+				Name:     "city",
+				DataType: []string{string(schema.DataTypeString)},
+				TextAnalyzer: &models.TextAnalyzerConfig{
+					Tokenizer: "field",
+				},
+			},
+			&models.Property{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeText)},
+				TextAnalyzer: &models.TextAnalyzerConfig{
+					Tokenizer:      "ngram",
+					NgramMinLength: 3,
+					NgramMaxLength: 3,
+				},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"2f1c1c4e-8d0b-4b8e-a3b4-6c1f0e5d7a01",
+		"3a2d2d5f-9e1c-4c9f-b4c5-7d2a1f6e8b02",
+		"4b3e3e6a-af2d-4dab-85d6-8e3b2a7f9c03",
+	}
+	objects := []map[string]interface{}{
+		{
+			"body":  "Die roten Häuser stehen am Fluss",
+			"city":  "New York",
+			"title": "Hausbau",
+		},
+		{
+			"body":  "Ein Haus mit einem Garten",
+			"city":  "New Orleans",
+			"title": "Gartenarbeit",
+		},
+		{
+			"body":  "Der Garten ist grün",
+			"city":  "York",
+			"title": "Gärten",
+		},
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i, props := range objects {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  class.Class,
+				ID:     ids[i],
+				Schema: props,
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+	})
+
+	all := &filters.Pagination{Limit: 10}
+
+	filter := func(t *testing.T, operator filters.Operator, prop,
+		value string) ([]search.Result, error) {
+		return repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: all,
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: operator,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(prop),
+					},
+					Value: &filters.Value{
+						Value: value,
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+		})
+	}
+
+	t.Run("the filter value is stemmed like the prop", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorEqual, "body", "Häuser")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[1]}, resultIDs(res))
+	})
+
+	t.Run("stopwords are removed from the filter value", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorEqual, "body", "die Gärten")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1], ids[2]}, resultIDs(res))
+	})
+
+	t.Run("a filter value of only stopwords", func(t *testing.T) {
+		_, err := filter(t, filters.OperatorEqual, "body", "die und der")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("all terms of a multi-term value must match", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorEqual, "body", "Haus Garten")
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{ids[1]}, resultIDs(res))
+	})
+
+	t.Run("the field tokenizer matches the whole value only", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorEqual, "city", "York")
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{ids[2]}, resultIDs(res))
+
+		res, err = filter(t, filters.OperatorEqual, "city", "New York")
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{ids[0]}, resultIDs(res))
+	})
+
+	t.Run("like on a field tokenized prop", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorLike, "city", "New *")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[1]}, resultIDs(res))
+	})
+
+	t.Run("like on an ngram tokenized prop", func(t *testing.T) {
+		_, err := filter(t, filters.OperatorLike, "title", "Haus*")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("bm25 on an ngram tokenized prop matches partial words", func(t *testing.T) {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: all,
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Type:       "bm25",
+				Query:      "garten",
+				Properties: []string{"title"},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, ids[1], res[0].ID)
+		assert.Equal(t, ids[2], res[1].ID)
+	})
+}
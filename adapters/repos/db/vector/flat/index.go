@@ -0,0 +1,297 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package flat provides a vector index which answers every query by
+// comparing the search vector to all vectors of its owner. Results are exact
+// and there is no graph to build or persist, which makes it a better fit than
+// hnsw for small classes.
+//
+// The index does not hold a copy of the vectors. They are read from the
+// owner through VectorForIDThunk and the most recently read ones are kept in
+// a cache of at most VectorCacheMaxObjects vectors, i.e. the index never
+// occupies more than VectorCacheMaxObjects * dimensions * 4 bytes. Every
+// search compares all candidates, so vectors which do not fit into the cache
+// are read from disk on every search. Additionally, a search without an allow
+// list allocates 4 bytes per doc id of the owner for its candidates. As there
+// is nothing to rebuild, a restart is instant.
+package flat
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const defaultVectorCacheMaxObjects = 50000
+
+// DocIDs lists all doc ids the owner stores, they are the candidates of a
+// search without an allow list
+type DocIDs func() ([]uint32, error)
+
+// Config is passed to the flat index when its created
+type Config struct {
+	ID               string
+	DistanceProvider distancer.Provider
+	VectorForIDThunk hnsw.VectorForID
+	DocIDsThunk      DocIDs
+
+	// Optional, defaults to defaultVectorCacheMaxObjects if not set. Can be
+	// changed with UpdateConfig.
+	VectorCacheMaxObjects int
+}
+
+type Index struct {
+	id                string
+	distancerProvider distancer.Provider
+	vectorForID       hnsw.VectorForID
+	docIDs            DocIDs
+	cache             *vectorCache
+}
+
+func New(cfg Config) (*Index, error) {
+	if cfg.ID == "" {
+		return nil, errors.Errorf("id cannot be empty")
+	}
+
+	if cfg.DistanceProvider == nil {
+		return nil, errors.Errorf("distance provider cannot be nil")
+	}
+
+	if cfg.VectorForIDThunk == nil {
+		return nil, errors.Errorf("vectorForIDThunk cannot be nil")
+	}
+
+	if cfg.DocIDsThunk == nil {
+		return nil, errors.Errorf("docIDsThunk cannot be nil")
+	}
+
+	if cfg.VectorCacheMaxObjects == 0 {
+		cfg.VectorCacheMaxObjects = defaultVectorCacheMaxObjects
+	}
+
+	return &Index{
+		id:                cfg.ID,
+		distancerProvider: cfg.DistanceProvider,
+		vectorForID:       cfg.VectorForIDThunk,
+		docIDs:            cfg.DocIDsThunk,
+		cache:             newVectorCache(cfg.VectorCacheMaxObjects),
+	}, nil
+}
+
+// Add caches the vector of the specified id, it must already be stored by
+// the owner. Adding an id which is already cached replaces its vector.
+func (i *Index) Add(id int, vector []float32) error {
+	if id < 0 {
+		return errors.Errorf("flat index %q: invalid id %d", i.id, id)
+	}
+
+	if len(vector) == 0 {
+		return errors.Errorf("flat index %q: insert empty vector at id %d", i.id, id)
+	}
+
+	i.cache.set(uint32(id), vector)
+	return nil
+}
+
+// Delete removes the id from the cache. The id is no longer searched once
+// the owner does not list it anymore. Deleting an id which is not cached is a
+// no-op.
+func (i *Index) Delete(id int) error {
+	if id < 0 {
+		return nil
+	}
+
+	i.cache.delete(uint32(id))
+	return nil
+}
+
+// Len is the number of doc ids the index searches
+func (i *Index) Len() (int, error) {
+	ids, err := i.docIDs()
+	if err != nil {
+		return 0, errors.Wrapf(err, "flat index %q: list doc ids", i.id)
+	}
+
+	return len(ids), nil
+}
+
+// CacheStats returns the number of cached vectors and the size of the cache
+func (i *Index) CacheStats() (count, maxObjects int) {
+	return i.cache.stats()
+}
+
+// SearchByID returns the k closest ids to the vector of the specified id,
+// which includes the id itself
+func (i *Index) SearchByID(id int, k int) ([]int, error) {
+	var vector []float32
+	if id >= 0 {
+		vec, ok, err := i.vector(uint32(id))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			vector = vec
+		}
+	}
+
+	if vector == nil {
+		return nil, errors.Errorf("flat index %q: id %d is not indexed", i.id, id)
+	}
+
+	return i.SearchByVector(vector, k, nil)
+}
+
+// SearchByVector returns the k closest ids to the specified vector ordered
+// by ascending distance. With an allow list, only the allowed ids are
+// compared, which makes restrictive filters cheap.
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList) ([]int, error) {
+	if k <= 0 {
+		return nil, nil
+	}
+
+	var candidates []uint32
+	if allow != nil {
+		candidates = make([]uint32, 0, len(allow))
+		for docID := range allow {
+			candidates = append(candidates, docID)
+		}
+	} else {
+		ids, err := i.docIDs()
+		if err != nil {
+			return nil, errors.Wrapf(err, "flat index %q: list doc ids", i.id)
+		}
+		candidates = ids
+	}
+
+	distancer := i.distancerProvider.New(vector)
+	results := &maxHeap{}
+	for _, docID := range candidates {
+		candidate, ok, err := i.vector(docID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		dist, _, err := distancer.Distance(candidate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "flat index %q: distance to id %d", i.id, docID)
+		}
+
+		id := int(docID)
+		if results.Len() < k {
+			heap.Push(results, result{id: id, dist: dist})
+		} else if (result{id: id, dist: dist}).closerThan((*results)[0]) {
+			(*results)[0] = result{id: id, dist: dist}
+			heap.Fix(results, 0)
+		}
+	}
+
+	out := make([]int, results.Len())
+	for pos := len(out) - 1; pos >= 0; pos-- {
+		out[pos] = heap.Pop(results).(result).id
+	}
+
+	return out, nil
+}
+
+// vector returns the cached vector or reads it from the owner. Ids which no
+// longer resolve to an object, as well as objects without a vector, are
+// skipped.
+func (i *Index) vector(docID uint32) ([]float32, bool, error) {
+	if vec, ok := i.cache.get(docID); ok {
+		return vec, true, nil
+	}
+
+	vec, err := i.vectorForID(context.Background(), int32(docID))
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return nil, false, nil
+		}
+
+		return nil, false, errors.Wrapf(err, "flat index %q: get vector of id %d",
+			i.id, docID)
+	}
+
+	if len(vec) == 0 {
+		return nil, false, nil
+	}
+
+	i.cache.set(docID, vec)
+	return vec, true, nil
+}
+
+// UpdateConfig accepts the settings of a live class, only the size of the
+// vector cache applies to a flat index
+func (i *Index) UpdateConfig(cfg hnsw.UpdatableConfig) error {
+	if cfg.VectorCacheMaxObjects != 0 {
+		i.cache.updateMaxSize(cfg.VectorCacheMaxObjects)
+	}
+
+	return nil
+}
+
+// Shutdown releases the cached vectors. The index must not be used
+// afterwards.
+func (i *Index) Shutdown() error {
+	i.cache.drop()
+	return nil
+}
+
+// Drop is identical to Shutdown, as there are no files to remove
+func (i *Index) Drop() error {
+	return i.Shutdown()
+}
+
+// Backup is a no-op, as the vectors are part of the objects which are backed
+// up by the owner
+func (i *Index) Backup(rootPath string) error {
+	return nil
+}
+
+type result struct {
+	id   int
+	dist float32
+}
+
+// closerThan orders by distance and breaks ties by id, so that results are
+// stable across queries
+func (r result) closerThan(other result) bool {
+	if r.dist != other.dist {
+		return r.dist < other.dist
+	}
+
+	return r.id < other.id
+}
+
+// maxHeap holds the closest results found so far with the furthest one on
+// top, so it can be replaced when a closer one is found
+type maxHeap []result
+
+func (h maxHeap) Len() int            { return len(h) }
+func (h maxHeap) Less(a, b int) bool  { return h[b].closerThan(h[a]) }
+func (h maxHeap) Swap(a, b int)       { h[a], h[b] = h[b], h[a] }
+func (h *maxHeap) Push(x interface{}) { *h = append(*h, x.(result)) }
+
+func (h *maxHeap) Pop() interface{} {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,279 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package flat
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatIndex(t *testing.T) {
+	providers := map[string]distancer.Provider{
+		"cosine":     distancer.NewCosineProvider(),
+		"dot":        distancer.NewDotProductProvider(),
+		"l2-squared": distancer.NewL2SquaredProvider(),
+		"manhattan":  distancer.NewManhattanProvider(),
+		"hamming":    distancer.NewHammingProvider(),
+	}
+
+	for name, provider := range providers {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(7))
+			vectors := make([][]float32, 500)
+			for i := range vectors {
+				vectors[i] = make([]float32, 16)
+				for j := range vectors[i] {
+					// small integers, so that hamming distances are meaningful
+					vectors[i][j] = float32(r.Intn(4))
+				}
+			}
+
+			store := newFakeStore()
+			index, err := New(store.config(provider, 100))
+			require.Nil(t, err)
+			for id, vector := range vectors {
+				store.put(id, vector)
+				require.Nil(t, index.Add(id, vector))
+			}
+
+			deleted := map[int]bool{}
+			for id := 0; id < len(vectors); id += 7 {
+				store.delete(id)
+				require.Nil(t, index.Delete(id))
+				deleted[id] = true
+			}
+			length, err := index.Len()
+			require.Nil(t, err)
+			assert.Equal(t, len(vectors)-len(deleted), length)
+
+			// bruteForce is the reference the index is compared against
+			bruteForce := func(query []float32, k int, allow helpers.AllowList) []int {
+				d := provider.New(query)
+				var candidates []result
+				for id, vector := range vectors {
+					if deleted[id] || (allow != nil && !allow.Contains(uint32(id))) {
+						continue
+					}
+					dist, _, err := d.Distance(vector)
+					require.Nil(t, err)
+					candidates = append(candidates, result{id: id, dist: dist})
+				}
+
+				sort.Slice(candidates, func(a, b int) bool {
+					return candidates[a].closerThan(candidates[b])
+				})
+
+				var out []int
+				for i := 0; i < k && i < len(candidates); i++ {
+					out = append(out, candidates[i].id)
+				}
+				return out
+			}
+
+			query := vectors[len(vectors)-1]
+
+			t.Run("without an allow list", func(t *testing.T) {
+				res, err := index.SearchByVector(query, 20, nil)
+				require.Nil(t, err)
+				assert.Equal(t, bruteForce(query, 20, nil), res)
+			})
+
+			t.Run("with an allow list", func(t *testing.T) {
+				allow := helpers.AllowList{}
+				for id := 0; id < len(vectors); id += 3 {
+					allow.Insert(uint32(id))
+				}
+				// ids which are not indexed are skipped
+				allow.Insert(uint32(len(vectors) + 100))
+
+				res, err := index.SearchByVector(query, 20, allow)
+				require.Nil(t, err)
+				assert.Equal(t, bruteForce(query, 20, allow), res)
+			})
+
+			t.Run("with a limit larger than the index", func(t *testing.T) {
+				res, err := index.SearchByVector(query, 1000, nil)
+				require.Nil(t, err)
+				assert.Len(t, res, len(vectors)-len(deleted))
+			})
+
+			t.Run("by id", func(t *testing.T) {
+				res, err := index.SearchByID(len(vectors)-1, 20)
+				require.Nil(t, err)
+				assert.Equal(t, bruteForce(query, 20, nil), res)
+
+				_, err = index.SearchByID(0, 20)
+				assert.NotNil(t, err, "id 0 was deleted")
+			})
+
+			t.Run("the cache is bounded", func(t *testing.T) {
+				count, maxObjects := index.CacheStats()
+				assert.Equal(t, 100, maxObjects)
+				assert.LessOrEqual(t, count, 100)
+			})
+		})
+	}
+}
+
+func TestFlatIndexReplacesVectors(t *testing.T) {
+	store := newFakeStore()
+	index, err := New(store.config(distancer.NewL2SquaredProvider(), 0))
+	require.Nil(t, err)
+
+	for id, vector := range [][]float32{{0, 0}, {1, 1}, {5, 5}} {
+		store.put(id%2, vector)
+		require.Nil(t, index.Add(id%2, vector))
+	}
+	length, err := index.Len()
+	require.Nil(t, err)
+	assert.Equal(t, 2, length)
+
+	res, err := index.SearchByVector([]float32{0, 0}, 2, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []int{1, 0}, res)
+}
+
+func TestFlatIndexReadsVectorsFromTheOwner(t *testing.T) {
+	store := newFakeStore()
+	for id := 0; id < 10; id++ {
+		store.put(id, []float32{float32(id)})
+	}
+	// objects without a vector are skipped
+	store.put(10, nil)
+
+	index, err := New(store.config(distancer.NewL2SquaredProvider(), 4))
+	require.Nil(t, err)
+
+	t.Run("vectors which were never added are searched", func(t *testing.T) {
+		res, err := index.SearchByVector([]float32{2.2}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []int{2, 3, 1}, res)
+		assert.Equal(t, 11, store.readCount())
+	})
+
+	t.Run("the cache never exceeds its size", func(t *testing.T) {
+		count, _ := index.CacheStats()
+		assert.LessOrEqual(t, count, 4)
+	})
+
+	t.Run("cached vectors are not read again", func(t *testing.T) {
+		require.Nil(t, index.UpdateConfig(hnsw.UpdatableConfig{VectorCacheMaxObjects: 20}))
+
+		_, err := index.SearchByVector([]float32{2.2}, 3, nil)
+		require.Nil(t, err)
+		reads := store.readCount()
+
+		_, err = index.SearchByVector([]float32{2.2}, 3, nil)
+		require.Nil(t, err)
+		// only the object without a vector is never cached
+		assert.Equal(t, reads+1, store.readCount())
+	})
+
+	t.Run("the cache is emptied if it exceeds a smaller size", func(t *testing.T) {
+		require.Nil(t, index.UpdateConfig(hnsw.UpdatableConfig{VectorCacheMaxObjects: 2}))
+		count, maxObjects := index.CacheStats()
+		assert.Equal(t, 0, count)
+		assert.Equal(t, 2, maxObjects)
+	})
+
+	t.Run("a full cache still serves the vectors it holds", func(t *testing.T) {
+		require.Nil(t, index.UpdateConfig(hnsw.UpdatableConfig{VectorCacheMaxObjects: 4}))
+
+		_, err := index.SearchByVector([]float32{2.2}, 3, nil)
+		require.Nil(t, err)
+		count, _ := index.CacheStats()
+		assert.Equal(t, 4, count)
+		reads := store.readCount()
+
+		_, err = index.SearchByVector([]float32{2.2}, 3, nil)
+		require.Nil(t, err)
+		// 11 objects, of which 4 are served from the cache
+		assert.Equal(t, reads+7, store.readCount())
+	})
+}
+
+// fakeStore stands in for the shard which owns the vectors
+type fakeStore struct {
+	sync.Mutex
+	vectors map[uint32][]float32
+	reads   int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{vectors: map[uint32][]float32{}}
+}
+
+func (s *fakeStore) config(provider distancer.Provider, cacheSize int) Config {
+	return Config{
+		ID:                    "flat",
+		DistanceProvider:      provider,
+		VectorForIDThunk:      s.vectorForID,
+		DocIDsThunk:           s.docIDs,
+		VectorCacheMaxObjects: cacheSize,
+	}
+}
+
+func (s *fakeStore) put(id int, vector []float32) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.vectors[uint32(id)] = vector
+}
+
+func (s *fakeStore) delete(id int) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.vectors, uint32(id))
+}
+
+func (s *fakeStore) readCount() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.reads
+}
+
+func (s *fakeStore) vectorForID(ctx context.Context, id int32) ([]float32, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.reads++
+	vector, ok := s.vectors[uint32(id)]
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(id, "not found")
+	}
+
+	return vector, nil
+}
+
+func (s *fakeStore) docIDs() ([]uint32, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	out := make([]uint32, 0, len(s.vectors))
+	for id := range s.vectors {
+		out = append(out, id)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package flat
+
+import "sync"
+
+// vectorCache holds up to maxSize vectors. Once it is full, no further
+// vectors are added. A search reads all vectors, so evicting any of them
+// would only make room for a vector that is then evicted again before it is
+// read the next time. Keeping the first ones instead means each search of a
+// class larger than the cache is served from the cache for maxSize vectors.
+type vectorCache struct {
+	sync.RWMutex
+	vectors map[uint32][]float32
+	maxSize int
+}
+
+func newVectorCache(maxSize int) *vectorCache {
+	return &vectorCache{
+		vectors: map[uint32][]float32{},
+		maxSize: maxSize,
+	}
+}
+
+func (c *vectorCache) get(id uint32) ([]float32, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	vec, ok := c.vectors[id]
+	return vec, ok
+}
+
+func (c *vectorCache) set(id uint32, vec []float32) {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.vectors[id]; !ok && len(c.vectors) >= c.maxSize {
+		return
+	}
+
+	c.vectors[id] = vec
+}
+
+func (c *vectorCache) delete(id uint32) {
+	c.Lock()
+	defer c.Unlock()
+
+	delete(c.vectors, id)
+}
+
+func (c *vectorCache) stats() (count, maxSize int) {
+	c.RLock()
+	defer c.RUnlock()
+
+	return len(c.vectors), c.maxSize
+}
+
+// updateMaxSize empties the cache right away if it exceeds the new size
+func (c *vectorCache) updateMaxSize(maxSize int) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.maxSize = maxSize
+	if len(c.vectors) > maxSize {
+		c.vectors = map[uint32][]float32{}
+	}
+}
+
+func (c *vectorCache) drop() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.vectors = map[uint32][]float32{}
+}
@@ -40,6 +40,9 @@ type vectorIndex interface {
 	Add(id int, vector []float32) error
 	KnnSearchByVectorMaxDist(query []float32, dist float32, ef int,
 		allowList helpers.AllowList) ([]int, error)
+	Shutdown() error
+	Drop() error
+	Backup(rootPath string) error
 }
 
 // Config is passed to the GeoIndex when its created
@@ -52,6 +55,12 @@ type Config struct {
 }
 
 func NewIndex(config Config) (*Index, error) {
+	// without persistence there are no commit logs to snapshot
+	snapshotInterval := 10 * time.Second
+	if config.DisablePersistence {
+		snapshotInterval = 0
+	}
+
 	vi, err := hnsw.New(hnsw.Config{
 		VectorForIDThunk:      config.CoordinatesForID.VectorForID,
 		ID:                    config.ID,
@@ -59,6 +68,7 @@ func NewIndex(config Config) (*Index, error) {
 		EFConstruction:        128,
 		MaximumConnections:    64,
 		MakeCommitLoggerThunk: makeCommitLoggerFromConfig(config),
+		SnapshotInterval:      snapshotInterval,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "underlying hnsw index")
@@ -77,7 +87,7 @@ func makeCommitLoggerFromConfig(config Config) hnsw.MakeCommitLogger {
 	if !config.DisablePersistence {
 		makeCL = func() (hnsw.CommitLogger, error) {
 			return hnsw.NewCommitLogger(config.RootPath, config.ID, 10*time.Second,
-				config.Logger)
+				config.Logger, nil)
 		}
 	}
 	return makeCL
@@ -109,3 +119,20 @@ func (i *Index) WithinRange(ctx context.Context,
 
 	return i.vectorIndex.KnnSearchByVectorMaxDist(query, geoRange.Distance, 800, nil)
 }
+
+// Shutdown stops the underlying index and closes its files. The index must
+// not be used afterwards.
+func (i *Index) Shutdown() error {
+	return i.vectorIndex.Shutdown()
+}
+
+// Drop shuts the index down and removes its files from disk
+func (i *Index) Drop() error {
+	return i.vectorIndex.Drop()
+}
+
+// Backup writes a copy of the index into the specified root path, see
+// hnsw.Backup for details
+func (i *Index) Backup(rootPath string) error {
+	return i.vectorIndex.Backup(rootPath)
+}
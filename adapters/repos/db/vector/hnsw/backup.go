@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Backup writes a snapshot of the current state of the index into the
+// specified root path, together with an empty commit log which is newer than
+// the snapshot. If the index is compressed, its codes are written as well. An
+// index created on top of these files continues exactly where the backup left
+// off. The caller must make sure that no writes happen while the backup is
+// taken, tombstone cleanups are held back by the index itself.
+//
+// Tombstoned nodes are cleaned up first. A restored index starts without the
+// vectors of deleted objects in its cache, so a tombstoned entrypoint would
+// make searches fail until the next cleanup cycle.
+func (h *hnsw) Backup(rootPath string) error {
+	if err := h.CleanUpTombstonedNodes(); err != nil {
+		return errors.Wrapf(err, "backup hnsw index %q", h.id)
+	}
+
+	h.cleanupLock.Lock()
+	defer h.cleanupLock.Unlock()
+
+	state := h.copyState()
+
+	// commit logs up to and including the time stamp of a snapshot are
+	// skipped on startup, writes after a restore must go to a newer one
+	timeStamp := time.Now().Unix()
+	if err := writeSnapshot(rootPath, h.id, timeStamp, state); err != nil {
+		return errors.Wrapf(err, "backup hnsw index %q", h.id)
+	}
+
+	if err := h.writeCompression(rootPath); err != nil {
+		return errors.Wrapf(err, "backup hnsw index %q", h.id)
+	}
+
+	if err := os.MkdirAll(commitLogDirectory(rootPath, h.id), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "backup hnsw index %q", h.id)
+	}
+
+	fd, err := os.Create(commitLogFileName(rootPath, h.id,
+		fmt.Sprintf("%d", timeStamp+1)))
+	if err != nil {
+		return errors.Wrapf(err, "backup hnsw index %q", h.id)
+	}
+
+	return fd.Close()
+}
+
+// copyState returns a deep copy of the graph, so it can be serialized without
+// holding any locks
+func (h *hnsw) copyState() *DeserializationResult {
+	h.RLock()
+	defer h.RUnlock()
+
+	state := &DeserializationResult{
+		Nodes:             make([]*vertex, len(h.nodes)),
+		Entrypoint:        uint32(h.entryPointID),
+		Level:             uint16(h.currentMaximumLayer),
+		Tombstones:        make(map[int]struct{}, len(h.tombstones)),
+		EntrypointChanged: true,
+	}
+
+	for id := range h.tombstones {
+		state.Tombstones[id] = struct{}{}
+	}
+
+	for i, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		node.RLock()
+		connections := make(map[int][]uint32, len(node.connections))
+		for level, links := range node.connections {
+			connections[level] = append([]uint32{}, links...)
+		}
+		state.Nodes[i] = &vertex{
+			id:          node.id,
+			level:       node.level,
+			connections: connections,
+		}
+		node.RUnlock()
+	}
+
+	return state
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHnswBackup(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	backupDirName := dirName + "_backup"
+	os.MkdirAll(dirName, 0o777)
+	indexID := "integrationtest_backup"
+	defer func() {
+		os.RemoveAll(dirName)
+		os.RemoveAll(backupDirName)
+	}()
+
+	// one more vector than the test vectors, so there is something to add
+	// after the restore
+	vectors := append(append([][]float32{}, testVectors...), testVectors[3])
+	vectorForID := func(ctx context.Context, id int32) ([]float32, error) {
+		return vectors[int(id)], nil
+	}
+
+	logger, _ := test.NewNullLogger()
+	makeIndex := func(t *testing.T, rootPath string) *hnsw {
+		index, err := New(Config{
+			RootPath: rootPath,
+			ID:       indexID,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(rootPath, indexID, 0, logger, nil)
+			},
+			MaximumConnections: 30,
+			EFConstruction:     60,
+			VectorForIDThunk:   vectorForID,
+		})
+		require.Nil(t, err)
+		return index
+	}
+
+	index := makeIndex(t, dirName)
+	for i, vec := range vectors[:len(testVectors)] {
+		require.Nil(t, index.Add(i, vec))
+	}
+	require.Nil(t, index.Delete(5))
+
+	// see index_test.go for more context
+	expectedResults := []int{
+		3, 4, // cluster 2
+		7, 8, 6, // cluster 3
+		2, 1, 0, // cluster 1
+	}
+
+	t.Run("take a backup", func(t *testing.T) {
+		require.Nil(t, index.Backup(backupDirName))
+		require.Nil(t, index.Shutdown())
+	})
+
+	var restored *hnsw
+	t.Run("restore an index from the backup", func(t *testing.T) {
+		restored = makeIndex(t, backupDirName)
+		position := 3
+		res, err := restored.knnSearchByVector(testVectors[position], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+		// tombstones are cleaned up before the backup is taken
+		assert.Len(t, restored.tombstones, 0)
+		assert.Nil(t, restored.nodes[5])
+	})
+
+	t.Run("writes after the restore are not lost", func(t *testing.T) {
+		require.Nil(t, restored.Add(len(testVectors), vectors[len(testVectors)]))
+		require.Nil(t, restored.Shutdown())
+
+		reopened := makeIndex(t, backupDirName)
+		defer reopened.Shutdown()
+		res, err := reopened.knnSearchByVector(testVectors[3], 1, 36, nil)
+		require.Nil(t, err)
+		assert.Contains(t, []int{3, len(testVectors)}, res[0])
+		require.Greater(t, len(reopened.nodes), len(testVectors))
+		assert.NotNil(t, reopened.nodes[len(testVectors)])
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -38,16 +39,20 @@ func commitLogDirectory(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, name)
 }
 
+// NewCommitLogger starts logging to the latest commit log of the index. The
+// metrics are optional and can be nil.
 func NewCommitLogger(rootPath, name string,
 	maintainenceInterval time.Duration,
-	logger logrus.FieldLogger) (*hnswCommitLogger, error) {
+	logger logrus.FieldLogger, metrics *Metrics) (*hnswCommitLogger, error) {
 	l := &hnswCommitLogger{
 		events:               make(chan []byte),
+		switches:             make(chan chan logSwitch),
 		rootPath:             rootPath,
 		id:                   name,
 		maintainenceInterval: maintainenceInterval,
 		condensor:            NewMemoryCondensor(logger),
 		logger:               logger,
+		metrics:              metrics,
 	}
 
 	fd, err := getLatestCommitFileOrCreate(rootPath, name)
@@ -55,6 +60,7 @@ func NewCommitLogger(rootPath, name string,
 		return nil, err
 	}
 	l.logFile = fd
+	l.recordSize()
 
 	l.StartLogging()
 	return l, nil
@@ -170,12 +176,24 @@ type condensor interface {
 
 type hnswCommitLogger struct {
 	events               chan []byte
+	switches             chan chan logSwitch
 	logFile              *os.File
 	rootPath             string
 	id                   string
 	condensor            condensor
 	maintainenceInterval time.Duration
 	logger               logrus.FieldLogger
+	metrics              *Metrics
+	shutdown             chan struct{}
+	shutdownOnce         sync.Once
+	wg                   sync.WaitGroup
+}
+
+// logSwitch is the outcome of switching to a new commit log, timeStamp is
+// the time stamp of the commit log which was completed by the switch
+type logSwitch struct {
+	timeStamp int64
+	err       error
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -292,48 +310,112 @@ func (l *hnswCommitLogger) Reset() error {
 }
 
 func (l *hnswCommitLogger) StartLogging() {
+	l.shutdown = make(chan struct{})
+	l.wg.Add(2)
+
 	// switch log
 	go func() {
+		defer l.wg.Done()
 		if l.maintainenceInterval == 0 {
 			l.logger.WithField("action", "commit_logging_skipped").
 				WithField("id", l.id).
 				Info("commit log switching explitictly turned off")
 		}
-		maintenance := time.Tick(l.maintainenceInterval)
+		maintenance, stop := l.maintenanceTicker()
+		defer stop()
 
 		for {
 			select {
 			case event := <-l.events:
-				l.logFile.Write(event)
+				n, _ := l.logFile.Write(event)
+				l.metrics.AddCommitLogSize(n)
+			case done := <-l.switches:
+				timeStamp, err := l.switchLogFile()
+				done <- logSwitch{timeStamp: timeStamp, err: err}
 			case <-maintenance:
 				if err := l.maintenance(); err != nil {
 					l.logger.WithError(err).
 						WithField("action", "hsnw_commit_log_maintenance").
 						Error("hnsw commit log maintenance failed")
 				}
+			case <-l.shutdown:
+				return
 			}
 		}
 	}()
 
 	// condense old logs
 	go func() {
+		defer l.wg.Done()
 		if l.maintainenceInterval == 0 {
 			l.logger.WithField("action", "commit_logging_skipped").
 				WithField("id", l.id).
 				Info("commit log switching explitictly turned off")
 		}
-		maintenance := time.Tick(l.maintainenceInterval)
+		maintenance, stop := l.maintenanceTicker()
+		defer stop()
+
 		for {
-			<-maintenance
+			select {
+			case <-maintenance:
+			case <-l.shutdown:
+				return
+			}
+
 			if err := l.condenseOldLogs(); err != nil {
 				l.logger.WithError(err).
 					WithField("action", "hsnw_commit_log_condensing").
 					Error("hnsw commit log maintenance failed")
 			}
+			l.recordSize()
 		}
 	}()
 }
 
+// recordSize sets the size of all commit log files, as condensing shrinks
+// them, the size can not only be increased on each write
+func (l *hnswCommitLogger) recordSize() {
+	if l.metrics == nil {
+		return
+	}
+
+	files, err := ioutil.ReadDir(commitLogDirectory(l.rootPath, l.id))
+	if err != nil {
+		return
+	}
+
+	var size int64
+	for _, file := range files {
+		size += file.Size()
+	}
+	l.metrics.SetCommitLogSize(size)
+}
+
+// maintenanceTicker returns a nil channel, which never fires, if maintenance
+// is turned off
+func (l *hnswCommitLogger) maintenanceTicker() (<-chan time.Time, func()) {
+	if l.maintainenceInterval == 0 {
+		return nil, func() {}
+	}
+
+	t := time.NewTicker(l.maintainenceInterval)
+	return t.C, t.Stop
+}
+
+// Shutdown stops the background routines and closes the current log file.
+// Every commit that was logged before is written to disk. Nothing can be
+// logged after a shutdown.
+func (l *hnswCommitLogger) Shutdown() error {
+	var err error
+	l.shutdownOnce.Do(func() {
+		close(l.shutdown)
+		l.wg.Wait()
+		err = l.logFile.Close()
+	})
+
+	return err
+}
+
 func (l *hnswCommitLogger) maintenance() error {
 	i, err := l.logFile.Stat()
 	if err != nil {
@@ -341,30 +423,64 @@ func (l *hnswCommitLogger) maintenance() error {
 	}
 
 	if i.Size() > maxUncondensedCommitLogSize {
-		l.logFile.Close()
-
-		// this is a new commit log, initialize with the current time stamp
-		fileName := fmt.Sprintf("%d", time.Now().Unix())
-
 		l.logger.WithField("action", "commit_log_file_switched").
 			WithField("id", l.id).
 			WithField("old_file_name", i.Name()).
 			WithField("old_file_size", i.Size()).
-			WithField("new_file_name", fileName).
 			Info("commit log size crossed threshold, switching to new file")
 
-		fd, err := os.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
-			os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
-		if err != nil {
-			return errors.Wrap(err, "create commit log file")
+		if _, err := l.switchLogFile(); err != nil {
+			return err
 		}
-
-		l.logFile = fd
 	}
 
 	return nil
 }
 
+// SwitchCommitLogs completes the current commit log and returns its time
+// stamp. Every commit which was logged before the call returned is contained
+// in a commit log up to (and including) that time stamp, every later commit
+// in a newer one.
+func (l *hnswCommitLogger) SwitchCommitLogs() (int64, error) {
+	done := make(chan logSwitch, 1)
+	select {
+	case l.switches <- done:
+	case <-l.shutdown:
+		return 0, fmt.Errorf("commit logger %q is shut down", l.id)
+	}
+
+	res := <-done
+	return res.timeStamp, res.err
+}
+
+// switchLogFile must only be called from the routine which writes the
+// events, so that no event is written while the file is switched
+func (l *hnswCommitLogger) switchLogFile() (int64, error) {
+	timeStamp, err := commitLogTimeStamp(l.logFile.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	l.logFile.Close()
+
+	// this is a new commit log, initialize with the current time stamp. It
+	// must be newer than the completed one, even if both were created within
+	// the same second
+	newTimeStamp := time.Now().Unix()
+	if newTimeStamp <= timeStamp {
+		newTimeStamp = timeStamp + 1
+	}
+
+	fd, err := os.OpenFile(commitLogFileName(l.rootPath, l.id,
+		fmt.Sprintf("%d", newTimeStamp)), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+	if err != nil {
+		return 0, errors.Wrap(err, "create commit log file")
+	}
+
+	l.logFile = fd
+	return timeStamp, nil
+}
+
 func (l *hnswCommitLogger) condenseOldLogs() error {
 	files, err := getCommitFileNames(l.rootPath, l.id)
 	if err != nil {
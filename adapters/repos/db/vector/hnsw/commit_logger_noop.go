@@ -51,6 +51,14 @@ func (n *NoopCommitLogger) Reset() error {
 	return nil
 }
 
+func (n *NoopCommitLogger) SwitchCommitLogs() (int64, error) {
+	return 0, nil
+}
+
+func (n *NoopCommitLogger) Shutdown() error {
+	return nil
+}
+
 func MakeNoopCommitLogger() (CommitLogger, error) {
 	return &NoopCommitLogger{}, nil
 }
@@ -0,0 +1,461 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/pq"
+)
+
+const defaultPQTrainingLimit = 100000
+
+const (
+	compressionIdle int32 = iota
+	compressionTraining
+	// training failed, it is not retried until the config changes, as it
+	// would most likely fail again
+	compressionFailed
+)
+
+func pqConfigWithDefaults(cfg PQConfig) PQConfig {
+	if cfg.Centroids == 0 {
+		cfg.Centroids = pq.MaxCentroids
+	}
+
+	if cfg.TrainingLimit == 0 {
+		cfg.TrainingLimit = defaultPQTrainingLimit
+	}
+
+	return cfg
+}
+
+func defaultPQSegments(dimensions int) int {
+	if dimensions >= 4 && dimensions%4 == 0 {
+		return dimensions / 4
+	}
+
+	return dimensions
+}
+
+func (h *hnsw) compressed() bool {
+	h.compressionLock.RLock()
+	defer h.compressionLock.RUnlock()
+
+	return h.quantizer != nil
+}
+
+// updatePQConfig is safe to call on a live index. Disabling compression
+// drops the codes right away, enabling it starts the training as soon as
+// there are enough vectors
+func (h *hnsw) updatePQConfig(cfg PQConfig) {
+	cfg = pqConfigWithDefaults(cfg)
+
+	h.compressionLock.Lock()
+	h.pqConfig = cfg
+	if !cfg.Enabled && h.quantizer != nil {
+		h.quantizer = nil
+		h.codes = nil
+		h.cache.setBypass(false)
+		if err := h.removeCompression(); err != nil {
+			h.logger.WithField("action", "hnsw_compress").
+				WithField("id", h.id).
+				WithError(err).
+				Error("remove persisted product quantization")
+		}
+	}
+	h.compressionLock.Unlock()
+
+	// give a previously failed training another chance with the new config
+	atomic.CompareAndSwapInt32(&h.compressionState, compressionFailed,
+		compressionIdle)
+	h.maybeCompress()
+}
+
+// maybeCompress starts the training in the background if compression is
+// enabled, but has not happened yet and the index contains enough vectors
+func (h *hnsw) maybeCompress() {
+	h.compressionLock.RLock()
+	cfg := h.pqConfig
+	trained := h.quantizer != nil
+	h.compressionLock.RUnlock()
+
+	if !cfg.Enabled || trained {
+		return
+	}
+
+	if atomic.LoadInt64(&h.vectorCount) < int64(cfg.TrainingLimit) {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&h.compressionState, compressionIdle,
+		compressionTraining) {
+		// already training or failed before
+		return
+	}
+
+	go func() {
+		before := time.Now()
+		if err := h.compress(); err != nil {
+			atomic.StoreInt32(&h.compressionState, compressionFailed)
+			h.logger.WithField("action", "hnsw_compress").
+				WithField("id", h.id).
+				WithError(err).
+				Error("product quantization failed, vectors stay uncompressed")
+			return
+		}
+
+		atomic.StoreInt32(&h.compressionState, compressionIdle)
+		h.logger.WithField("action", "hnsw_compress").
+			WithField("id", h.id).
+			WithField("took", time.Since(before)).
+			Info("compressed vectors with product quantization")
+	}()
+}
+
+// compress trains the codebooks on a sample of the existing vectors and
+// then compresses every vector of the index. Searches keep using the
+// uncompressed vectors until it is done. Vectors which are added while
+// compress is running are not compressed, their distances are always
+// calculated on the uncompressed vectors.
+//
+// Once compressed, the vector cache is bypassed: searches work on the codes
+// and the remaining reads of uncompressed vectors go to the source. The
+// codebooks and codes are persisted, so that they survive a restart.
+func (h *hnsw) compress() error {
+	h.compressionLock.RLock()
+	cfg := h.pqConfig
+	h.compressionLock.RUnlock()
+
+	if _, ok := pqMetric(h.distancerProvider); !ok {
+		return fmt.Errorf("product quantization is not supported " +
+			"for the distance of this index")
+	}
+
+	ids := h.nodeIDs()
+	sample, err := h.sampleVectors(ids, cfg.TrainingLimit)
+	if err != nil {
+		return errors.Wrap(err, "sample vectors")
+	}
+
+	if len(sample) == 0 {
+		return fmt.Errorf("no vectors to train on")
+	}
+
+	segments := cfg.Segments
+	if segments == 0 {
+		segments = defaultPQSegments(len(sample[0]))
+	}
+
+	quantizer, err := pq.NewProductQuantizer(len(sample[0]), segments,
+		cfg.Centroids)
+	if err != nil {
+		return errors.Wrap(err, "create product quantizer")
+	}
+
+	if err := quantizer.Fit(sample); err != nil {
+		return errors.Wrap(err, "train codebooks")
+	}
+
+	codes := [][]byte{}
+	for _, id := range ids {
+		vec, ok, err := h.vectorFromSourceOrSkip(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		code, err := quantizer.Encode(vec)
+		if err != nil {
+			return errors.Wrapf(err, "compress vector of docID %d", id)
+		}
+
+		codes = setCode(codes, id, code)
+	}
+
+	h.compressionLock.Lock()
+	if !h.pqConfig.Enabled {
+		// compression was disabled while we were training
+		h.compressionLock.Unlock()
+		return nil
+	}
+
+	h.quantizer = quantizer
+	h.codes = codes
+	h.cache.setBypass(true)
+	h.compressionLock.Unlock()
+
+	if err := h.persistCompression(); err != nil {
+		// the index keeps working, the codes are calculated again after a
+		// restart
+		h.logger.WithField("action", "hnsw_compress").
+			WithField("id", h.id).
+			WithError(err).
+			Error("persist product quantization")
+	}
+
+	return nil
+}
+
+// pqMetric returns the metric to calculate distances on the codes with. The
+// geo distance is not supported.
+func pqMetric(provider distancer.Provider) (pq.Metric, bool) {
+	switch provider.(type) {
+	case distancer.CosineProvider:
+		return pq.Cosine, true
+	case distancer.DotProductProvider:
+		return pq.Dot, true
+	case distancer.L2SquaredProvider:
+		return pq.L2Squared, true
+	case distancer.ManhattanProvider:
+		return pq.Manhattan, true
+	case distancer.HammingProvider:
+		return pq.Hamming, true
+	default:
+		return 0, false
+	}
+}
+
+// nodeIDs returns the ids of all nodes which are not marked as deleted
+func (h *hnsw) nodeIDs() []int {
+	h.RLock()
+	defer h.RUnlock()
+
+	ids := []int{}
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		if _, ok := h.tombstones[node.id]; ok {
+			continue
+		}
+
+		ids = append(ids, node.id)
+	}
+
+	return ids
+}
+
+// sampleVectors picks up to limit random nodes and retrieves their vectors.
+// The vectors are read from the source rather than the cache, so that
+// training does not evict the vectors the cache holds for searches.
+func (h *hnsw) sampleVectors(ids []int, limit int) ([][]float32, error) {
+	if len(ids) > limit {
+		shuffled := make([]int, len(ids))
+		copy(shuffled, ids)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		ids = shuffled[:limit]
+	}
+
+	sample := make([][]float32, 0, len(ids))
+	for _, id := range ids {
+		vec, ok, err := h.vectorFromSourceOrSkip(id)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		sample = append(sample, vec)
+	}
+
+	return sample, nil
+}
+
+func (h *hnsw) vectorFromSourceOrSkip(id int) ([]float32, bool, error) {
+	vec, err := h.vectorFromSource(context.Background(), int32(id))
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			// deleted in the meantime, it will be cleaned up eventually
+			return nil, false, nil
+		}
+
+		return nil, false, errors.Wrapf(err,
+			"could not get vector of object at docID %d", id)
+	}
+
+	return vec, true, nil
+}
+
+// compressVector stores the code of a newly added vector if the index is
+// compressed
+func (h *hnsw) compressVector(id int, vec []float32) error {
+	h.compressionLock.Lock()
+	defer h.compressionLock.Unlock()
+
+	if h.quantizer == nil {
+		return nil
+	}
+
+	code, err := h.quantizer.Encode(vec)
+	if err != nil {
+		return errors.Wrap(err, "compress vector")
+	}
+
+	h.codes = setCode(h.codes, id, code)
+	return nil
+}
+
+func (h *hnsw) dropCode(id int) {
+	h.compressionLock.Lock()
+	defer h.compressionLock.Unlock()
+
+	if id < len(h.codes) {
+		h.codes[id] = nil
+	}
+}
+
+func setCode(codes [][]byte, id int, code []byte) [][]byte {
+	if id >= len(codes) {
+		grown := make([][]byte, id+defaultIndexGrowthDelta)
+		copy(grown, codes)
+		codes = grown
+	}
+
+	codes[id] = code
+	return codes
+}
+
+// decompressedVector reconstructs the approximate vector of the node into
+// buf. It returns false if the node has no code, either because the index is
+// not compressed or because the node was added during the training. It is
+// used for the distances between two nodes while building the graph,
+// searches use the lookup of their searchDistancer instead.
+func (h *hnsw) decompressedVector(id int32, buf []float32) ([]float32, bool) {
+	h.compressionLock.RLock()
+	defer h.compressionLock.RUnlock()
+
+	if h.quantizer == nil || int(id) >= len(h.codes) || h.codes[id] == nil {
+		return nil, false
+	}
+
+	return h.quantizer.Decode(h.codes[id], buf), true
+}
+
+// rescoreLimit is only set if the index is actually compressed, rescoring
+// uncompressed results would not change anything
+func (h *hnsw) rescoreLimit() int {
+	h.compressionLock.RLock()
+	defer h.compressionLock.RUnlock()
+
+	if h.quantizer == nil {
+		return 0
+	}
+
+	return h.pqConfig.RescoreLimit
+}
+
+// rescore recalculates the distances of the candidates using the
+// uncompressed vectors and returns them in their new order. The vectors are
+// read from the source, as the cache is bypassed on a compressed index.
+func (h *hnsw) rescore(searchVec []float32,
+	candidates []*binarySearchNodeGeneric) ([]*binarySearchNodeGeneric, error) {
+	distancer := h.distancerProvider.New(searchVec)
+	rescored := &binarySearchTreeGeneric{}
+	for _, candidate := range candidates {
+		vec, err := h.vectorFromSource(context.Background(),
+			int32(candidate.index))
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				h.handleDeletedNode(e.DocID)
+				continue
+			}
+
+			return nil, errors.Wrapf(err, "rescore: get vector of docID %d",
+				candidate.index)
+		}
+
+		dist, _, err := distancer.Distance(vec)
+		if err != nil {
+			return nil, errors.Wrap(err, "rescore")
+		}
+
+		rescored.insert(candidate.index, dist)
+	}
+
+	return rescored.flattenInOrder(), nil
+}
+
+// searchDistancer calculates the distances between the query vector and the
+// nodes visited during a search. On a compressed index the distances to
+// nodes with a code are looked up in a table built from the query, so the
+// codes never have to be decoded. It must not be shared between concurrent
+// searches.
+type searchDistancer struct {
+	distancer.Distancer
+
+	// both nil if the index was not compressed when the search started
+	quantizer *pq.ProductQuantizer
+	lookup    *pq.DistanceLookup
+}
+
+func (h *hnsw) newSearchDistancer(queryVector []float32) *searchDistancer {
+	d := &searchDistancer{
+		Distancer: h.distancerProvider.New(queryVector),
+	}
+
+	metric, ok := pqMetric(h.distancerProvider)
+	if !ok {
+		return d
+	}
+
+	h.compressionLock.RLock()
+	quantizer := h.quantizer
+	h.compressionLock.RUnlock()
+
+	if quantizer == nil {
+		return d
+	}
+
+	lookup, err := quantizer.NewDistanceLookup(queryVector, metric)
+	if err != nil {
+		// e.g. a query vector of the wrong length, the uncompressed distancer
+		// reports the error on the first distance
+		return d
+	}
+
+	d.quantizer = quantizer
+	d.lookup = lookup
+	return d
+}
+
+// compressedDistance returns false if the node has no code or the codes
+// belong to a different training than the lookup of the distancer
+func (h *hnsw) compressedDistance(d *searchDistancer, id int32) (float32, bool) {
+	if d.lookup == nil {
+		return 0, false
+	}
+
+	h.compressionLock.RLock()
+	defer h.compressionLock.RUnlock()
+
+	if h.quantizer != d.quantizer || int(id) >= len(h.codes) || h.codes[id] == nil {
+		return 0, false
+	}
+
+	return d.lookup.Distance(h.codes[id]), true
+}
@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/pq"
+)
+
+// The codebooks and codes of a compressed index are persisted in a single
+// file next to the commit logs, so that a restart neither trains the
+// codebooks again nor has to read every vector. The file is written once the
+// training is done, on shutdown and on backups. The codes of vectors which
+// were added after the last write are calculated again when the index is
+// loaded.
+//
+// The file layout is:
+//
+//   version (uint8)
+//   length of the serialized quantizer (uint32), serialized quantizer
+//   number of codes (uint32), for each code:
+//     id (uint32), code (one byte per segment)
+//   crc32 checksum of everything above (uint32)
+
+const compressionVersion uint8 = 1
+
+func compressionFileName(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.pq", rootPath, name)
+}
+
+func (h *hnsw) persistCompression() error {
+	return h.writeCompression(h.rootPath)
+}
+
+// writeCompression does nothing if the index is not compressed
+func (h *hnsw) writeCompression(rootPath string) error {
+	h.compressionLock.RLock()
+	quantizer := h.quantizer
+	codes := make([][]byte, len(h.codes))
+	copy(codes, h.codes)
+	h.compressionLock.RUnlock()
+
+	if quantizer == nil {
+		return nil
+	}
+
+	serialized, err := quantizer.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "serialize product quantizer")
+	}
+
+	fileName := compressionFileName(rootPath, h.id)
+	tmpFileName := fileName + ".tmp"
+	fd, err := os.Create(tmpFileName)
+	if err != nil {
+		return errors.Wrap(err, "create product quantization file")
+	}
+
+	bufw := bufio.NewWriter(fd)
+	w := &snapshotWriter{w: bufw, hash: crc32.NewIEEE()}
+	w.writeUint8(compressionVersion)
+	w.writeUint32(uint32(len(serialized)))
+	w.writeBinary(serialized)
+
+	codeCount := 0
+	for _, code := range codes {
+		if code != nil {
+			codeCount++
+		}
+	}
+	w.writeUint32(uint32(codeCount))
+	for id, code := range codes {
+		if code == nil {
+			continue
+		}
+
+		w.writeUint32(uint32(id))
+		w.writeBinary(code)
+	}
+
+	w.writeChecksum()
+	if w.err == nil {
+		w.err = bufw.Flush()
+	}
+	if w.err == nil {
+		w.err = fd.Sync()
+	}
+	if err := fd.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+	if w.err != nil {
+		os.Remove(tmpFileName)
+		return errors.Wrap(w.err, "write product quantization file")
+	}
+
+	if err := os.Rename(tmpFileName, fileName); err != nil {
+		return errors.Wrap(err, "rename product quantization file")
+	}
+
+	return nil
+}
+
+func (h *hnsw) removeCompression() error {
+	err := os.Remove(compressionFileName(h.rootPath, h.id))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove product quantization file")
+	}
+
+	return nil
+}
+
+// restoreCompression loads the persisted codebooks and codes if compression
+// is enabled. A missing or unreadable file is not an error, the index is
+// then trained again once it contains enough vectors.
+func (h *hnsw) restoreCompression() {
+	if !h.pqConfig.Enabled {
+		return
+	}
+
+	fileName := compressionFileName(h.rootPath, h.id)
+	quantizer, codes, err := readCompression(fileName)
+	if err != nil {
+		if !os.IsNotExist(errors.Cause(err)) {
+			h.logger.WithField("action", "hnsw_load_compression").
+				WithField("id", h.id).
+				WithField("file_name", fileName).
+				WithError(err).
+				Warning("cannot read product quantization, vectors are compressed again")
+		}
+		return
+	}
+
+	h.quantizer = quantizer
+	h.codes = codes
+	h.cache.setBypass(true)
+
+	go h.compressMissing(quantizer)
+}
+
+// compressMissing encodes the vectors which were added after the codes were
+// persisted for the last time. Until then their distances are calculated on
+// the uncompressed vectors.
+func (h *hnsw) compressMissing(quantizer *pq.ProductQuantizer) {
+	for _, id := range h.nodeIDs() {
+		select {
+		case <-h.shutdown:
+			return
+		default:
+		}
+
+		h.compressionLock.RLock()
+		missing := h.quantizer == quantizer &&
+			(id >= len(h.codes) || h.codes[id] == nil)
+		h.compressionLock.RUnlock()
+		if !missing {
+			continue
+		}
+
+		vec, ok, err := h.vectorFromSourceOrSkip(id)
+		if err != nil {
+			h.logger.WithField("action", "hnsw_load_compression").
+				WithField("id", h.id).
+				WithError(err).
+				Error("compress vectors added after the codes were persisted")
+			return
+		}
+		if !ok {
+			continue
+		}
+
+		code, err := quantizer.Encode(vec)
+		if err != nil {
+			h.logger.WithField("action", "hnsw_load_compression").
+				WithField("id", h.id).
+				WithError(err).
+				Errorf("compress vector of docID %d", id)
+			return
+		}
+
+		h.compressionLock.Lock()
+		if h.quantizer == quantizer {
+			h.codes = setCode(h.codes, id, code)
+		}
+		h.compressionLock.Unlock()
+	}
+}
+
+func readCompression(fileName string) (*pq.ProductQuantizer, [][]byte, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "open product quantization file")
+	}
+	defer fd.Close()
+
+	// the checksum is verified before parsing, so that a corrupted length can
+	// never lead to an excessive allocation
+	size, err := verifySnapshotChecksum(fd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := fd.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, errors.Wrap(err, "seek to start of product quantization file")
+	}
+
+	r := &snapshotReader{r: bufio.NewReader(io.LimitReader(fd, size))}
+	if version := r.readUint8(); r.err == nil && version != compressionVersion {
+		return nil, nil, fmt.Errorf("unsupported product quantization version %d",
+			version)
+	}
+
+	length := r.readUint32()
+	if r.err == nil && int64(length) > size {
+		return nil, nil, fmt.Errorf("product quantizer of %d bytes exceeds the file",
+			length)
+	}
+	serialized := make([]byte, length)
+	r.readBinary(serialized)
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	quantizer := &pq.ProductQuantizer{}
+	if err := quantizer.UnmarshalBinary(serialized); err != nil {
+		return nil, nil, err
+	}
+
+	var codes [][]byte
+	codeCount := r.readUint32()
+	for i := uint32(0); i < codeCount && r.err == nil; i++ {
+		id := r.readUint32()
+		code := make([]byte, quantizer.Segments())
+		r.readBinary(code)
+		if r.err == nil {
+			codes = setCode(codes, int(id), code)
+		}
+	}
+
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	return quantizer, codes, nil
+}
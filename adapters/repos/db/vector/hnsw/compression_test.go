@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHnswCompression(t *testing.T) {
+	dims := 32
+	r := rand.New(rand.NewSource(7))
+	vectors := make([][]float32, 2000)
+	for i := range vectors {
+		vectors[i] = make([]float32, dims)
+		for d := range vectors[i] {
+			vectors[i][d] = r.Float32()
+		}
+	}
+	queries := vectors[:50]
+
+	vectorForID := func(ctx context.Context, id int32) ([]float32, error) {
+		return vectors[id], nil
+	}
+
+	// the codes are persisted in the root path
+	rootPath, err := ioutil.TempDir("", "hnsw-compression")
+	require.Nil(t, err)
+	defer os.RemoveAll(rootPath)
+
+	cfg := Config{
+		RootPath:              rootPath,
+		ID:                    "unittest",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		MaximumConnections:    30,
+		EFConstruction:        64,
+		VectorForIDThunk:      vectorForID,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		PQ: PQConfig{
+			Enabled:   true,
+			Segments:  8,
+			Centroids: 64,
+			// high enough to never start the training in the background, it is
+			// triggered explicitly below
+			TrainingLimit: 100000,
+		},
+	}
+	index, err := New(cfg)
+	require.Nil(t, err)
+
+	// the last vector is only added once the index is compressed
+	for i, vec := range vectors[:len(vectors)-1] {
+		require.Nil(t, index.Add(i, vec))
+	}
+
+	t.Run("not compressed before training", func(t *testing.T) {
+		assert.False(t, index.compressed())
+	})
+
+	t.Run("compressing", func(t *testing.T) {
+		require.Nil(t, index.compress())
+		assert.True(t, index.compressed())
+		assert.Len(t, index.codes[0], 8)
+	})
+
+	t.Run("vectors added after compressing are compressed", func(t *testing.T) {
+		id := len(vectors) - 1
+		require.Nil(t, index.Add(id, vectors[id]))
+		assert.Len(t, index.codes[id], 8)
+	})
+
+	t.Run("recall without rescoring", func(t *testing.T) {
+		recall := compressionTestRecall(t, index, vectors, queries, 10)
+		assert.Greater(t, recall, 0.5)
+	})
+
+	t.Run("the vector cache is bypassed", func(t *testing.T) {
+		assert.Equal(t, int32(0), atomic.LoadInt32(&index.cache.count))
+	})
+
+	t.Run("the codes are restored after a restart", func(t *testing.T) {
+		require.Nil(t, index.persistCompression())
+
+		restored, err := New(cfg)
+		require.Nil(t, err)
+		defer restored.Shutdown()
+
+		require.True(t, restored.compressed())
+		assert.Equal(t, index.quantizer, restored.quantizer)
+		assert.Equal(t, index.codes[:len(vectors)], restored.codes[:len(vectors)])
+	})
+
+	t.Run("recall with rescoring", func(t *testing.T) {
+		err := index.UpdateConfig(UpdatableConfig{PQ: PQConfig{
+			Enabled:       true,
+			Segments:      8,
+			Centroids:     64,
+			TrainingLimit: 100000,
+			RescoreLimit:  100,
+		}})
+		require.Nil(t, err)
+
+		recall := compressionTestRecall(t, index, vectors, queries, 10)
+		assert.Greater(t, recall, 0.9)
+	})
+
+	t.Run("disabling compression drops the codes", func(t *testing.T) {
+		err := index.UpdateConfig(UpdatableConfig{})
+		require.Nil(t, err)
+
+		assert.False(t, index.compressed())
+		assert.Nil(t, index.codes)
+		assert.Equal(t, 0, index.rescoreLimit())
+
+		_, err = os.Stat(compressionFileName(rootPath, "unittest"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("segments which do not divide the dimensions", func(t *testing.T) {
+		index.updatePQConfig(PQConfig{
+			Enabled:       true,
+			Segments:      5,
+			TrainingLimit: 100000,
+		})
+
+		assert.NotNil(t, index.compress())
+	})
+}
+
+func compressionTestRecall(t *testing.T, index *hnsw, vectors,
+	queries [][]float32, k int) float64 {
+	found, total := 0, 0
+	for _, query := range queries {
+		res, err := index.SearchByVector(query, k, nil)
+		require.Nil(t, err)
+
+		truth := bruteForceL2(vectors, query, k)
+		for _, id := range res {
+			if _, ok := truth[id]; ok {
+				found++
+			}
+		}
+		total += k
+	}
+
+	return float64(found) / float64(total)
+}
+
+func bruteForceL2(vectors [][]float32, query []float32, k int) map[int]struct{} {
+	type distAndID struct {
+		id   int
+		dist float32
+	}
+
+	all := make([]distAndID, len(vectors))
+	for i, vec := range vectors {
+		dist, _, _ := distancer.NewL2SquaredProvider().New(query).Distance(vec)
+		all[i] = distAndID{id: i, dist: dist}
+	}
+
+	sort.Slice(all, func(a, b int) bool { return all[a].dist < all[b].dist })
+
+	out := map[int]struct{}{}
+	for _, elem := range all[:k] {
+		out[elem.id] = struct{}{}
+	}
+	return out
+}
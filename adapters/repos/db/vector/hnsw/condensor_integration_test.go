@@ -36,10 +36,10 @@ func TestCondensor(t *testing.T) {
 	}()
 
 	logger, _ := test.NewNullLogger()
-	uncondensed, err := NewCommitLogger(rootPath, "uncondensed", 0, logger)
+	uncondensed, err := NewCommitLogger(rootPath, "uncondensed", 0, logger, nil)
 	require.Nil(t, err)
 
-	perfect, err := NewCommitLogger(rootPath, "perfect", 0, logger)
+	perfect, err := NewCommitLogger(rootPath, "perfect", 0, logger, nil)
 	require.Nil(t, err)
 
 	t.Run("add redundant data to the original log", func(t *testing.T) {
@@ -152,7 +152,7 @@ func TestCondensorWithoutEntrypoint(t *testing.T) {
 	}()
 
 	logger, _ := test.NewNullLogger()
-	uncondensed, err := NewCommitLogger(rootPath, "uncondensed", 0, logger)
+	uncondensed, err := NewCommitLogger(rootPath, "uncondensed", 0, logger, nil)
 	require.Nil(t, err)
 
 	t.Run("add data, but do not set an entrypoint", func(t *testing.T) {
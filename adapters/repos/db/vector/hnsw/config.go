@@ -17,6 +17,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/pq"
 	"github.com/sirupsen/logrus"
 )
 
@@ -30,8 +32,65 @@ type Config struct {
 	VectorForIDThunk      VectorForID
 	Logger                logrus.FieldLogger
 
+	// Optional, defaults to the cosine distance if not set. The same provider is
+	// used for inserts, searches and the reassignment of edges during tombstone
+	// cleanup, so it must never change for an existing index
+	DistanceProvider distancer.Provider
+
 	// Optional, no period clean up will be scheduled if interval is not set
 	TombstoneCleanupInterval time.Duration
+
+	// Optional, no snapshots are written if interval is not set. A snapshot
+	// is only written if a commit log was completed since the latest one
+	SnapshotInterval time.Duration
+
+	// Optional, if not set or set to a negative value, the ef used at query
+	// time is derived from the requested limit. Can be changed on a live index
+	// through UpdateConfig
+	EF int
+
+	// Optional, defaults to defaultVectorCacheMaxObjects if not set. Can be
+	// changed on a live index through UpdateConfig
+	VectorCacheMaxObjects int
+
+	// Optional, vectors are not compressed if not enabled. Whether
+	// compression is enabled and the rescore limit can be changed on a live
+	// index through UpdateConfig
+	PQ PQConfig
+
+	// Optional, nothing is recorded if not set
+	Metrics *Metrics
+}
+
+// PQConfig controls the product quantization of the vectors held in memory.
+// Once the index contains TrainingLimit vectors, the codebooks are trained
+// and graph traversals use distances on the compressed vectors. A compressed
+// index holds one byte per segment and vector instead of the vector cache,
+// uncompressed vectors are only read from the source for rescoring.
+type PQConfig struct {
+	Enabled bool
+
+	// Optional, defaults to a quarter of the dimensions, or to the dimensions
+	// if they are not divisible by four
+	Segments int
+
+	// Optional, defaults to pq.MaxCentroids
+	Centroids int
+
+	// Optional, defaults to defaultPQTrainingLimit
+	TrainingLimit int
+
+	// Optional, the best RescoreLimit candidates of a search are rescored with
+	// the uncompressed vectors. No rescoring happens if not set.
+	RescoreLimit int
+}
+
+// UpdatableConfig contains the settings which can be changed on a live index
+// without having to rebuild it. They match their counterparts in Config.
+type UpdatableConfig struct {
+	EF                    int
+	VectorCacheMaxObjects int
+	PQ                    PQConfig
 }
 
 func (c Config) Validate() error {
@@ -57,6 +116,12 @@ func (c Config) Validate() error {
 		ec.addf("makeCommitLoggerThunk cannot be nil")
 	}
 
+	if c.VectorCacheMaxObjects < 0 {
+		ec.addf("vectorCacheMaxObjects cannot be negative")
+	}
+
+	ec.add(c.PQ.validate())
+
 	if c.VectorForIDThunk == nil {
 		ec.addf("vectorForIDThunk cannot be nil")
 	}
@@ -64,6 +129,28 @@ func (c Config) Validate() error {
 	return ec.toError()
 }
 
+func (c PQConfig) validate() error {
+	ec := &errorCompounder{}
+
+	if c.Segments < 0 {
+		ec.addf("pq segments cannot be negative")
+	}
+
+	if c.Centroids < 0 || c.Centroids > pq.MaxCentroids {
+		ec.addf("pq centroids must be between 0 and %d", pq.MaxCentroids)
+	}
+
+	if c.TrainingLimit < 0 {
+		ec.addf("pq trainingLimit cannot be negative")
+	}
+
+	if c.RescoreLimit < 0 {
+		ec.addf("pq rescoreLimit cannot be negative")
+	}
+
+	return ec.toError()
+}
+
 type errorCompounder struct {
 	errors []error
 }
@@ -13,6 +13,7 @@ package hnsw
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
@@ -22,6 +23,8 @@ import (
 // Delete attaches a tombstone to an item so it can be periodically cleaned up
 // later and the edges reassigned
 func (h *hnsw) Delete(id int) error {
+	defer h.metrics.Delete(time.Now())
+
 	h.addTombstone(id)
 	return nil
 }
@@ -29,6 +32,9 @@ func (h *hnsw) Delete(id int) error {
 // CleanUpTombstonedNodes removes nodes with a tombstone and reassignes edges
 // that were previously pointing to the tombstoned nodes
 func (h *hnsw) CleanUpTombstonedNodes() error {
+	h.cleanupLock.Lock()
+	defer h.cleanupLock.Unlock()
+
 	deleteList := helpers.AllowList{}
 
 	h.RLock()
@@ -49,10 +55,6 @@ func (h *hnsw) CleanUpTombstonedNodes() error {
 		deleteList.Insert(uint32(id))
 	}
 
-	if err := h.reassignNeighborsOf(deleteList); err != nil {
-		return errors.Wrap(err, "reassign neighbor edges")
-	}
-
 	for id := range tombstones {
 		if h.entryPointID == id {
 			// this a special case because:
@@ -62,6 +64,10 @@ func (h *hnsw) CleanUpTombstonedNodes() error {
 			// 2. there is a risk that this is the only node in the entire graph. In
 			// this case we must reverse the special behavior of inserting the first
 			// node
+			//
+			// It needs to happen before the neighbors are reassigned, as those
+			// searches start at the entrypoint, whose vector might no longer be
+			// present in the object store
 			h.RLock()
 			node := h.nodes[id]
 			h.RUnlock()
@@ -71,11 +77,17 @@ func (h *hnsw) CleanUpTombstonedNodes() error {
 		}
 	}
 
+	if err := h.reassignNeighborsOf(deleteList); err != nil {
+		return errors.Wrap(err, "reassign neighbor edges")
+	}
+
 	for id := range tombstones {
 		h.Lock()
 		h.nodes[id] = nil
 		delete(h.tombstones, id)
 		h.Unlock()
+		h.metrics.RemoveTombstone()
+		h.dropCode(id)
 		h.commitLog.DeleteNode(id)
 		h.commitLog.RemoveTombstone(id)
 	}
@@ -273,6 +285,9 @@ func (h *hnsw) hasTombstone(id int) bool {
 
 func (h *hnsw) addTombstone(id int) {
 	h.Lock()
+	if _, ok := h.tombstones[id]; !ok {
+		h.metrics.AddTombstone()
+	}
 	h.tombstones[id] = struct{}{}
 	h.Unlock()
 	h.commitLog.AddTombstone(id)
@@ -14,9 +14,11 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -513,3 +515,53 @@ func TestDelete_EntrypointIssues(t *testing.T) {
 
 	// t.Fail()
 }
+
+func TestDelete_TombstonedEntrypointWithoutVector(t *testing.T) {
+	// After a restart the vector cache is empty, and the object of a deleted
+	// entrypoint is no longer present in the object store. Reassigning the
+	// neighbors of the deleted nodes must not start its searches at such an
+	// entrypoint.
+	vectors := vectorsForDeleteTest()
+	deleted := map[int32]bool{}
+	var deletedLock sync.Mutex
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "delete-entrypoint-without-vector-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		MaximumConnections:    30,
+		EFConstruction:        128,
+		VectorForIDThunk: func(ctx context.Context, id int32) ([]float32, error) {
+			deletedLock.Lock()
+			defer deletedLock.Unlock()
+			if deleted[id] {
+				return nil, storobj.NewErrNotFoundf(id, "object was deleted")
+			}
+			return vectors[int(id)], nil
+		},
+	})
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(i, vec))
+	}
+
+	entrypoint := index.entryPointID
+	deletedLock.Lock()
+	deleted[int32(entrypoint)] = true
+	deletedLock.Unlock()
+	require.Nil(t, index.Delete(entrypoint))
+
+	// empty the cache, as if the index had just been loaded from disk
+	index.cache.updateMaxSize(0)
+	index.cache.replaceMapIfFull()
+	index.cache.updateMaxSize(defaultVectorCacheMaxObjects)
+
+	require.Nil(t, index.CleanUpTombstonedNodes())
+	assert.NotEqual(t, entrypoint, index.entryPointID)
+
+	res, err := index.SearchByVector(vectors[entrypoint], len(vectors), nil)
+	require.Nil(t, err)
+	assert.Len(t, res, len(vectors)-1)
+	assert.NotContains(t, res, entrypoint)
+}
@@ -161,6 +161,12 @@ func (c *Deserializer) ReadLink(r io.Reader, res *DeserializationResult) error {
 		res.Nodes[int(source)] = &vertex{id: int(source), connections: make(map[int][]uint32)}
 	}
 
+	// the link may already be contained, e.g. if the commit log is replayed
+	// on top of a snapshot which was taken while the link was added
+	if targetContained(res.Nodes[int(source)].connections[int(level)], target) {
+		return nil
+	}
+
 	res.Nodes[int(source)].connections[int(level)] = append(res.Nodes[int(source)].connections[int(level)], target)
 	return nil
 }
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistancers(t *testing.T) {
+	type pair struct {
+		a, b     []float32
+		expected float32
+	}
+
+	tests := []struct {
+		name     string
+		provider Provider
+		// the distance of a vector to itself, it is the smallest possible
+		// distance of all metrics except for the dot product
+		identical float32
+		pairs     []pair
+	}{
+		{
+			name:      "dot product",
+			provider:  NewDotProductProvider(),
+			identical: -50,
+			pairs: []pair{
+				{[]float32{1, 2, 3}, []float32{3, 2, -1}, -4},
+				// orthogonal vectors
+				{[]float32{1, 0}, []float32{0, 1}, 0},
+				// the longer of two vectors in the same direction is closer
+				{[]float32{1, 1}, []float32{2, 2}, -4},
+			},
+		},
+		{
+			name:     "l2 squared",
+			provider: NewL2SquaredProvider(),
+			pairs: []pair{
+				{[]float32{1, 2, 3}, []float32{3, 2, -1}, 20},
+				{[]float32{0, 0}, []float32{3, 4}, 25},
+			},
+		},
+		{
+			name:     "manhattan",
+			provider: NewManhattanProvider(),
+			pairs: []pair{
+				{[]float32{1, 2, 3}, []float32{3, 2, -1}, 6},
+				{[]float32{0, 0}, []float32{3, -4}, 7},
+			},
+		},
+		{
+			name:     "hamming",
+			provider: NewHammingProvider(),
+			pairs: []pair{
+				{[]float32{1, 2, 3}, []float32{3, 2, -1}, 2},
+				// only the number of differing positions counts, not by how much
+				// they differ
+				{[]float32{0, 0, 0}, []float32{0.1, 100, 0}, 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("identical vectors", func(t *testing.T) {
+				vec := []float32{3, 4, 5}
+
+				dist, ok, err := test.provider.New(vec).Distance(vec)
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.Equal(t, test.identical, dist)
+			})
+
+			t.Run("different vectors", func(t *testing.T) {
+				for _, p := range test.pairs {
+					dist, ok, err := test.provider.New(p.a).Distance(p.b)
+					require.Nil(t, err)
+					require.True(t, ok)
+					assert.Equal(t, p.expected, dist, "%v and %v", p.a, p.b)
+
+					// all metrics are symmetric
+					reverse, _, err := test.provider.New(p.b).Distance(p.a)
+					require.Nil(t, err)
+					assert.Equal(t, dist, reverse, "%v and %v", p.b, p.a)
+				}
+			})
+
+			t.Run("vectors of different dimensions", func(t *testing.T) {
+				_, _, err := test.provider.New([]float32{1, 2}).Distance([]float32{1, 2, 3})
+				assert.NotNil(t, err)
+			})
+		})
+	}
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import "fmt"
+
+// dotProduct distance is the negative dot product, so that - as with all
+// other distances - a smaller value indicates a closer match. It is only
+// meaningful for vectors whose magnitude carries information, for normalized
+// vectors it produces the same ranking as the cosine distance.
+func dotProductDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, fmt.Errorf("vectors have different dimensions")
+	}
+
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+
+	return -sum, true, nil
+}
+
+type DotProduct struct {
+	a []float32
+}
+
+func (d DotProduct) Distance(b []float32) (float32, bool, error) {
+	return dotProductDist(d.a, b)
+}
+
+type DotProductProvider struct{}
+
+func (p DotProductProvider) New(vec []float32) Distancer {
+	return DotProduct{a: vec}
+}
+
+func NewDotProductProvider() Provider {
+	return DotProductProvider{}
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import "fmt"
+
+// hammingDist counts the dimensions in which the two vectors differ. It is
+// intended for binary or otherwise discrete embeddings.
+func hammingDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, fmt.Errorf("vectors have different dimensions")
+	}
+
+	var sum float32
+	for i := range a {
+		if a[i] != b[i] {
+			sum++
+		}
+	}
+
+	return sum, true, nil
+}
+
+type Hamming struct {
+	a []float32
+}
+
+func (h Hamming) Distance(b []float32) (float32, bool, error) {
+	return hammingDist(h.a, b)
+}
+
+type HammingProvider struct{}
+
+func (p HammingProvider) New(vec []float32) Distancer {
+	return Hamming{a: vec}
+}
+
+func NewHammingProvider() Provider {
+	return HammingProvider{}
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import "fmt"
+
+// l2SquaredDist is the squared euclidean distance. The square root is
+// omitted, as it is expensive and does not alter the order of the results.
+func l2SquaredDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, fmt.Errorf("vectors have different dimensions")
+	}
+
+	var sum float32
+	for i := range a {
+		diff := a[i] - b[i]
+		sum += diff * diff
+	}
+
+	return sum, true, nil
+}
+
+type L2Squared struct {
+	a []float32
+}
+
+func (l L2Squared) Distance(b []float32) (float32, bool, error) {
+	return l2SquaredDist(l.a, b)
+}
+
+type L2SquaredProvider struct{}
+
+func (p L2SquaredProvider) New(vec []float32) Distancer {
+	return L2Squared{a: vec}
+}
+
+func NewL2SquaredProvider() Provider {
+	return L2SquaredProvider{}
+}
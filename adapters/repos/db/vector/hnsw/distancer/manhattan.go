@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"fmt"
+	"math"
+)
+
+func manhattanDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, fmt.Errorf("vectors have different dimensions")
+	}
+
+	var sum float64
+	for i := range a {
+		sum += math.Abs(float64(a[i] - b[i]))
+	}
+
+	return float32(sum), true, nil
+}
+
+type Manhattan struct {
+	a []float32
+}
+
+func (m Manhattan) Distance(b []float32) (float32, bool, error) {
+	return manhattanDist(m.a, b)
+}
+
+type ManhattanProvider struct{}
+
+func (p ManhattanProvider) New(vec []float32) Distancer {
+	return Manhattan{a: vec}
+}
+
+func NewManhattanProvider() Provider {
+	return ManhattanProvider{}
+}
@@ -17,14 +17,15 @@ import (
 	"io/ioutil"
 	"math"
 	"math/rand"
-	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/pq"
 	"github.com/sirupsen/logrus"
 )
 
@@ -51,6 +52,11 @@ type hnsw struct {
 	// ef parameter used in construction phases, should be higher than ef during querying
 	efConstruction int
 
+	// ef parameter used during querying, a negative value means the ef is
+	// derived from the limit of each query. Can be changed on a live index, so
+	// it must only be accessed atomically
+	ef int64
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -65,6 +71,10 @@ type hnsw struct {
 	// This process should happen periodically.
 	tombstones map[int]struct{}
 
+	// held for the duration of a tombstone cleanup, so that a backup does not
+	// see a partially reassigned graph
+	cleanupLock sync.Mutex
+
 	// // for distributed spike, can be used to call a insertExternal on a different graph
 	// insertHook func(node, targetLevel int, neighborsAtLevel map[int][]uint32)
 
@@ -73,6 +83,30 @@ type hnsw struct {
 
 	logger            logrus.FieldLogger
 	distancerProvider distancer.Provider
+	cache             *vectorCache
+
+	// vectorFromSource bypasses the cache, it is used to read all vectors
+	// when training the product quantization
+	vectorFromSource VectorForID
+
+	metrics *Metrics // nil if monitoring is turned off
+
+	// closed on Shutdown to stop the background routines
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+
+	// number of vectors added to the index, deletes are not taken into
+	// account. Used to decide when to train the product quantization, must
+	// only be accessed atomically
+	vectorCount int64
+
+	// everything related to compressing the vectors with product quantization
+	// is guarded by the compressionLock, see compression.go
+	compressionLock  sync.RWMutex
+	pqConfig         PQConfig
+	quantizer        *pq.ProductQuantizer // nil as long as not compressed
+	codes            [][]byte             // codes of the vectors by docID
+	compressionState int32                // access atomically
 }
 
 type CommitLogger interface {
@@ -85,6 +119,8 @@ type CommitLogger interface {
 	DeleteNode(nodeid int) error
 	ClearLinks(nodeid int) error
 	Reset() error
+	SwitchCommitLogs() (int64, error)
+	Shutdown() error
 }
 
 type MakeCommitLogger func() (CommitLogger, error)
@@ -108,7 +144,20 @@ func New(cfg Config) (*hnsw, error) {
 		cfg.Logger = logger
 	}
 
-	vectorCache := newCache(cfg.VectorForIDThunk, cfg.Logger)
+	if cfg.DistanceProvider == nil {
+		cfg.DistanceProvider = distancer.NewCosineProvider()
+	}
+
+	if cfg.EF == 0 {
+		cfg.EF = -1
+	}
+
+	if cfg.VectorCacheMaxObjects == 0 {
+		cfg.VectorCacheMaxObjects = defaultVectorCacheMaxObjects
+	}
+
+	vectorCache := newCache(cfg.VectorForIDThunk, cfg.VectorCacheMaxObjects,
+		cfg.Logger, cfg.Metrics)
 	index := &hnsw{
 		maximumConnections: cfg.MaximumConnections,
 
@@ -118,19 +167,28 @@ func New(cfg Config) (*hnsw, error) {
 		// inspired by c++ implementation
 		levelNormalizer:   1 / math.Log(float64(cfg.MaximumConnections)),
 		efConstruction:    cfg.EFConstruction,
+		ef:                int64(cfg.EF),
+		cache:             vectorCache,
 		nodes:             make([]*vertex, initialSize),
 		vectorForID:       vectorCache.get,
 		id:                cfg.ID,
 		rootPath:          cfg.RootPath,
 		tombstones:        map[int]struct{}{},
 		logger:            cfg.Logger,
-		distancerProvider: distancer.NewCosineProvider(),
+		distancerProvider: cfg.DistanceProvider,
+		vectorFromSource:  cfg.VectorForIDThunk,
+		pqConfig:          pqConfigWithDefaults(cfg.PQ),
+		shutdown:          make(chan struct{}),
+		metrics:           cfg.Metrics,
 	}
 
 	if err := index.restoreFromDisk(); err != nil {
 		return nil, errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
 	}
 
+	index.restoreCompression()
+	index.vectorCount = int64(len(index.nodeIDs()))
+
 	// init commit logger for future writes
 	cl, err := cfg.MakeCommitLoggerThunk()
 	if err != nil {
@@ -139,6 +197,7 @@ func New(cfg Config) (*hnsw, error) {
 
 	index.commitLog = cl
 	index.registerMaintainence(cfg)
+	index.maybeCompress()
 
 	return index, nil
 }
@@ -156,29 +215,51 @@ func (h *hnsw) restoreFromDisk() error {
 		return nil
 	}
 
-	var state *DeserializationResult
-	for _, fileName := range fileNames {
-		fd, err := os.Open(fileName)
-		if err != nil {
-			return errors.Wrapf(err, "open commit log %q for reading", fileName)
-		}
+	// only the commit logs written after the latest snapshot need to be
+	// replayed, without a (valid) snapshot all of them are replayed
+	state, snapshotTimeStamp, ok := loadLatestSnapshot(h.rootPath, h.id, h.logger)
+	if !ok {
+		snapshotTimeStamp = -1
+	}
 
-		state, err = NewDeserializer(h.logger).Do(fd, state)
-		if err != nil {
-			return errors.Wrapf(err, "deserialize commit log %q", fileName)
-		}
+	state, err = replayCommitLogs(fileNames, state, snapshotTimeStamp, h.logger)
+	if err != nil {
+		return err
 	}
 
 	h.nodes = state.Nodes
 	h.currentMaximumLayer = int(state.Level)
 	h.entryPointID = int(state.Entrypoint)
 	h.tombstones = state.Tombstones
+	h.metrics.SetTombstones(len(h.tombstones))
 
 	return nil
 }
 
 func (h *hnsw) registerMaintainence(cfg Config) {
 	h.registerTombstoneCleanup(cfg)
+	h.registerSnapshots(cfg)
+}
+
+func (h *hnsw) registerSnapshots(cfg Config) {
+	if cfg.SnapshotInterval == 0 {
+		return
+	}
+
+	go func() {
+		for {
+			select {
+			case <-time.After(cfg.SnapshotInterval):
+			case <-h.shutdown:
+				return
+			}
+
+			if err := h.snapshot(); err != nil {
+				h.logger.WithField("action", "hnsw_snapshot").
+					WithError(err).Error("hnsw snapshot failed")
+			}
+		}
+	}()
 }
 
 func (h *hnsw) registerTombstoneCleanup(cfg Config) {
@@ -191,7 +272,12 @@ func (h *hnsw) registerTombstoneCleanup(cfg Config) {
 
 	go func() {
 		for {
-			time.Sleep(cfg.TombstoneCleanupInterval)
+			select {
+			case <-time.After(cfg.TombstoneCleanupInterval):
+			case <-h.shutdown:
+				return
+			}
+
 			err := h.CleanUpTombstonedNodes()
 			if err != nil {
 				h.logger.WithField("action", "hnsw_tombstone_cleanup").
@@ -300,7 +386,35 @@ func (h *hnsw) registerTombstoneCleanup(cfg Config) {
 
 // }
 
+// UpdateConfig applies the settings which can be changed on a live index.
+// Unset (zero) values are replaced with their defaults, matching the
+// behavior of New
+func (h *hnsw) UpdateConfig(cfg UpdatableConfig) error {
+	if cfg.VectorCacheMaxObjects < 0 {
+		return errors.Errorf("vectorCacheMaxObjects cannot be negative")
+	}
+
+	if err := cfg.PQ.validate(); err != nil {
+		return err
+	}
+
+	if cfg.EF == 0 {
+		cfg.EF = -1
+	}
+
+	if cfg.VectorCacheMaxObjects == 0 {
+		cfg.VectorCacheMaxObjects = defaultVectorCacheMaxObjects
+	}
+
+	atomic.StoreInt64(&h.ef, int64(cfg.EF))
+	h.cache.updateMaxSize(cfg.VectorCacheMaxObjects)
+	h.updatePQConfig(cfg.PQ)
+	return nil
+}
+
 func (h *hnsw) Add(id int, vector []float32) error {
+	defer h.metrics.Insert(time.Now())
+
 	if len(vector) == 0 {
 		return fmt.Errorf("insert called with nil-vector")
 	}
@@ -309,7 +423,17 @@ func (h *hnsw) Add(id int, vector []float32) error {
 		id: id,
 	}
 
-	return h.insert(node, vector)
+	if err := h.compressVector(id, vector); err != nil {
+		return errors.Wrapf(err, "insert docID %d", id)
+	}
+
+	if err := h.insert(node, vector); err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&h.vectorCount, 1)
+	h.maybeCompress()
+	return nil
 }
 
 func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
@@ -564,6 +688,14 @@ func min(a, b int) int {
 }
 
 func (h *hnsw) distBetweenNodes(a, b int) (float32, bool, error) {
+	// on a compressed index the graph is built on the codes, so that
+	// inserting does not have to read uncompressed vectors from the source
+	if vecA, ok := h.decompressedVector(int32(a), nil); ok {
+		if vecB, ok := h.decompressedVector(int32(b), nil); ok {
+			return h.distancerProvider.New(vecA).Distance(vecB)
+		}
+	}
+
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
 	vecA, err := h.vectorForID(context.Background(), int32(a))
@@ -608,6 +740,12 @@ func (h *hnsw) distBetweenNodes(a, b int) (float32, bool, error) {
 }
 
 func (h *hnsw) distBetweenNodeAndVec(node int, vecB []float32) (float32, bool, error) {
+	// see distBetweenNodes, this matches the distances of a search on the
+	// codes
+	if vecA, ok := h.decompressedVector(int32(node), nil); ok {
+		return h.distancerProvider.New(vecA).Distance(vecB)
+	}
+
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
 	vecA, err := h.vectorForID(context.Background(), int32(node))
@@ -640,33 +778,6 @@ func (h *hnsw) distBetweenNodeAndVec(node int, vecB []float32) (float32, bool, e
 	return h.distancerProvider.New(vecA).Distance(vecB)
 }
 
-func (h *hnsw) Stats() {
-	fmt.Printf("levels: %d\n", h.currentMaximumLayer)
-
-	perLevelCount := map[int]uint{}
-
-	for _, node := range h.nodes {
-		if node == nil {
-			continue
-		}
-		l := node.level
-		if l == 0 && len(node.connections) == 0 {
-			// filter out allocated space without nodes
-			continue
-		}
-		c, ok := perLevelCount[l]
-		if !ok {
-			perLevelCount[l] = 0
-		}
-
-		perLevelCount[l] = c + 1
-	}
-
-	for level, count := range perLevelCount {
-		fmt.Printf("unique count on level %d: %d\n", level, count)
-	}
-}
-
 func (h *hnsw) isEmpty() bool {
 	h.RLock()
 	defer h.RUnlock()
@@ -82,3 +82,48 @@ func TestHnswIndex(t *testing.T) {
 		}, res)
 	})
 }
+
+func TestHnswIndexUpdateConfig(t *testing.T) {
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "unittest",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		MaximumConnections:    30,
+		EFConstruction:        60,
+		VectorForIDThunk:      testVectorForID,
+	})
+	require.Nil(t, err)
+
+	t.Run("without a user-specified ef", func(t *testing.T) {
+		assert.Equal(t, reasonableEfFromK(3), index.searchEF(3))
+		assert.Equal(t, defaultVectorCacheMaxObjects, int(index.cache.maxSize))
+	})
+
+	t.Run("updating ef and cache size", func(t *testing.T) {
+		err := index.UpdateConfig(UpdatableConfig{
+			EF:                    250,
+			VectorCacheMaxObjects: 1000,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, 250, index.searchEF(3))
+		assert.Equal(t, 1000, int(index.cache.maxSize))
+	})
+
+	t.Run("ef is never smaller than k", func(t *testing.T) {
+		assert.Equal(t, 300, index.searchEF(300))
+	})
+
+	t.Run("resetting to the defaults", func(t *testing.T) {
+		err := index.UpdateConfig(UpdatableConfig{})
+		require.Nil(t, err)
+
+		assert.Equal(t, reasonableEfFromK(3), index.searchEF(3))
+		assert.Equal(t, defaultVectorCacheMaxObjects, int(index.cache.maxSize))
+	})
+
+	t.Run("with an invalid cache size", func(t *testing.T) {
+		err := index.UpdateConfig(UpdatableConfig{VectorCacheMaxObjects: -1})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// Metrics records the activity of a single index. A nil *Metrics is valid
+// and records nothing, so that an index can be used without monitoring.
+type Metrics struct {
+	insert        prometheus.Observer
+	delete        prometheus.Observer
+	search        prometheus.Observer
+	tombstones    prometheus.Gauge
+	cacheHits     prometheus.Counter
+	cacheMisses   prometheus.Counter
+	commitLogSize prometheus.Gauge
+}
+
+// NewMetrics labels all collectors with the class and shard the index
+// belongs to. It returns nil if monitoring is turned off.
+func NewMetrics(prom *monitoring.PrometheusMetrics,
+	className, shardName string) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		insert: prom.VectorIndexDuration.
+			WithLabelValues("insert", className, shardName),
+		delete: prom.VectorIndexDuration.
+			WithLabelValues("delete", className, shardName),
+		search: prom.VectorIndexDuration.
+			WithLabelValues("search", className, shardName),
+		tombstones:    prom.VectorIndexTombstones.WithLabelValues(className, shardName),
+		cacheHits:     prom.VectorCacheHits.WithLabelValues(className, shardName),
+		cacheMisses:   prom.VectorCacheMisses.WithLabelValues(className, shardName),
+		commitLogSize: prom.CommitLogSize.WithLabelValues(className, shardName),
+	}
+}
+
+func (m *Metrics) Insert(before time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.insert.Observe(time.Since(before).Seconds())
+}
+
+func (m *Metrics) Delete(before time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.delete.Observe(time.Since(before).Seconds())
+}
+
+func (m *Metrics) Search(before time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.search.Observe(time.Since(before).Seconds())
+}
+
+func (m *Metrics) AddTombstone() {
+	if m == nil {
+		return
+	}
+
+	m.tombstones.Inc()
+}
+
+func (m *Metrics) RemoveTombstone() {
+	if m == nil {
+		return
+	}
+
+	m.tombstones.Dec()
+}
+
+func (m *Metrics) SetTombstones(count int) {
+	if m == nil {
+		return
+	}
+
+	m.tombstones.Set(float64(count))
+}
+
+func (m *Metrics) CacheHit() {
+	if m == nil {
+		return
+	}
+
+	m.cacheHits.Inc()
+}
+
+func (m *Metrics) CacheMiss() {
+	if m == nil {
+		return
+	}
+
+	m.cacheMisses.Inc()
+}
+
+func (m *Metrics) AddCommitLogSize(bytes int) {
+	if m == nil {
+		return
+	}
+
+	m.commitLogSize.Add(float64(bytes))
+}
+
+func (m *Metrics) SetCommitLogSize(bytes int64) {
+	if m == nil {
+		return
+	}
+
+	m.commitLogSize.Set(float64(bytes))
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	vectors := vectorsForDeleteTest()
+	prom := monitoring.NewPrometheusMetrics()
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "metrics-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		MaximumConnections:    30,
+		EFConstruction:        128,
+		VectorForIDThunk: func(ctx context.Context, id int32) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		Metrics: NewMetrics(prom, "MetricsClass", "single"),
+	})
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(i, vec))
+	}
+
+	_, err = index.SearchByVector([]float32{0.1, 0.1, 0.1}, 5, nil)
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, index.Delete(i))
+	}
+	// deleting the same id twice must not count another tombstone
+	require.Nil(t, index.Delete(0))
+
+	t.Run("durations are recorded by operation", func(t *testing.T) {
+		assert.Equal(t, 3, testutil.CollectAndCount(prom.VectorIndexDuration))
+	})
+
+	t.Run("tombstones are counted", func(t *testing.T) {
+		assert.Equal(t, float64(3), testutil.ToFloat64(
+			prom.VectorIndexTombstones.WithLabelValues("MetricsClass", "single")))
+	})
+
+	t.Run("tombstones are no longer counted after cleanup", func(t *testing.T) {
+		require.Nil(t, index.CleanUpTombstonedNodes())
+		assert.Equal(t, float64(0), testutil.ToFloat64(
+			prom.VectorIndexTombstones.WithLabelValues("MetricsClass", "single")))
+	})
+
+	t.Run("the vector cache is used", func(t *testing.T) {
+		hits := testutil.ToFloat64(
+			prom.VectorCacheHits.WithLabelValues("MetricsClass", "single"))
+		misses := testutil.ToFloat64(
+			prom.VectorCacheMisses.WithLabelValues("MetricsClass", "single"))
+		assert.True(t, hits > 0)
+		assert.True(t, misses > 0)
+	})
+}
+
+func TestMetrics_Disabled(t *testing.T) {
+	m := NewMetrics(nil, "MetricsClass", "single")
+	assert.Nil(t, m)
+
+	// all methods must be safe to call on a nil *Metrics
+	m.AddTombstone()
+	m.RemoveTombstone()
+	m.CacheHit()
+	m.CacheMiss()
+	m.AddCommitLogSize(10)
+}
@@ -36,7 +36,7 @@ func TestHnswPersistence(t *testing.T) {
 	}()
 
 	logger, _ := test.NewNullLogger()
-	cl, clErr := NewCommitLogger(dirName, indexID, 0, logger)
+	cl, clErr := NewCommitLogger(dirName, indexID, 0, logger, nil)
 	makeCL := func() (CommitLogger, error) {
 		return cl, clErr
 	}
@@ -104,7 +104,7 @@ func TestHnswPersistence_WithDeletion_WithoutTombstoneCleanup(t *testing.T) {
 	}()
 
 	logger, _ := test.NewNullLogger()
-	cl, clErr := NewCommitLogger(dirName, indexID, 0, logger)
+	cl, clErr := NewCommitLogger(dirName, indexID, 0, logger, nil)
 	makeCL := func() (CommitLogger, error) {
 		return cl, clErr
 	}
@@ -183,7 +183,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 
 	logger, _ := test.NewNullLogger()
 	makeCL := func() (CommitLogger, error) {
-		return NewCommitLogger(dirName, indexID, 0, logger)
+		return NewCommitLogger(dirName, indexID, 0, logger, nil)
 	}
 	index, err := New(Config{
 		RootPath:              dirName,
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package pq
+
+import (
+	"math"
+	"math/rand"
+)
+
+// maxKMeansIterations is deliberately low. Training runs on large samples
+// and the assignments hardly change after the first few iterations.
+const maxKMeansIterations = 10
+
+// kMeans clusters the points into k clusters using the squared euclidean
+// distance and returns the centroids back to back in a single slice. There
+// must be at least k points.
+func kMeans(points [][]float32, k, dims int, rng *rand.Rand) []float32 {
+	centroids := initCentroids(points, k, dims, rng)
+
+	assignments := make([]int, len(points))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+
+	sums := make([]float64, k*dims)
+	counts := make([]int, k)
+	for iteration := 0; iteration < maxKMeansIterations; iteration++ {
+		changed := false
+		for i, point := range points {
+			nearest := nearestCentroid(centroids, point, dims)
+			if nearest != assignments[i] {
+				assignments[i] = nearest
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+
+		for i := range sums {
+			sums[i] = 0
+		}
+		for i := range counts {
+			counts[i] = 0
+		}
+
+		for i, point := range points {
+			c := assignments[i]
+			counts[c]++
+			for d, v := range point {
+				sums[c*dims+d] += float64(v)
+			}
+		}
+
+		for c := 0; c < k; c++ {
+			if counts[c] == 0 {
+				// an empty cluster is of no use, restart it at a random point
+				copy(centroids[c*dims:(c+1)*dims], points[rng.Intn(len(points))])
+				continue
+			}
+
+			for d := 0; d < dims; d++ {
+				centroids[c*dims+d] = float32(sums[c*dims+d] / float64(counts[c]))
+			}
+		}
+	}
+
+	return centroids
+}
+
+// initCentroids picks the initial centroids with the k-means++ strategy.
+// Each point is picked with a probability proportional to its squared
+// distance to the closest centroid picked so far, which spreads the
+// centroids out and never picks a duplicate of an existing centroid unless
+// there are fewer distinct points than centroids.
+func initCentroids(points [][]float32, k, dims int,
+	rng *rand.Rand) []float32 {
+	centroids := make([]float32, k*dims)
+	copy(centroids, points[rng.Intn(len(points))])
+
+	minDists := make([]float64, len(points))
+	for i := range minDists {
+		minDists[i] = math.MaxFloat64
+	}
+
+	for c := 1; c < k; c++ {
+		previous := centroids[(c-1)*dims : c*dims]
+		var sum float64
+		for i, point := range points {
+			if dist := float64(squaredDist(previous, point)); dist < minDists[i] {
+				minDists[i] = dist
+			}
+			sum += minDists[i]
+		}
+
+		pick := rng.Intn(len(points))
+		if sum > 0 {
+			target := rng.Float64() * sum
+			for i, dist := range minDists {
+				target -= dist
+				if target <= 0 {
+					pick = i
+					break
+				}
+			}
+		}
+
+		copy(centroids[c*dims:], points[pick])
+	}
+
+	return centroids
+}
+
+func squaredDist(a, b []float32) float32 {
+	var dist float32
+	for d, v := range a {
+		diff := v - b[d]
+		dist += diff * diff
+	}
+
+	return dist
+}
+
+func nearestCentroid(centroids []float32, point []float32, dims int) int {
+	nearest := 0
+	nearestDist := float32(math.MaxFloat32)
+	for c := 0; c*dims < len(centroids); c++ {
+		dist := squaredDist(point, centroids[c*dims:(c+1)*dims])
+		if dist < nearestDist {
+			nearest = c
+			nearestDist = dist
+		}
+	}
+
+	return nearest
+}
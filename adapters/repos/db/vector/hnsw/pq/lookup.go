@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package pq
+
+import (
+	"fmt"
+	"math"
+)
+
+// Metric is the distance a DistanceLookup calculates. The values match the
+// distances of the hnsw distancer package.
+type Metric int
+
+const (
+	Cosine Metric = iota
+	Dot
+	L2Squared
+	Manhattan
+	Hamming
+)
+
+// DistanceLookup calculates the distances between a single query vector and
+// encoded vectors without decoding them (asymmetric distance computation).
+// The distance between the query and every centroid is calculated once per
+// segment, the distance to an encoded vector is then the sum of one table
+// entry per segment. It must not be shared between concurrent searches.
+type DistanceLookup struct {
+	metric    Metric
+	centroids int
+
+	// table holds the partial distances of all centroids of a segment back to
+	// back. For cosine it holds the partial dot products.
+	table []float32
+
+	// only set for cosine, see ProductQuantizer.norms
+	norms     []float32
+	queryNorm float64
+}
+
+// NewDistanceLookup calculates the lookup table for the query. The distances
+// are exactly the distances between the query and the decoded vectors.
+func (pq *ProductQuantizer) NewDistanceLookup(query []float32,
+	metric Metric) (*DistanceLookup, error) {
+	if pq.codebooks == nil {
+		return nil, fmt.Errorf("product quantizer has not been trained")
+	}
+
+	if len(query) != pq.dimensions {
+		return nil, fmt.Errorf("vector has %d dimensions, expected %d",
+			len(query), pq.dimensions)
+	}
+
+	partial, err := partialDistance(metric)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &DistanceLookup{
+		metric:    metric,
+		centroids: pq.centroids,
+		table:     make([]float32, pq.segments*pq.centroids),
+	}
+
+	for segment := 0; segment < pq.segments; segment++ {
+		querySegment := pq.segmentOf(query, segment)
+		codebook := pq.codebooks[segment]
+		for centroid := 0; centroid < pq.centroids; centroid++ {
+			start := centroid * pq.segmentLen
+			l.table[segment*pq.centroids+centroid] = partial(querySegment,
+				codebook[start:start+pq.segmentLen])
+		}
+	}
+
+	if metric == Cosine {
+		var sum float64
+		for _, v := range query {
+			sum += float64(v) * float64(v)
+		}
+		l.queryNorm = math.Sqrt(sum)
+		l.norms = pq.norms
+	}
+
+	return l, nil
+}
+
+// Distance of the query to the encoded vector
+func (l *DistanceLookup) Distance(code []byte) float32 {
+	var sum float32
+	for segment, centroid := range code {
+		sum += l.table[segment*l.centroids+int(centroid)]
+	}
+
+	switch l.metric {
+	case Dot:
+		return -sum
+	case Cosine:
+		var norm float32
+		for segment, centroid := range code {
+			norm += l.norms[segment*l.centroids+int(centroid)]
+		}
+		return 1 - float32(float64(sum)/(math.Sqrt(float64(norm))*l.queryNorm))
+	default:
+		return sum
+	}
+}
+
+// partialDistance returns the share of a single segment of the distance
+// before it is transformed by DistanceLookup.Distance
+func partialDistance(metric Metric) (func(a, b []float32) float32, error) {
+	switch metric {
+	case Cosine, Dot:
+		return dotProduct, nil
+	case L2Squared:
+		return squaredDist, nil
+	case Manhattan:
+		return manhattan, nil
+	case Hamming:
+		return hamming, nil
+	default:
+		return nil, fmt.Errorf("unsupported metric %d", metric)
+	}
+}
+
+func dotProduct(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+func manhattan(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += float32(math.Abs(float64(a[i] - b[i])))
+	}
+	return sum
+}
+
+func hamming(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		if a[i] != b[i] {
+			sum++
+		}
+	}
+	return sum
+}
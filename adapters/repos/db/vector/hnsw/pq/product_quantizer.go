@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package pq compresses vectors with product quantization. A vector is split
+// into equally sized segments and every segment is replaced with the id of
+// the closest centroid in that segment's codebook. With at most 256 centroids
+// per segment, each segment is stored as a single byte.
+package pq
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// MaxCentroids is the highest number of centroids per segment, so that the
+// id of a centroid always fits into a single byte
+const MaxCentroids = 256
+
+type ProductQuantizer struct {
+	dimensions int
+	segments   int
+	centroids  int
+	segmentLen int
+
+	// codebooks contains the centroids of each segment, the centroids of a
+	// single segment are stored back to back
+	codebooks [][]float32
+
+	// norms contains the squared norm of every centroid of every segment, so
+	// that the norm of an encoded vector can be looked up for the cosine
+	// distance. The centroids of a segment follow each other.
+	norms []float32
+}
+
+// NewProductQuantizer creates an untrained quantizer, it has to be trained
+// with Fit before any vector can be encoded
+func NewProductQuantizer(dimensions, segments,
+	centroids int) (*ProductQuantizer, error) {
+	if dimensions <= 0 {
+		return nil, fmt.Errorf("dimensions must be greater than 0")
+	}
+
+	if segments <= 0 {
+		return nil, fmt.Errorf("segments must be greater than 0")
+	}
+
+	if dimensions%segments != 0 {
+		return nil, fmt.Errorf("segments (%d) must divide the dimensions (%d)",
+			segments, dimensions)
+	}
+
+	if centroids <= 0 || centroids > MaxCentroids {
+		return nil, fmt.Errorf("centroids must be between 1 and %d, got %d",
+			MaxCentroids, centroids)
+	}
+
+	return &ProductQuantizer{
+		dimensions: dimensions,
+		segments:   segments,
+		centroids:  centroids,
+		segmentLen: dimensions / segments,
+	}, nil
+}
+
+func (pq *ProductQuantizer) Dimensions() int {
+	return pq.dimensions
+}
+
+func (pq *ProductQuantizer) Segments() int {
+	return pq.segments
+}
+
+// Fit trains the codebook of every segment on the provided sample of
+// vectors. The sample must contain at least as many vectors as there are
+// centroids per segment.
+func (pq *ProductQuantizer) Fit(data [][]float32) error {
+	if len(data) < pq.centroids {
+		return fmt.Errorf("need at least %d vectors to train %d centroids, got %d",
+			pq.centroids, pq.centroids, len(data))
+	}
+
+	for i, vec := range data {
+		if len(vec) != pq.dimensions {
+			return fmt.Errorf("vector %d has %d dimensions, expected %d",
+				i, len(vec), pq.dimensions)
+		}
+	}
+
+	codebooks := make([][]float32, pq.segments)
+	segments := make(chan int, pq.segments)
+	for segment := 0; segment < pq.segments; segment++ {
+		segments <- segment
+	}
+	close(segments)
+
+	// the segments are independent of each other, so they can be trained in
+	// parallel
+	seed := time.Now().UnixNano()
+	wg := &sync.WaitGroup{}
+	for worker := 0; worker < runtime.GOMAXPROCS(0); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for segment := range segments {
+				points := make([][]float32, len(data))
+				for i, vec := range data {
+					points[i] = pq.segmentOf(vec, segment)
+				}
+
+				rng := rand.New(rand.NewSource(seed + int64(segment)))
+				codebooks[segment] = kMeans(points, pq.centroids, pq.segmentLen, rng)
+			}
+		}()
+	}
+	wg.Wait()
+
+	pq.setCodebooks(codebooks)
+	return nil
+}
+
+func (pq *ProductQuantizer) setCodebooks(codebooks [][]float32) {
+	norms := make([]float32, pq.segments*pq.centroids)
+	for segment, codebook := range codebooks {
+		for centroid := 0; centroid < pq.centroids; centroid++ {
+			c := codebook[centroid*pq.segmentLen : (centroid+1)*pq.segmentLen]
+			norms[segment*pq.centroids+centroid] = dotProduct(c, c)
+		}
+	}
+
+	pq.codebooks = codebooks
+	pq.norms = norms
+}
+
+// Encode compresses the vector into one byte per segment
+func (pq *ProductQuantizer) Encode(vec []float32) ([]byte, error) {
+	if pq.codebooks == nil {
+		return nil, fmt.Errorf("product quantizer has not been trained")
+	}
+
+	if len(vec) != pq.dimensions {
+		return nil, fmt.Errorf("vector has %d dimensions, expected %d",
+			len(vec), pq.dimensions)
+	}
+
+	code := make([]byte, pq.segments)
+	for segment := range code {
+		code[segment] = byte(nearestCentroid(pq.codebooks[segment],
+			pq.segmentOf(vec, segment), pq.segmentLen))
+	}
+
+	return code, nil
+}
+
+// Decode reconstructs the approximate vector of the code. The result is
+// written into out if it is large enough to hold it, so that callers can
+// reuse a buffer across many calls.
+func (pq *ProductQuantizer) Decode(code []byte, out []float32) []float32 {
+	if cap(out) < pq.dimensions {
+		out = make([]float32, pq.dimensions)
+	}
+	out = out[:pq.dimensions]
+
+	for segment, centroid := range code {
+		start := int(centroid) * pq.segmentLen
+		copy(out[segment*pq.segmentLen:],
+			pq.codebooks[segment][start:start+pq.segmentLen])
+	}
+
+	return out
+}
+
+func (pq *ProductQuantizer) segmentOf(vec []float32, segment int) []float32 {
+	return vec[segment*pq.segmentLen : (segment+1)*pq.segmentLen]
+}
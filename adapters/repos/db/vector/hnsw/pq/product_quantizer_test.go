@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package pq
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQuantizer(t *testing.T) {
+	t.Run("with invalid settings", func(t *testing.T) {
+		_, err := NewProductQuantizer(0, 1, 16)
+		assert.NotNil(t, err)
+
+		_, err = NewProductQuantizer(10, 3, 16)
+		assert.NotNil(t, err, "segments must divide the dimensions")
+
+		_, err = NewProductQuantizer(10, 5, 257)
+		assert.NotNil(t, err, "codes must fit into a byte")
+	})
+
+	t.Run("encoding before training", func(t *testing.T) {
+		pq, err := NewProductQuantizer(4, 2, 16)
+		require.Nil(t, err)
+
+		_, err = pq.Encode([]float32{1, 2, 3, 4})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("training on too few vectors", func(t *testing.T) {
+		pq, err := NewProductQuantizer(4, 2, 16)
+		require.Nil(t, err)
+
+		err = pq.Fit([][]float32{{1, 2, 3, 4}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("vectors made of as many distinct segments as centroids", func(t *testing.T) {
+		// every segment of every vector is one of 4 distinct values, so 4
+		// centroids per segment are enough to reconstruct the vectors exactly
+		r := rand.New(rand.NewSource(3))
+		values := [][]float32{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+		data := make([][]float32, 500)
+		for i := range data {
+			for segment := 0; segment < 3; segment++ {
+				data[i] = append(data[i], values[r.Intn(len(values))]...)
+			}
+		}
+
+		pq, err := NewProductQuantizer(6, 3, 4)
+		require.Nil(t, err)
+		require.Nil(t, pq.Fit(data))
+
+		for _, vec := range data[:20] {
+			code, err := pq.Encode(vec)
+			require.Nil(t, err)
+			assert.Len(t, code, 3)
+			assert.InDeltaSlice(t, vec, pq.Decode(code, nil), 0.0001)
+		}
+	})
+
+	t.Run("decoding into a reused buffer", func(t *testing.T) {
+		r := rand.New(rand.NewSource(5))
+		data := make([][]float32, 300)
+		for i := range data {
+			data[i] = []float32{r.Float32(), r.Float32(), r.Float32(), r.Float32()}
+		}
+
+		pq, err := NewProductQuantizer(4, 2, 32)
+		require.Nil(t, err)
+		require.Nil(t, pq.Fit(data))
+
+		code, err := pq.Encode(data[0])
+		require.Nil(t, err)
+
+		buf := make([]float32, 4)
+		decoded := pq.Decode(code, buf)
+		assert.Equal(t, &buf[0], &decoded[0])
+		assert.InDeltaSlice(t, data[0], decoded, 0.2)
+	})
+	t.Run("distance lookups match the distances to the decoded vectors", func(t *testing.T) {
+		r := rand.New(rand.NewSource(9))
+		data := make([][]float32, 300)
+		for i := range data {
+			data[i] = make([]float32, 8)
+			for d := range data[i] {
+				// few distinct values, so that the hamming distance is not
+				// always the full length
+				data[i][d] = float32(r.Intn(3))
+			}
+		}
+
+		pq, err := NewProductQuantizer(8, 4, 16)
+		require.Nil(t, err)
+		require.Nil(t, pq.Fit(data))
+
+		providers := map[Metric]distancer.Provider{
+			Cosine:    distancer.NewCosineProvider(),
+			Dot:       distancer.NewDotProductProvider(),
+			L2Squared: distancer.NewL2SquaredProvider(),
+			Manhattan: distancer.NewManhattanProvider(),
+			Hamming:   distancer.NewHammingProvider(),
+		}
+
+		query := []float32{0.5, 1, 2, 0, 1, 1.5, 2, 0.5}
+		for metric, provider := range providers {
+			lookup, err := pq.NewDistanceLookup(query, metric)
+			require.Nil(t, err)
+
+			for _, vec := range data[:20] {
+				code, err := pq.Encode(vec)
+				require.Nil(t, err)
+
+				expected, _, err := provider.New(query).Distance(pq.Decode(code, nil))
+				require.Nil(t, err)
+				assert.InDelta(t, expected, lookup.Distance(code), 0.0001,
+					"metric %d", metric)
+			}
+		}
+	})
+
+	t.Run("restoring a serialized quantizer", func(t *testing.T) {
+		r := rand.New(rand.NewSource(11))
+		data := make([][]float32, 300)
+		for i := range data {
+			data[i] = []float32{r.Float32(), r.Float32(), r.Float32(), r.Float32()}
+		}
+
+		pq, err := NewProductQuantizer(4, 2, 32)
+		require.Nil(t, err)
+		require.Nil(t, pq.Fit(data))
+
+		serialized, err := pq.MarshalBinary()
+		require.Nil(t, err)
+
+		restored := &ProductQuantizer{}
+		require.Nil(t, restored.UnmarshalBinary(serialized))
+		assert.Equal(t, pq, restored)
+
+		assert.NotNil(t, restored.UnmarshalBinary(serialized[:len(serialized)-1]),
+			"truncated codebooks")
+	})
+}
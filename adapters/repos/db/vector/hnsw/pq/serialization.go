@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package pq
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// The layout of a serialized quantizer is:
+//
+//   version (uint8)
+//   dimensions (uint32), segments (uint32), centroids (uint32)
+//   the codebooks of all segments (float32 each)
+
+const serializationVersion uint8 = 1
+
+// MarshalBinary serializes a trained quantizer, so that it does not have to
+// be trained again
+func (pq *ProductQuantizer) MarshalBinary() ([]byte, error) {
+	if pq.codebooks == nil {
+		return nil, fmt.Errorf("product quantizer has not been trained")
+	}
+
+	buf := &bytes.Buffer{}
+	for _, value := range []interface{}{
+		serializationVersion,
+		uint32(pq.dimensions),
+		uint32(pq.segments),
+		uint32(pq.centroids),
+	} {
+		if err := binary.Write(buf, binary.LittleEndian, value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, codebook := range pq.codebooks {
+		if err := binary.Write(buf, binary.LittleEndian, codebook); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary restores a quantizer serialized with MarshalBinary, it can
+// encode vectors right away
+func (pq *ProductQuantizer) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+
+	var version uint8
+	var dimensions, segments, centroids uint32
+	for _, value := range []interface{}{
+		&version, &dimensions, &segments, &centroids,
+	} {
+		if err := binary.Read(r, binary.LittleEndian, value); err != nil {
+			return errors.Wrap(err, "read product quantizer header")
+		}
+	}
+
+	if version != serializationVersion {
+		return fmt.Errorf("unsupported product quantizer version %d", version)
+	}
+
+	restored, err := NewProductQuantizer(int(dimensions), int(segments),
+		int(centroids))
+	if err != nil {
+		return errors.Wrap(err, "read product quantizer")
+	}
+
+	// checked before allocating, so that a corrupted header can never lead
+	// to an excessive allocation
+	expected := int(segments) * int(centroids) * restored.segmentLen * 4
+	if r.Len() != expected {
+		return fmt.Errorf("product quantizer contains %d bytes of codebooks, "+
+			"expected %d", r.Len(), expected)
+	}
+
+	codebooks := make([][]float32, segments)
+	for segment := range codebooks {
+		codebooks[segment] = make([]float32, int(centroids)*restored.segmentLen)
+		if err := binary.Read(r, binary.LittleEndian, codebooks[segment]); err != nil {
+			return errors.Wrap(err, "read codebooks")
+		}
+	}
+
+	restored.setCodebooks(codebooks)
+	*pq = *restored
+	return nil
+}
@@ -14,11 +14,12 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 )
 
 func reasonableEfFromK(k int) int {
@@ -30,12 +31,41 @@ func reasonableEfFromK(k int) int {
 	return ef
 }
 
+// searchEF returns the user-configured ef or derives one from k if the user
+// did not set one. The ef can never be smaller than k, as the search would
+// otherwise not produce enough results.
+func (h *hnsw) searchEF(k int) int {
+	ef := int(atomic.LoadInt64(&h.ef))
+	if ef < 1 {
+		ef = reasonableEfFromK(k)
+	}
+
+	if ef < k {
+		ef = k
+	}
+
+	return ef
+}
+
+// searchEFWithRescoring makes sure that a search on compressed vectors
+// finds enough candidates to rescore
+func (h *hnsw) searchEFWithRescoring(k int) int {
+	ef := h.searchEF(k)
+	if limit := h.rescoreLimit(); limit > ef {
+		return limit
+	}
+
+	return ef
+}
+
 func (h *hnsw) SearchByID(id int, k int) ([]int, error) {
-	return h.knnSearch(id, k, reasonableEfFromK(k))
+	defer h.metrics.Search(time.Now())
+	return h.knnSearch(id, k, h.searchEFWithRescoring(k))
 }
 
 func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]int, error) {
-	return h.knnSearchByVector(vector, k, reasonableEfFromK(k), allowList)
+	defer h.metrics.Search(time.Now())
+	return h.knnSearchByVector(vector, k, h.searchEFWithRescoring(k), allowList)
 }
 
 func (h *hnsw) knnSearch(queryNodeID int, k int, ef int) ([]int, error) {
@@ -71,17 +101,7 @@ func (h *hnsw) knnSearch(queryNodeID int, k int, ef int) ([]int, error) {
 		return nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
-	flat := res.flattenInOrder()
-	size := min(len(flat), k)
-	out := make([]int, size)
-	for i, elem := range flat {
-		if i >= size {
-			break
-		}
-		out[i] = elem.index
-	}
-
-	return out, nil
+	return h.topK(queryVector, res, k)
 }
 
 func (h *hnsw) searchLayerByVector(queryVector []float32,
@@ -90,7 +110,7 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 	visited := newVisitedList(entrypoints)
 	candidates := &binarySearchTreeGeneric{}
 	results := &binarySearchTreeGeneric{}
-	distancer := h.distancerProvider.New(queryVector)
+	distancer := h.newSearchDistancer(queryVector)
 
 	h.insertViableEntrypointsAsCandidatesAndResults(entrypoints, candidates,
 		results, level, allowList)
@@ -177,7 +197,7 @@ func (h *hnsw) insertViableEntrypointsAsCandidatesAndResults(
 }
 
 func (h *hnsw) currentWorstResultDistance(results *binarySearchTreeGeneric,
-	distancer distancer.Distancer) (float32, error) {
+	distancer *searchDistancer) (float32, error) {
 	if results.root != nil {
 		id := int32(results.maximum().index)
 		d, ok, err := h.distanceToNode(distancer, id)
@@ -201,7 +221,7 @@ func (h *hnsw) currentWorstResultDistance(results *binarySearchTreeGeneric,
 
 func (h *hnsw) extendCandidatesAndResultsFromNeighbors(candidates,
 	results *binarySearchTreeGeneric, connections []uint32,
-	visited map[uint32]struct{}, distancer distancer.Distancer, ef int,
+	visited map[uint32]struct{}, distancer *searchDistancer, ef int,
 	level int, allowList helpers.AllowList, worstResultDistance float32) error {
 	for _, neighborID := range connections {
 		if _, ok := visited[neighborID]; ok {
@@ -252,8 +272,12 @@ func (h *hnsw) extendCandidatesAndResultsFromNeighbors(candidates,
 	return nil
 }
 
-func (h *hnsw) distanceToNode(distancer distancer.Distancer,
+func (h *hnsw) distanceToNode(distancer *searchDistancer,
 	nodeID int32) (float32, bool, error) {
+	if dist, ok := h.compressedDistance(distancer, nodeID); ok {
+		return dist, true, nil
+	}
+
 	candidateVec, err := h.vectorForID(context.Background(), nodeID)
 	if err != nil {
 		var e storobj.ErrNotFound
@@ -287,12 +311,17 @@ func (h *hnsw) handleDeletedNode(docID int32) {
 	h.addTombstone(int(docID))
 	h.logger.WithField("action", "attach_tombstone_to_deleted_node").
 		WithField("node_id", docID).
-		Info("found a deleted node (%d) without a tombstone, "+
+		Infof("found a deleted node (%d) without a tombstone, "+
 			"tombstone was added", docID)
 }
 
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList helpers.AllowList) ([]int, error) {
+	if h.isEmpty() {
+		// nothing was inserted yet, the entrypoint does not point to a node
+		return nil, nil
+	}
+
 	entryPointID := h.entryPointID
 	entryPointDistance, ok, err := h.distBetweenNodeAndVec(entryPointID, searchVec)
 	if err != nil {
@@ -325,7 +354,28 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		return nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
+	return h.topK(searchVec, res, k)
+}
+
+// topK cuts the results to the k best ones. If the index is compressed and
+// rescoring is enabled, the best candidates are rescored with their
+// uncompressed vectors first.
+func (h *hnsw) topK(searchVec []float32, res *binarySearchTreeGeneric,
+	k int) ([]int, error) {
 	flat := res.flattenInOrder()
+
+	if limit := h.rescoreLimit(); limit > 0 {
+		if limit < k {
+			limit = k
+		}
+
+		rescored, err := h.rescore(searchVec, flat[:min(len(flat), limit)])
+		if err != nil {
+			return nil, errors.Wrap(err, "knn search")
+		}
+		flat = rescored
+	}
+
 	size := min(len(flat), k)
 	out := make([]int, size)
 	for i, elem := range flat {
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Shutdown stops all background routines, persists the codes of a
+// compressed index and closes the commit log. The index must not be used
+// after it was shut down.
+func (h *hnsw) Shutdown() error {
+	var err error
+	h.shutdownOnce.Do(func() {
+		close(h.shutdown)
+		h.cache.stopWatching()
+		err = h.persistCompression()
+		if cErr := h.commitLog.Shutdown(); err == nil {
+			err = cErr
+		}
+	})
+	if err != nil {
+		return errors.Wrapf(err, "shutdown hnsw index %q", h.id)
+	}
+
+	return nil
+}
+
+// Drop shuts the index down and removes its commit logs, snapshots and
+// persisted codes from disk
+func (h *hnsw) Drop() error {
+	if err := h.Shutdown(); err != nil {
+		return err
+	}
+
+	for _, path := range []string{
+		commitLogDirectory(h.rootPath, h.id),
+		snapshotDirectory(h.rootPath, h.id),
+		compressionFileName(h.rootPath, h.id),
+	} {
+		if err := os.RemoveAll(path); err != nil {
+			return errors.Wrapf(err, "drop hnsw index %q", h.id)
+		}
+	}
+
+	return nil
+}
+
+// RenameFiles moves the commit logs, snapshots and persisted codes of the
+// index with the specified id, so that they are picked up by an index created
+// with the new id. It must only be called while no index with either id is
+// open. If a file can't be moved, the files which were moved already are
+// moved back, so that they are never split between both ids.
+func RenameFiles(rootPath, from, to string) error {
+	var moved [][2]string
+	for _, paths := range [][2]string{
+		{commitLogDirectory(rootPath, from), commitLogDirectory(rootPath, to)},
+		{snapshotDirectory(rootPath, from), snapshotDirectory(rootPath, to)},
+		{compressionFileName(rootPath, from), compressionFileName(rootPath, to)},
+	} {
+		if err := os.Rename(paths[0], paths[1]); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			for i := len(moved) - 1; i >= 0; i-- {
+				os.Rename(moved[i][1], moved[i][0])
+			}
+			return errors.Wrapf(err, "rename hnsw index %q to %q", from, to)
+		}
+
+		moved = append(moved, paths)
+	}
+
+	return nil
+}
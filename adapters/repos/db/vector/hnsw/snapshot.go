@@ -0,0 +1,524 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// A snapshot contains the entire graph as it results from deserializing all
+// commit logs up to (and including) the one named by the snapshot's time
+// stamp. On startup, the latest snapshot is loaded and only the commit logs
+// written after it are replayed. The commit logs themselves are kept, so
+// that a corrupted snapshot can be detected through its checksum and the
+// index can fall back to replaying all commit logs.
+//
+// The file layout is:
+//
+//   version (uint8)
+//   entrypoint (uint32), level (uint16), entrypoint changed (uint8)
+//   length of the nodes slice (uint32)
+//   number of nodes (uint32), for each node:
+//     id (uint32), level (uint16), number of connection levels (uint16),
+//     for each level: level (uint16), number of links (uint32), links
+//   number of tombstones (uint32), tombstone ids (uint32 each)
+//   crc32 checksum of everything above (uint32)
+
+const snapshotVersion uint8 = 1
+
+const snapshotSuffix = ".snapshot"
+
+func snapshotDirectory(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.snapshot.d", rootPath, name)
+}
+
+func snapshotFileName(rootPath, name string, commitLogTimeStamp int64) string {
+	return fmt.Sprintf("%s/%d%s", snapshotDirectory(rootPath, name),
+		commitLogTimeStamp, snapshotSuffix)
+}
+
+// getSnapshotTimeStamps in order, from new to old. Left-over temporary files
+// of an interrupted snapshot are ignored.
+func getSnapshotTimeStamps(rootPath, name string) ([]int64, error) {
+	files, err := ioutil.ReadDir(snapshotDirectory(rootPath, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "browse snapshot directory")
+	}
+
+	var out []int64
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), snapshotSuffix) {
+			continue
+		}
+
+		ts, err := strconv.ParseInt(strings.TrimSuffix(file.Name(),
+			snapshotSuffix), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse snapshot file name %q", file.Name())
+		}
+
+		out = append(out, ts)
+	}
+
+	sort.Slice(out, func(a, b int) bool { return out[a] > out[b] })
+	return out, nil
+}
+
+// commitLogTimeStamp parses the time stamp of a commit log from its path,
+// condensed logs have the same time stamp as their uncondensed original
+func commitLogTimeStamp(path string) (int64, error) {
+	return asTimeStamp(filepath.Base(path))
+}
+
+// loadLatestSnapshot returns the state contained in the latest snapshot and
+// the time stamp of the last commit log it includes. If there is no snapshot
+// or the latest snapshot cannot be read, false is returned and the caller
+// has to replay all commit logs.
+func loadLatestSnapshot(rootPath, name string,
+	logger logrus.FieldLogger) (*DeserializationResult, int64, bool) {
+	timeStamps, err := getSnapshotTimeStamps(rootPath, name)
+	if err != nil {
+		logger.WithField("action", "hnsw_load_snapshot").
+			WithField("id", name).
+			WithError(err).
+			Warning("cannot list snapshots, replaying full commit log")
+		return nil, 0, false
+	}
+
+	if len(timeStamps) == 0 {
+		return nil, 0, false
+	}
+
+	fileName := snapshotFileName(rootPath, name, timeStamps[0])
+	state, err := readSnapshot(fileName)
+	if err != nil {
+		logger.WithField("action", "hnsw_load_snapshot").
+			WithField("id", name).
+			WithField("file_name", fileName).
+			WithError(err).
+			Warning("cannot read snapshot, replaying full commit log")
+		return nil, 0, false
+	}
+
+	return state, timeStamps[0], true
+}
+
+// replayCommitLogs deserializes the commit logs on top of the specified
+// state. Commit logs with a time stamp up to (and including) skipUntil are
+// skipped as they are already contained in the state.
+func replayCommitLogs(fileNames []string, state *DeserializationResult,
+	skipUntil int64, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	for _, fileName := range fileNames {
+		ts, err := commitLogTimeStamp(fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		if ts <= skipUntil {
+			continue
+		}
+
+		fd, err := os.Open(fileName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "open commit log %q for reading", fileName)
+		}
+
+		state, err = NewDeserializer(logger).Do(fd, state)
+		fd.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "deserialize commit log %q", fileName)
+		}
+	}
+
+	return state, nil
+}
+
+func readSnapshot(fileName string) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "open snapshot")
+	}
+	defer fd.Close()
+
+	// the checksum is verified before parsing, so that a corrupted length can
+	// never lead to an excessive allocation
+	size, err := verifySnapshotChecksum(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := fd.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "seek to start of snapshot")
+	}
+
+	r := &snapshotReader{r: bufio.NewReader(io.LimitReader(fd, size))}
+	state := r.read()
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return state, nil
+}
+
+// verifySnapshotChecksum returns the size of the snapshot without the
+// trailing checksum
+func verifySnapshotChecksum(fd *os.File) (int64, error) {
+	stat, err := fd.Stat()
+	if err != nil {
+		return 0, errors.Wrap(err, "stat snapshot")
+	}
+
+	size := stat.Size() - 4
+	if size < 0 {
+		return 0, fmt.Errorf("snapshot is too short to contain a checksum")
+	}
+
+	hash := crc32.NewIEEE()
+	r := bufio.NewReader(fd)
+	if _, err := io.CopyN(hash, r, size); err != nil {
+		return 0, errors.Wrap(err, "calculate checksum")
+	}
+
+	var checksum uint32
+	if err := binary.Read(r, binary.LittleEndian, &checksum); err != nil {
+		return 0, errors.Wrap(err, "read checksum")
+	}
+
+	if checksum != hash.Sum32() {
+		return 0, fmt.Errorf("checksum mismatch: snapshot is corrupted")
+	}
+
+	return size, nil
+}
+
+// snapshot writes a new snapshot if a commit log was completed since the
+// latest one. The graph is copied from memory, so no commit log has to be
+// read. The current commit log is completed before the copy is taken, so
+// every commit contained in the completed logs is contained in the copy.
+// Commits which happen while the graph is copied may be contained in the
+// copy as well, they are replayed a second time on startup, which leads to
+// the same graph.
+func (h *hnsw) snapshot() error {
+	files, err := getCommitFileNames(h.rootPath, h.id)
+	if err != nil {
+		return err
+	}
+
+	if len(files) <= 1 {
+		// the only commit log is still in use
+		return nil
+	}
+
+	// the last element is still being written to
+	lastTimeStamp, err := commitLogTimeStamp(files[len(files)-2])
+	if err != nil {
+		return err
+	}
+
+	timeStamps, err := getSnapshotTimeStamps(h.rootPath, h.id)
+	if err != nil {
+		return err
+	}
+
+	if len(timeStamps) > 0 && timeStamps[0] >= lastTimeStamp {
+		// nothing new since the last snapshot
+		return nil
+	}
+
+	timeStamp, err := h.commitLog.SwitchCommitLogs()
+	if err != nil {
+		return errors.Wrap(err, "complete current commit log")
+	}
+
+	if err := writeSnapshot(h.rootPath, h.id, timeStamp, h.copyState()); err != nil {
+		return err
+	}
+
+	h.logger.WithField("action", "hnsw_snapshot_written").
+		WithField("id", h.id).
+		WithField("commit_log_time_stamp", timeStamp).
+		Debug("wrote snapshot of hnsw index")
+
+	return removeSnapshotsBefore(h.rootPath, h.id, timeStamp)
+}
+
+// writeSnapshot writes the state to a temporary file first, which is only
+// renamed to the final file name once it was written completely. A crash
+// during writing can therefore not leave a partial snapshot behind.
+func writeSnapshot(rootPath, name string, commitLogTimeStamp int64,
+	state *DeserializationResult) error {
+	if err := os.MkdirAll(snapshotDirectory(rootPath, name), os.ModePerm); err != nil {
+		return errors.Wrap(err, "create snapshot directory")
+	}
+
+	fileName := snapshotFileName(rootPath, name, commitLogTimeStamp)
+	tmpFileName := fileName + ".tmp"
+	fd, err := os.Create(tmpFileName)
+	if err != nil {
+		return errors.Wrap(err, "create snapshot file")
+	}
+
+	bufw := bufio.NewWriter(fd)
+	w := &snapshotWriter{w: bufw, hash: crc32.NewIEEE()}
+	w.write(state)
+	if w.err == nil {
+		w.err = bufw.Flush()
+	}
+	if w.err == nil {
+		w.err = fd.Sync()
+	}
+	if err := fd.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+	if w.err != nil {
+		os.Remove(tmpFileName)
+		return errors.Wrap(w.err, "write snapshot")
+	}
+
+	if err := os.Rename(tmpFileName, fileName); err != nil {
+		return errors.Wrap(err, "rename snapshot file")
+	}
+
+	return nil
+}
+
+// removeSnapshotsBefore deletes all snapshots older than the one for the
+// specified time stamp
+func removeSnapshotsBefore(rootPath, name string, commitLogTimeStamp int64) error {
+	timeStamps, err := getSnapshotTimeStamps(rootPath, name)
+	if err != nil {
+		return err
+	}
+
+	for _, ts := range timeStamps {
+		if ts >= commitLogTimeStamp {
+			continue
+		}
+
+		if err := os.Remove(snapshotFileName(rootPath, name, ts)); err != nil {
+			return errors.Wrap(err, "remove outdated snapshot")
+		}
+	}
+
+	return nil
+}
+
+// snapshotWriter keeps track of the first error, so that the individual
+// writes don't need to be checked
+type snapshotWriter struct {
+	w    io.Writer
+	hash hash.Hash32
+	err  error
+}
+
+func (w *snapshotWriter) write(state *DeserializationResult) {
+	w.writeUint8(snapshotVersion)
+	w.writeUint32(state.Entrypoint)
+	w.writeUint16(state.Level)
+	if state.EntrypointChanged {
+		w.writeUint8(1)
+	} else {
+		w.writeUint8(0)
+	}
+
+	w.writeUint32(uint32(len(state.Nodes)))
+	nodeCount := 0
+	for _, node := range state.Nodes {
+		if node != nil {
+			nodeCount++
+		}
+	}
+	w.writeUint32(uint32(nodeCount))
+
+	for _, node := range state.Nodes {
+		if node == nil {
+			// nil nodes occur when we've grown, but not inserted anything yet
+			continue
+		}
+
+		w.writeUint32(uint32(node.id))
+		w.writeUint16(uint16(node.level))
+
+		levels := make([]int, 0, len(node.connections))
+		for level := range node.connections {
+			levels = append(levels, level)
+		}
+		sort.Ints(levels)
+
+		w.writeUint16(uint16(len(levels)))
+		for _, level := range levels {
+			links := node.connections[level]
+			w.writeUint16(uint16(level))
+			w.writeUint32(uint32(len(links)))
+			w.writeBinary(links)
+		}
+	}
+
+	tombstones := make([]int, 0, len(state.Tombstones))
+	for id := range state.Tombstones {
+		tombstones = append(tombstones, id)
+	}
+	sort.Ints(tombstones)
+
+	w.writeUint32(uint32(len(tombstones)))
+	for _, id := range tombstones {
+		w.writeUint32(uint32(id))
+	}
+
+	// the checksum itself is not part of the hash
+	w.writeChecksum()
+}
+
+func (w *snapshotWriter) writeChecksum() {
+	if w.err == nil {
+		w.err = binary.Write(w.w, binary.LittleEndian, w.hash.Sum32())
+	}
+}
+
+func (w *snapshotWriter) writeUint8(in uint8) {
+	w.writeBinary(in)
+}
+
+func (w *snapshotWriter) writeUint16(in uint16) {
+	w.writeBinary(in)
+}
+
+func (w *snapshotWriter) writeUint32(in uint32) {
+	w.writeBinary(in)
+}
+
+func (w *snapshotWriter) writeBinary(in interface{}) {
+	if w.err != nil {
+		return
+	}
+
+	w.err = binary.Write(io.MultiWriter(w.w, w.hash), binary.LittleEndian, in)
+}
+
+// snapshotReader is the counterpart to snapshotWriter, it keeps track of the
+// first error. The checksum must have been verified before.
+type snapshotReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *snapshotReader) read() *DeserializationResult {
+	if version := r.readUint8(); r.err == nil && version != snapshotVersion {
+		r.err = fmt.Errorf("unsupported snapshot version %d", version)
+		return nil
+	}
+
+	out := &DeserializationResult{
+		Entrypoint:        r.readUint32(),
+		Level:             r.readUint16(),
+		EntrypointChanged: r.readUint8() == 1,
+		Tombstones:        map[int]struct{}{},
+	}
+
+	nodesLength := r.readUint32()
+	nodeCount := r.readUint32()
+	if r.err != nil {
+		return nil
+	}
+	if nodeCount > nodesLength {
+		r.err = fmt.Errorf("snapshot contains %d nodes, but only room for %d",
+			nodeCount, nodesLength)
+		return nil
+	}
+
+	out.Nodes = make([]*vertex, nodesLength)
+	for i := uint32(0); i < nodeCount && r.err == nil; i++ {
+		id := r.readUint32()
+		level := r.readUint16()
+		levelCount := r.readUint16()
+		if r.err != nil {
+			break
+		}
+		if id >= nodesLength {
+			r.err = fmt.Errorf("node id %d out of range", id)
+			break
+		}
+
+		node := &vertex{
+			id:          int(id),
+			level:       int(level),
+			connections: make(map[int][]uint32, levelCount),
+		}
+		for j := uint16(0); j < levelCount && r.err == nil; j++ {
+			connLevel := r.readUint16()
+			length := r.readUint32()
+			if r.err != nil {
+				break
+			}
+
+			links := make([]uint32, length)
+			r.readBinary(links)
+			node.connections[int(connLevel)] = links
+		}
+
+		out.Nodes[id] = node
+	}
+
+	tombstoneCount := r.readUint32()
+	for i := uint32(0); i < tombstoneCount && r.err == nil; i++ {
+		out.Tombstones[int(r.readUint32())] = struct{}{}
+	}
+
+	if r.err != nil {
+		return nil
+	}
+
+	return out
+}
+
+func (r *snapshotReader) readUint8() uint8 {
+	var value uint8
+	r.readBinary(&value)
+	return value
+}
+
+func (r *snapshotReader) readUint16() uint16 {
+	var value uint16
+	r.readBinary(&value)
+	return value
+}
+
+func (r *snapshotReader) readUint32() uint32 {
+	var value uint32
+	r.readBinary(&value)
+	return value
+}
+
+func (r *snapshotReader) readBinary(out interface{}) {
+	if r.err != nil {
+		return
+	}
+
+	r.err = binary.Read(r.r, binary.LittleEndian, out)
+}
@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package hnsw
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	rootPath := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(rootPath, 0o777)
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	id := "snapshot"
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, id), 0o777))
+
+	writeCommitLog := func(t *testing.T, fileName string, write func(c *MemoryCondensor)) {
+		fd, err := os.Create(commitLogFileName(rootPath, id, fileName))
+		require.Nil(t, err)
+		c := NewMemoryCondensor(logger)
+		c.newLog = fd
+		write(c)
+		require.Nil(t, fd.Close())
+	}
+
+	t.Run("write three commit logs", func(t *testing.T) {
+		writeCommitLog(t, "1000.condensed", func(c *MemoryCondensor) {
+			c.AddNode(&vertex{id: 0, level: 1})
+			c.AddNode(&vertex{id: 1, level: 0})
+			c.SetLinksAtLevel(0, 0, []uint32{1})
+			c.SetLinksAtLevel(1, 0, []uint32{0})
+			c.SetEntryPointWithMaxLayer(0, 1)
+		})
+
+		writeCommitLog(t, "2000", func(c *MemoryCondensor) {
+			c.AddNode(&vertex{id: 2, level: 0})
+			c.SetLinksAtLevel(0, 0, []uint32{1, 2})
+			c.SetLinksAtLevel(2, 0, []uint32{0})
+			c.AddTombstone(1)
+		})
+
+		// the latest commit log is still in use and never part of a snapshot
+		writeCommitLog(t, "3000", func(c *MemoryCondensor) {
+			c.AddNode(&vertex{id: 3, level: 0})
+			c.SetLinksAtLevel(3, 0, []uint32{2})
+		})
+	})
+
+	h := &hnsw{rootPath: rootPath, id: id, logger: logger}
+	var l *hnswCommitLogger
+
+	t.Run("restore the index and create a snapshot", func(t *testing.T) {
+		require.Nil(t, h.restoreFromDisk())
+
+		var err error
+		l, err = NewCommitLogger(rootPath, id, 0, logger, nil)
+		require.Nil(t, err)
+		h.commitLog = l
+
+		require.Nil(t, h.snapshot())
+
+		// the commit log which was in use is completed by the snapshot
+		timeStamps, err := getSnapshotTimeStamps(rootPath, id)
+		require.Nil(t, err)
+		assert.Equal(t, []int64{3000}, timeStamps)
+
+		state, err := readSnapshot(snapshotFileName(rootPath, id, 3000))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(0), state.Entrypoint)
+		assert.Equal(t, uint16(1), state.Level)
+		assert.True(t, state.EntrypointChanged)
+		assert.Equal(t, map[int]struct{}{1: {}}, state.Tombstones)
+		require.NotNil(t, state.Nodes[3])
+		assert.Equal(t, []uint32{1, 2}, state.Nodes[0].connections[0])
+		assert.Equal(t, []uint32{2}, state.Nodes[3].connections[0])
+	})
+
+	t.Run("nothing is written without a newly completed commit log", func(t *testing.T) {
+		require.Nil(t, h.snapshot())
+
+		timeStamps, err := getSnapshotTimeStamps(rootPath, id)
+		require.Nil(t, err)
+		assert.Equal(t, []int64{3000}, timeStamps)
+	})
+
+	expectNodes := func(t *testing.T, h *hnsw) {
+		for i := 0; i < 4; i++ {
+			require.NotNil(t, h.nodes[i], "node %d", i)
+		}
+		assert.Equal(t, 0, h.entryPointID)
+		assert.Equal(t, 1, h.currentMaximumLayer)
+		assert.Equal(t, map[int]struct{}{1: {}}, h.tombstones)
+		assert.Equal(t, []uint32{1, 2}, h.nodes[0].connections[0])
+		assert.Equal(t, []uint32{2}, h.nodes[3].connections[0])
+	}
+
+	t.Run("restore only replays the logs after the snapshot", func(t *testing.T) {
+		// move the logs contained in the snapshot out of the way, the index
+		// can only be complete if it was read from the snapshot
+		backup := rootPath + "/backup"
+		require.Nil(t, os.MkdirAll(backup, 0o777))
+		for _, name := range []string{"1000.condensed", "2000", "3000"} {
+			require.Nil(t, os.Rename(commitLogFileName(rootPath, id, name),
+				backup+"/"+name))
+		}
+
+		restored := &hnsw{rootPath: rootPath, id: id, logger: logger}
+		require.Nil(t, restored.restoreFromDisk())
+		expectNodes(t, restored)
+
+		for _, name := range []string{"1000.condensed", "2000", "3000"} {
+			require.Nil(t, os.Rename(backup+"/"+name,
+				commitLogFileName(rootPath, id, name)))
+		}
+	})
+
+	t.Run("a corrupted snapshot falls back to a full replay", func(t *testing.T) {
+		fileName := snapshotFileName(rootPath, id, 3000)
+		content, err := ioutil.ReadFile(fileName)
+		require.Nil(t, err)
+		content[len(content)/2] ^= 0xff
+		require.Nil(t, ioutil.WriteFile(fileName, content, 0o666))
+
+		_, err = readSnapshot(fileName)
+		assert.NotNil(t, err)
+
+		restored := &hnsw{rootPath: rootPath, id: id, logger: logger}
+		require.Nil(t, restored.restoreFromDisk())
+		expectNodes(t, restored)
+	})
+
+	t.Run("a newer snapshot replaces the old one", func(t *testing.T) {
+		node := &vertex{id: 4, connections: map[int][]uint32{}}
+		h.nodes[4] = node
+		require.Nil(t, l.AddNode(node))
+
+		// a commit log completed because of its size
+		completed, err := l.SwitchCommitLogs()
+		require.Nil(t, err)
+
+		require.Nil(t, h.snapshot())
+
+		timeStamps, err := getSnapshotTimeStamps(rootPath, id)
+		require.Nil(t, err)
+		require.Len(t, timeStamps, 1)
+		assert.Greater(t, timeStamps[0], completed)
+
+		state, err := readSnapshot(snapshotFileName(rootPath, id, timeStamps[0]))
+		require.Nil(t, err)
+		require.NotNil(t, state.Nodes[4])
+	})
+
+	t.Run("commits already contained in the snapshot are replayed", func(t *testing.T) {
+		// a link which was added while the graph was copied is contained in
+		// the snapshot and in the commit log after it
+		require.Nil(t, l.AddLinkAtLevel(0, 0, 2))
+		require.Nil(t, l.Shutdown())
+
+		restored := &hnsw{rootPath: rootPath, id: id, logger: logger}
+		require.Nil(t, restored.restoreFromDisk())
+		expectNodes(t, restored)
+		require.NotNil(t, restored.nodes[4])
+	})
+}
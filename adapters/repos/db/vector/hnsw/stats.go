@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// Stats describes the current state of the graph, see (*hnsw).Stats
+type Stats struct {
+	// Nodes includes tombstoned nodes which were not cleaned up yet
+	Nodes      int
+	Tombstones int
+	MaxLayer   int
+
+	// EntryPointID is -1 if the graph is empty
+	EntryPointID int
+
+	VectorCacheCount      int
+	VectorCacheMaxObjects int
+	CommitLogs            []CommitLogFile
+
+	// Layers is ordered from layer 0 to the max layer
+	Layers []LayerStats
+
+	// Connectivity is nil unless it was explicitly requested, as it has to
+	// visit every node
+	Connectivity *Connectivity
+}
+
+type CommitLogFile struct {
+	Name string
+	Size int64
+}
+
+type LayerStats struct {
+	Nodes         int
+	AverageDegree float64
+}
+
+// Connectivity lists the nodes which cannot be reached from the entrypoint
+// by following the connections on any layer. A search can never return
+// them, so a growing number of unreachable nodes silently degrades recall.
+// Tombstoned nodes are traversed, but neither counted as reachable nor as
+// unreachable.
+type Connectivity struct {
+	Reachable   int
+	Unreachable []int // ordered ascending
+}
+
+// Stats reports the size and shape of the graph. Checking the connectivity
+// works on a copy of the graph, so it does not block writes while the graph
+// is traversed, but temporarily needs about as much memory as the graph
+// itself.
+func (h *hnsw) Stats(checkConnectivity bool) (*Stats, error) {
+	out := &Stats{
+		EntryPointID:          -1,
+		VectorCacheCount:      int(atomic.LoadInt32(&h.cache.count)),
+		VectorCacheMaxObjects: int(atomic.LoadInt32(&h.cache.maxSize)),
+	}
+
+	h.RLock()
+	out.Tombstones = len(h.tombstones)
+	out.MaxLayer = h.currentMaximumLayer
+	entryPointID := h.entryPointID
+
+	var links []int
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		out.Nodes++
+		node.RLock()
+		for len(out.Layers) <= node.level {
+			out.Layers = append(out.Layers, LayerStats{})
+			links = append(links, 0)
+		}
+		for level := 0; level <= node.level; level++ {
+			out.Layers[level].Nodes++
+			links[level] += len(node.connections[level])
+		}
+		node.RUnlock()
+	}
+	h.RUnlock()
+
+	if out.Nodes > 0 {
+		out.EntryPointID = entryPointID
+	}
+
+	for level := range out.Layers {
+		out.Layers[level].AverageDegree = float64(links[level]) /
+			float64(out.Layers[level].Nodes)
+	}
+
+	commitLogs, err := h.commitLogFiles()
+	if err != nil {
+		return nil, errors.Wrapf(err, "stats of hnsw index %q", h.id)
+	}
+	out.CommitLogs = commitLogs
+
+	if checkConnectivity && out.Nodes > 0 {
+		out.Connectivity = checkStateConnectivity(h.copyState())
+	}
+
+	return out, nil
+}
+
+// commitLogFiles lists the commit logs from old to new. Their names are the
+// unix time stamps they were started at.
+func (h *hnsw) commitLogFiles() ([]CommitLogFile, error) {
+	files, err := ioutil.ReadDir(commitLogDirectory(h.rootPath, h.id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// persistence is turned off
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "browse commit log directory")
+	}
+
+	out := make([]CommitLogFile, len(files))
+	for i, file := range files {
+		out[i] = CommitLogFile{Name: file.Name(), Size: file.Size()}
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		if len(out[a].Name) != len(out[b].Name) {
+			return len(out[a].Name) < len(out[b].Name)
+		}
+		return out[a].Name < out[b].Name
+	})
+
+	return out, nil
+}
+
+// checkStateConnectivity does a breadth-first traversal from the entrypoint
+// over the connections of all layers
+func checkStateConnectivity(state *DeserializationResult) *Connectivity {
+	visited := make([]bool, len(state.Nodes))
+	entryPoint := int(state.Entrypoint)
+	if entryPoint < len(state.Nodes) && state.Nodes[entryPoint] != nil {
+		visited[entryPoint] = true
+		queue := []int{entryPoint}
+		for len(queue) > 0 {
+			node := state.Nodes[queue[0]]
+			queue = queue[1:]
+
+			for _, connections := range node.connections {
+				for _, target := range connections {
+					id := int(target)
+					if id >= len(state.Nodes) || state.Nodes[id] == nil || visited[id] {
+						continue
+					}
+
+					visited[id] = true
+					queue = append(queue, id)
+				}
+			}
+		}
+	}
+
+	out := &Connectivity{}
+	for id, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+
+		if _, ok := state.Tombstones[id]; ok {
+			continue
+		}
+
+		if visited[id] {
+			out.Reachable++
+		} else {
+			out.Unreachable = append(out.Unreachable, id)
+		}
+	}
+
+	return out
+}
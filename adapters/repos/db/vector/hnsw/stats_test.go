@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats(t *testing.T) {
+	vectors := vectorsForDeleteTest()
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "stats-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		MaximumConnections:    30,
+		EFConstruction:        128,
+		VectorForIDThunk: func(ctx context.Context, id int32) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	})
+	require.Nil(t, err)
+
+	t.Run("an empty index", func(t *testing.T) {
+		stats, err := index.Stats(true)
+		require.Nil(t, err)
+
+		assert.Equal(t, 0, stats.Nodes)
+		assert.Equal(t, -1, stats.EntryPointID)
+		assert.Len(t, stats.Layers, 0)
+		assert.Len(t, stats.CommitLogs, 0)
+		assert.Nil(t, stats.Connectivity)
+	})
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(i, vec))
+	}
+
+	t.Run("without the connectivity check", func(t *testing.T) {
+		stats, err := index.Stats(false)
+		require.Nil(t, err)
+
+		assert.Equal(t, len(vectors), stats.Nodes)
+		assert.Equal(t, 0, stats.Tombstones)
+		assert.Equal(t, index.entryPointID, stats.EntryPointID)
+		assert.Equal(t, index.currentMaximumLayer, stats.MaxLayer)
+		require.Len(t, stats.Layers, stats.MaxLayer+1)
+		assert.Equal(t, len(vectors), stats.Layers[0].Nodes)
+		assert.True(t, stats.Layers[0].AverageDegree > 0)
+		for level := 1; level < len(stats.Layers); level++ {
+			assert.True(t, stats.Layers[level].Nodes <= stats.Layers[level-1].Nodes)
+		}
+		assert.Nil(t, stats.Connectivity)
+	})
+
+	t.Run("a fully connected graph", func(t *testing.T) {
+		stats, err := index.Stats(true)
+		require.Nil(t, err)
+
+		require.NotNil(t, stats.Connectivity)
+		assert.Equal(t, len(vectors), stats.Connectivity.Reachable)
+		assert.Len(t, stats.Connectivity.Unreachable, 0)
+	})
+
+	// pick a node other than the entrypoint and remove every link to it
+	cutOff := 0
+	if cutOff == index.entryPointID {
+		cutOff = 1
+	}
+	for _, node := range index.nodes {
+		if node == nil {
+			continue
+		}
+
+		for level, connections := range node.connections {
+			kept := connections[:0]
+			for _, target := range connections {
+				if int(target) != cutOff {
+					kept = append(kept, target)
+				}
+			}
+			node.connections[level] = kept
+		}
+	}
+
+	t.Run("a graph with an unreachable node", func(t *testing.T) {
+		stats, err := index.Stats(true)
+		require.Nil(t, err)
+
+		require.NotNil(t, stats.Connectivity)
+		assert.Equal(t, len(vectors)-1, stats.Connectivity.Reachable)
+		assert.Equal(t, []int{cutOff}, stats.Connectivity.Unreachable)
+	})
+
+	t.Run("tombstoned nodes are not reported as unreachable", func(t *testing.T) {
+		require.Nil(t, index.Delete(cutOff))
+
+		stats, err := index.Stats(true)
+		require.Nil(t, err)
+
+		assert.Equal(t, 1, stats.Tombstones)
+		assert.Equal(t, len(vectors), stats.Nodes)
+		assert.Equal(t, len(vectors)-1, stats.Connectivity.Reachable)
+		assert.Len(t, stats.Connectivity.Unreachable, 0)
+	})
+}
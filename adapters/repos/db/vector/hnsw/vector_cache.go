@@ -21,21 +21,33 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const defaultVectorCacheMaxObjects = 50000
+
 type vectorCache struct {
 	cache         sync.Map
 	count         int32
-	maxSize       int
+	maxSize       int32 // can be changed on a live cache, access atomically
 	getFromSource VectorForID
 	logger        logrus.FieldLogger
+	metrics       *Metrics
+	shutdown      chan struct{}
+
+	// set while the index is compressed, every vector is then read from the
+	// source and nothing is cached. Access atomically.
+	bypass int32
 	sync.RWMutex
 }
 
-func newCache(getFromSource VectorForID, logger logrus.FieldLogger) *vectorCache {
+func newCache(getFromSource VectorForID, maxSize int,
+	logger logrus.FieldLogger, metrics *Metrics) *vectorCache {
 	vc := &vectorCache{
 		cache:         sync.Map{},
 		count:         0,
-		maxSize:       50000, // TODO: make configurable
+		maxSize:       int32(maxSize),
 		getFromSource: getFromSource,
+		logger:        logger,
+		metrics:       metrics,
+		shutdown:      make(chan struct{}),
 	}
 
 	vc.watchForDeletion()
@@ -44,16 +56,26 @@ func newCache(getFromSource VectorForID, logger logrus.FieldLogger) *vectorCache
 
 func (c *vectorCache) watchForDeletion() {
 	go func() {
-		t := time.Tick(10 * time.Second)
+		t := time.NewTicker(10 * time.Second)
+		defer t.Stop()
 		for {
-			<-t
-			c.replaceMapIfFull()
+			select {
+			case <-t.C:
+				c.replaceMapIfFull()
+			case <-c.shutdown:
+				return
+			}
 		}
 	}()
 }
 
+// stopWatching stops the background routine, it must only be called once
+func (c *vectorCache) stopWatching() {
+	close(c.shutdown)
+}
+
 func (c *vectorCache) replaceMapIfFull() {
-	if c.count >= int32(c.maxSize) {
+	if atomic.LoadInt32(&c.count) >= atomic.LoadInt32(&c.maxSize) {
 		c.Lock()
 		c.logger.WithField("action", "hnsw_delete_vector_cache").
 			Debug("deleting full vector cache")
@@ -63,11 +85,44 @@ func (c *vectorCache) replaceMapIfFull() {
 	}
 }
 
+// updateMaxSize takes effect with the next periodic check, i.e. a cache
+// which exceeds the new size is not emptied right away
+func (c *vectorCache) updateMaxSize(size int) {
+	atomic.StoreInt32(&c.maxSize, int32(size))
+}
+
+// setBypass drops all cached vectors when the bypass is turned on. A
+// compressed index only needs the uncompressed vectors for the few nodes
+// without a code and for rescoring, keeping them in memory would defeat the
+// purpose of the compression.
+func (c *vectorCache) setBypass(bypass bool) {
+	if !bypass {
+		atomic.StoreInt32(&c.bypass, 0)
+		return
+	}
+
+	atomic.StoreInt32(&c.bypass, 1)
+	c.Lock()
+	c.cache = sync.Map{}
+	atomic.StoreInt32(&c.count, 0)
+	c.Unlock()
+}
+
 func (c *vectorCache) get(ctx context.Context, id int32) ([]float32, error) {
+	if atomic.LoadInt32(&c.bypass) == 1 {
+		vec, err := c.getFromSource(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get vector with id %d", id)
+		}
+
+		return vec, nil
+	}
+
 	c.RLock()
 	vec, ok := c.cache.Load(id)
 	c.RUnlock()
 	if !ok {
+		c.metrics.CacheMiss()
 		vec, err := c.getFromSource(ctx, id)
 		if err != nil {
 			return nil, errors.Wrapf(err, "fill cache with id %d", id)
@@ -80,5 +135,6 @@ func (c *vectorCache) get(ctx context.Context, id int32) ([]float32, error) {
 		return vec, nil
 	}
 
+	c.metrics.CacheHit()
 	return vec.([]float32), nil
 }
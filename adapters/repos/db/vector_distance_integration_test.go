@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorDistanceMetrics(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	// sameDirection points in exactly the same direction as the query vector,
+	// so it is always the best match using cosine. largerMagnitude points in a
+	// slightly different direction, but is the better match for the other
+	// metrics
+	sameDirectionID := strfmt.UUID("8b3e5aa2-6b4a-4a08-9d7d-7b5c0a1b1c01")
+	largerMagnitudeID := strfmt.UUID("8b3e5aa2-6b4a-4a08-9d7d-7b5c0a1b1c02")
+
+	type testCase struct {
+		distance      string
+		query         []float32
+		sameDirection []float32
+		other         []float32
+		expectedFirst strfmt.UUID
+	}
+
+	tests := []testCase{
+		{
+			distance:      "cosine",
+			query:         []float32{10, 0},
+			sameDirection: []float32{1, 0},
+			other:         []float32{9, 1},
+			expectedFirst: sameDirectionID,
+		},
+		{
+			distance:      "l2-squared",
+			query:         []float32{10, 0},
+			sameDirection: []float32{1, 0},
+			other:         []float32{9, 1},
+			expectedFirst: largerMagnitudeID,
+		},
+		{
+			distance:      "manhattan",
+			query:         []float32{10, 0},
+			sameDirection: []float32{1, 0},
+			other:         []float32{9, 1},
+			expectedFirst: largerMagnitudeID,
+		},
+		{
+			distance:      "dot",
+			query:         []float32{1, 0},
+			sameDirection: []float32{1, 0},
+			other:         []float32{5, 5},
+			expectedFirst: largerMagnitudeID,
+		},
+		{
+			distance:      "hamming",
+			query:         []float32{1, 0, 1},
+			sameDirection: []float32{2, 0, 2},
+			other:         []float32{1, 0, 0},
+			expectedFirst: largerMagnitudeID,
+		},
+	}
+
+	var classes []*models.Class
+	for i, test := range tests {
+		class := &models.Class{
+			Class: fmt.Sprintf("DistanceClass%d", i),
+			VectorIndexConfig: &models.VectorIndexConfig{
+				Distance: test.distance,
+			},
+			Properties: []*models.Property{
+				&models.Property{
+					Name:     "name",
+					DataType: []string{string(schema.DataTypeString)},
+				},
+			},
+		}
+		classes = append(classes, class)
+
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	}
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: classes,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.distance, func(t *testing.T) {
+			className := classes[i].Class
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  className,
+				ID:     sameDirectionID,
+				Schema: map[string]interface{}{"name": "same direction"},
+			}, test.sameDirection)
+			require.Nil(t, err)
+
+			err = repo.PutThing(context.Background(), &models.Thing{
+				Class:  className,
+				ID:     largerMagnitudeID,
+				Schema: map[string]interface{}{"name": "larger magnitude"},
+			}, test.other)
+			require.Nil(t, err)
+
+			res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+				Kind:         kind.Thing,
+				ClassName:    className,
+				Pagination:   &filters.Pagination{Limit: 2},
+				SearchVector: test.query,
+			})
+			require.Nil(t, err)
+			require.Len(t, res, 2)
+			assert.Equal(t, test.expectedFirst, res[0].ID)
+
+			// the certainty follows the ranking of the metric
+			first, err := repo.Certainty(kind.Thing, schema.ClassName(className),
+				test.query, res[0].Vector)
+			require.Nil(t, err)
+			second, err := repo.Certainty(kind.Thing, schema.ClassName(className),
+				test.query, res[1].Vector)
+			require.Nil(t, err)
+			assert.True(t, first > second)
+			assert.True(t, first <= 1)
+			assert.True(t, second >= 0)
+		})
+	}
+}
+
+func TestVectorSearchAcrossDistanceMetrics(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	cosineClass := &models.Class{
+		Class:             "MixedDistanceCosine",
+		VectorIndexConfig: &models.VectorIndexConfig{Distance: "cosine"},
+	}
+	l2Class := &models.Class{
+		Class:             "MixedDistanceL2",
+		VectorIndexConfig: &models.VectorIndexConfig{Distance: "l2-squared"},
+	}
+	for _, class := range []*models.Class{cosineClass, l2Class} {
+		class.Properties = []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		}
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	}
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{cosineClass, l2Class},
+		},
+	}
+
+	// the l2 object points in exactly the direction of the query, but is far
+	// away from it, so it is the worse match of the two
+	cosineID := strfmt.UUID("5d1e3a4c-8f1a-4a9b-a1e2-0c5c9d2f0a01")
+	l2ID := strfmt.UUID("5d1e3a4c-8f1a-4a9b-a1e2-0c5c9d2f0a02")
+	require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+		Class:  cosineClass.Class,
+		ID:     cosineID,
+		Schema: map[string]interface{}{"name": "cosine"},
+	}, []float32{1, 1}))
+	require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+		Class:  l2Class.Class,
+		ID:     l2ID,
+		Schema: map[string]interface{}{"name": "l2"},
+	}, []float32{10, 0}))
+
+	res, err := repo.VectorSearch(context.Background(), []float32{1, 0}, 10, nil)
+	require.Nil(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, cosineID, res[0].ID)
+	assert.InDelta(t, 0.854, res[0].Certainty, 0.001)
+	assert.Equal(t, l2ID, res[1].ID)
+	assert.InDelta(t, 1.0/82, res[1].Certainty, 0.001)
+}
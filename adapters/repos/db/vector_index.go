@@ -11,7 +11,14 @@
 
 package db
 
-import "github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+)
 
 // VectorIndex is anything that indexes vectors effieciently. For an example
 // look at ./vector/hsnw/index.go
@@ -20,4 +27,27 @@ type VectorIndex interface {
 	Delete(id int) error
 	SearchByID(id int, k int) ([]int, error)
 	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]int, error)
+	UpdateConfig(cfg hnsw.UpdatableConfig) error
+	Shutdown() error
+	Drop() error
+	Backup(rootPath string) error
+}
+
+// distanceProviderFromName maps the distance set in the class' vector index
+// config to the matching distancer.Provider
+func distanceProviderFromName(name string) (distancer.Provider, error) {
+	switch name {
+	case "", schemaUC.DistanceCosine:
+		return distancer.NewCosineProvider(), nil
+	case schemaUC.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case schemaUC.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case schemaUC.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case schemaUC.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, fmt.Errorf("unsupported distance %q", name)
+	}
 }
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorIndexConfig(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "TunedVectorIndexClass",
+		VectorIndexConfig: &models.VectorIndexConfig{
+			MaxConnections:         16,
+			EfConstruction:         64,
+			Ef:                     32,
+			VectorCacheMaxObjects:  100,
+			CleanupIntervalSeconds: 10,
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "position",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	t.Run("the index uses the settings of the class", func(t *testing.T) {
+		assert.Equal(t, 16, idx.Config.MaxConnections)
+		assert.Equal(t, 64, idx.Config.EFConstruction)
+		assert.Equal(t, 32, idx.Config.EF)
+		assert.Equal(t, 100, idx.Config.VectorCacheMaxObjects)
+		assert.Equal(t, 10*time.Second, idx.Config.CleanupInterval)
+	})
+
+	objectCount := 200
+	ids := make([]strfmt.UUID, objectCount)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		for i := range ids {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  class.Class,
+				ID:     ids[i],
+				Schema: map[string]interface{}{"position": int64(i)},
+			}, []float32{1, float32(i), 0})
+			require.Nil(t, err)
+		}
+	})
+
+	search := func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 3},
+			SearchVector: []float32{0, 1, 0},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, ids[objectCount-1], res[0].ID)
+	}
+
+	t.Run("searching with the initial settings", search)
+
+	t.Run("updating the mutable settings on the live class", func(t *testing.T) {
+		updated := *class.VectorIndexConfig
+		updated.Ef = 200
+		updated.VectorCacheMaxObjects = 1000
+
+		err := migrator.UpdateVectorIndexConfig(context.Background(), kind.Thing,
+			class.Class, &updated)
+		require.Nil(t, err)
+
+		assert.Equal(t, 200, idx.Config.EF)
+		assert.Equal(t, 1000, idx.Config.VectorCacheMaxObjects)
+	})
+
+	t.Run("searching with the updated settings", search)
+
+	t.Run("enabling compression on the live class", func(t *testing.T) {
+		updated := *class.VectorIndexConfig
+		updated.Pq = &models.ProductQuantizationConfig{
+			Enabled:       true,
+			Segments:      3,
+			Centroids:     16,
+			TrainingLimit: 100,
+			RescoreLimit:  10,
+		}
+
+		err := migrator.UpdateVectorIndexConfig(context.Background(), kind.Thing,
+			class.Class, &updated)
+		require.Nil(t, err)
+
+		assert.True(t, idx.Config.PQ.Enabled)
+		assert.Equal(t, 10, idx.Config.PQ.RescoreLimit)
+	})
+
+	t.Run("searching while or after compressing", search)
+
+	t.Run("updating a non-existing class", func(t *testing.T) {
+		err := migrator.UpdateVectorIndexConfig(context.Background(), kind.Thing,
+			"DoesNotExist", &models.VectorIndexConfig{})
+		assert.NotNil(t, err)
+	})
+}
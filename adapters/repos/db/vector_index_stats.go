@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/flat"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/semi-technologies/weaviate/usecases/shards"
+)
+
+// hnswStatser is implemented by the hnsw index, whose type is not exported
+type hnswStatser interface {
+	Stats(checkConnectivity bool) (*hnsw.Stats, error)
+}
+
+// VectorIndexStats reports the state of the vector index of a single shard.
+// It returns nil if the class has no shard with this name.
+func (d *DB) VectorIndexStats(ctx context.Context, kind kind.Kind,
+	className schema.ClassName, shardName string,
+	checkConnectivity bool) (*shards.VectorIndexStats, error) {
+	idx := d.GetIndex(kind, className)
+	if idx == nil {
+		return nil, fmt.Errorf("vector index stats of class %s: class does not exist", className)
+	}
+
+	shard, ok := idx.Shards[shardName]
+	if !ok {
+		return nil, nil
+	}
+
+	return shard.vectorIndexStats(checkConnectivity)
+}
+
+func (s *Shard) vectorIndexStats(checkConnectivity bool) (*shards.VectorIndexStats, error) {
+	switch vi := s.vectorIndex.(type) {
+	case *flat.Index:
+		nodes, err := vi.Len()
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", s.ID())
+		}
+
+		cacheCount, cacheMaxObjects := vi.CacheStats()
+		return &shards.VectorIndexStats{
+			Type:                  schemaUC.VectorIndexTypeFlat,
+			Nodes:                 nodes,
+			VectorCacheCount:      cacheCount,
+			VectorCacheMaxObjects: cacheMaxObjects,
+		}, nil
+	case hnswStatser:
+		stats, err := vi.Stats(checkConnectivity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", s.ID())
+		}
+
+		return vectorIndexStatsFromHNSW(stats), nil
+	default:
+		return nil, fmt.Errorf("shard %s: unsupported vector index %T", s.ID(), vi)
+	}
+}
+
+func vectorIndexStatsFromHNSW(stats *hnsw.Stats) *shards.VectorIndexStats {
+	out := &shards.VectorIndexStats{
+		Type:                  schemaUC.VectorIndexTypeHNSW,
+		Nodes:                 stats.Nodes,
+		Tombstones:            stats.Tombstones,
+		MaxLayer:              stats.MaxLayer,
+		VectorCacheCount:      stats.VectorCacheCount,
+		VectorCacheMaxObjects: stats.VectorCacheMaxObjects,
+		CommitLogs:            make([]shards.CommitLogFile, len(stats.CommitLogs)),
+		Layers:                make([]shards.LayerStats, len(stats.Layers)),
+	}
+
+	if stats.EntryPointID >= 0 {
+		entryPoint := stats.EntryPointID
+		out.EntryPoint = &entryPoint
+	}
+
+	for i, commitLog := range stats.CommitLogs {
+		out.CommitLogs[i] = shards.CommitLogFile{
+			Name: commitLog.Name,
+			Size: commitLog.Size,
+		}
+	}
+
+	for i, layer := range stats.Layers {
+		out.Layers[i] = shards.LayerStats{
+			Nodes:         layer.Nodes,
+			AverageDegree: layer.AverageDegree,
+		}
+	}
+
+	if stats.Connectivity != nil {
+		out.Connectivity = &shards.Connectivity{
+			Reachable:   stats.Connectivity.Reachable,
+			Unreachable: stats.Connectivity.Unreachable,
+		}
+	}
+
+	return out
+}
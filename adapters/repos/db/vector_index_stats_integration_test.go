@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorIndexStats(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	hnswClass := &models.Class{
+		Class:             "VectorIndexStatsHNSW",
+		VectorIndexConfig: &models.VectorIndexConfig{},
+	}
+	flatClass := &models.Class{
+		Class:             "VectorIndexStatsFlat",
+		VectorIndexConfig: &models.VectorIndexConfig{Type: "flat"},
+	}
+	for _, class := range []*models.Class{hnswClass, flatClass} {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	}
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{hnswClass, flatClass},
+		},
+	}
+
+	const objects = 300
+	for _, class := range []*models.Class{hnswClass, flatClass} {
+		for i := 0; i < objects; i++ {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class: class.Class,
+				ID:    strfmt.UUID(uuid.New().String()),
+			}, []float32{rand.Float32(), rand.Float32(), rand.Float32()})
+			require.Nil(t, err)
+		}
+	}
+
+	shardName := func(class *models.Class) string {
+		status, err := repo.ShardsStatus(context.Background(), kind.Thing,
+			schema.ClassName(class.Class))
+		require.Nil(t, err)
+		require.Len(t, status, 1)
+		return status[0].Name
+	}
+
+	t.Run("an hnsw index", func(t *testing.T) {
+		stats, err := repo.VectorIndexStats(context.Background(), kind.Thing,
+			schema.ClassName(hnswClass.Class), shardName(hnswClass), false)
+		require.Nil(t, err)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, "hnsw", stats.Type)
+		assert.Equal(t, objects, stats.Nodes)
+		assert.Equal(t, 0, stats.Tombstones)
+		require.NotNil(t, stats.EntryPoint)
+		require.Len(t, stats.Layers, stats.MaxLayer+1)
+		assert.Equal(t, objects, stats.Layers[0].Nodes)
+		assert.NotEmpty(t, stats.CommitLogs)
+		assert.Nil(t, stats.Connectivity)
+	})
+
+	t.Run("an hnsw index with a connectivity check", func(t *testing.T) {
+		stats, err := repo.VectorIndexStats(context.Background(), kind.Thing,
+			schema.ClassName(hnswClass.Class), shardName(hnswClass), true)
+		require.Nil(t, err)
+		require.NotNil(t, stats.Connectivity)
+
+		assert.Equal(t, objects, stats.Connectivity.Reachable)
+		assert.Len(t, stats.Connectivity.Unreachable, 0)
+	})
+
+	t.Run("a flat index", func(t *testing.T) {
+		stats, err := repo.VectorIndexStats(context.Background(), kind.Thing,
+			schema.ClassName(flatClass.Class), shardName(flatClass), true)
+		require.Nil(t, err)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, "flat", stats.Type)
+		assert.Equal(t, objects, stats.Nodes)
+		assert.Len(t, stats.Layers, 0)
+		assert.Nil(t, stats.Connectivity)
+	})
+
+	t.Run("a shard which does not exist", func(t *testing.T) {
+		stats, err := repo.VectorIndexStats(context.Background(), kind.Thing,
+			schema.ClassName(hnswClass.Class), "shard-does-not-exist", false)
+		require.Nil(t, err)
+		assert.Nil(t, stats)
+	})
+
+	t.Run("a class which does not exist", func(t *testing.T) {
+		_, err := repo.VectorIndexStats(context.Background(), kind.Thing,
+			"DoesNotExist", shardName(hnswClass), false)
+		assert.NotNil(t, err)
+	})
+}
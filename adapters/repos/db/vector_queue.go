@@ -0,0 +1,419 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+)
+
+const vectorQueueBatchSize = 100
+
+// the delay before a doc ID whose insert failed is retried doubles with
+// every failed attempt, from the min up to the max backoff
+const (
+	vectorQueueMinBackoff = time.Second
+	vectorQueueMaxBackoff = 5 * time.Minute
+)
+
+// vectorQueue decouples inserting into the vector index from writing the
+// object. Writes only persist the doc ID in the vector queue bucket as part
+// of their transaction, background workers then read the vector from the
+// object and insert it into the vector index. The bucket is the source of
+// truth, so a queue which was not drained on shutdown is picked up again when
+// the shard is loaded.
+//
+// A doc ID is either pending, i.e. waiting for a worker, or in flight, i.e. a
+// worker currently inserts it. Deleting a pending doc ID simply removes it
+// from the queue, as it never reached the vector index. Deleting an in-flight
+// doc ID is deferred until the worker is done, as the vector index must not
+// be asked to delete a node it has not seen yet.
+//
+// If reading or inserting the vector fails, the doc ID stays in the bucket
+// and is retried with an exponential backoff. While it waits for its retry,
+// the doc ID still counts as queued, so that searches keep finding it.
+type vectorQueue struct {
+	shard *Shard
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	sync.Mutex
+	order    []uint32 // FIFO, may contain doc IDs which are no longer pending
+	pending  map[uint32]struct{}
+	inFlight map[uint32]bool        // true if deleted while in flight
+	waiting  map[uint32]*time.Timer // failed doc IDs waiting for their retry
+	failures map[uint32]int         // consecutive failed attempts per doc ID
+
+	notify chan struct{}
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newVectorQueue(shard *Shard) *vectorQueue {
+	return &vectorQueue{
+		shard:      shard,
+		minBackoff: vectorQueueMinBackoff,
+		maxBackoff: vectorQueueMaxBackoff,
+		pending:    map[uint32]struct{}{},
+		inFlight:   map[uint32]bool{},
+		waiting:    map[uint32]*time.Timer{},
+		failures:   map[uint32]int{},
+		notify:     make(chan struct{}, 1),
+	}
+}
+
+// load reads the doc IDs which were queued before the shard was last shut
+// down. It must be called before the workers are started.
+func (q *vectorQueue) load() error {
+	return q.shard.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(helpers.VectorQueueBucket).ForEach(func(k, v []byte) error {
+			docID := binary.BigEndian.Uint32(k)
+			q.order = append(q.order, docID)
+			q.pending[docID] = struct{}{}
+			return nil
+		})
+	})
+}
+
+func (q *vectorQueue) start() {
+	q.stop = make(chan struct{})
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		q.wg.Add(1)
+		go q.work()
+	}
+
+	q.signal()
+}
+
+// shutdown stops the workers after their current batch. Doc IDs which were
+// not indexed yet remain in the bucket. Doc IDs waiting for a retry are
+// pending again, so that they are retried right away once the workers are
+// started again. Stopping workers which are not running is a no-op.
+func (q *vectorQueue) shutdown() {
+	if q.stop == nil {
+		return
+	}
+
+	close(q.stop)
+	q.wg.Wait()
+	q.stop = nil
+
+	q.Lock()
+	for docID, timer := range q.waiting {
+		timer.Stop()
+		delete(q.waiting, docID)
+		q.order = append(q.order, docID)
+		q.pending[docID] = struct{}{}
+	}
+	q.Unlock()
+}
+
+func (q *vectorQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// push queues doc IDs in memory. They must already have been persisted using
+// enqueueInTx.
+func (q *vectorQueue) push(docIDs ...uint32) {
+	q.Lock()
+	for _, docID := range docIDs {
+		q.order = append(q.order, docID)
+		q.pending[docID] = struct{}{}
+	}
+	q.Unlock()
+
+	q.signal()
+}
+
+// remove takes the doc ID out of the queue. It returns false if the doc ID
+// was not queued, in which case it needs to be deleted from the vector index
+// by the caller.
+func (q *vectorQueue) remove(docID uint32) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	if _, ok := q.pending[docID]; ok {
+		delete(q.pending, docID)
+		delete(q.failures, docID)
+		return true
+	}
+
+	if _, ok := q.inFlight[docID]; ok {
+		q.inFlight[docID] = true
+		return true
+	}
+
+	if timer, ok := q.waiting[docID]; ok {
+		timer.Stop()
+		delete(q.waiting, docID)
+		delete(q.failures, docID)
+		return true
+	}
+
+	return false
+}
+
+// length is the number of doc IDs which are not indexed yet
+func (q *vectorQueue) length() int {
+	q.Lock()
+	defer q.Unlock()
+
+	return len(q.pending) + len(q.inFlight) + len(q.waiting)
+}
+
+// failed is the number of queued doc IDs whose last insert into the vector
+// index failed, they are retried with a backoff
+func (q *vectorQueue) failed() int {
+	q.Lock()
+	defer q.Unlock()
+
+	return len(q.failures)
+}
+
+// docIDs returns all doc IDs which are not indexed yet. In-flight doc IDs
+// are included, as their insert might not have completed yet.
+func (q *vectorQueue) docIDs() helpers.AllowList {
+	q.Lock()
+	defer q.Unlock()
+
+	out := make(helpers.AllowList,
+		len(q.pending)+len(q.inFlight)+len(q.waiting))
+	for docID := range q.pending {
+		out.Insert(docID)
+	}
+	for docID := range q.inFlight {
+		out.Insert(docID)
+	}
+	for docID := range q.waiting {
+		out.Insert(docID)
+	}
+
+	return out
+}
+
+func (q *vectorQueue) work() {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-q.notify:
+		}
+
+		for {
+			select {
+			case <-q.stop:
+				return
+			default:
+			}
+
+			batch := q.next()
+			if len(batch) == 0 {
+				break
+			}
+
+			// there might be more work than this worker can take on
+			q.signal()
+			q.index(batch)
+		}
+	}
+}
+
+// next moves up to vectorQueueBatchSize pending doc IDs in flight
+func (q *vectorQueue) next() []uint32 {
+	q.Lock()
+	defer q.Unlock()
+
+	var batch []uint32
+	i := 0
+	for ; i < len(q.order) && len(batch) < vectorQueueBatchSize; i++ {
+		docID := q.order[i]
+		if _, ok := q.pending[docID]; !ok {
+			continue
+		}
+
+		delete(q.pending, docID)
+		q.inFlight[docID] = false
+		batch = append(batch, docID)
+	}
+	q.order = q.order[i:]
+
+	return batch
+}
+
+// index inserts the batch into the vector index and removes it from the
+// bucket. Like any other write it holds the backup lock, otherwise a backup
+// could copy the bucket before and the vector index after the batch was
+// indexed, so that the restored queue would insert it a second time.
+func (q *vectorQueue) index(batch []uint32) {
+	q.shard.index.backupLock.RLock()
+	defer q.shard.index.backupLock.RUnlock()
+
+	logger := q.shard.index.logger.WithField("action", "vector_queue").
+		WithField("shard", q.shard.ID())
+
+	added := make([]bool, len(batch))
+	failed := make([]bool, len(batch))
+	for i, docID := range batch {
+		vector, err := q.shard.vectorByIndexID(context.Background(), int32(docID))
+		if err != nil {
+			var e storobj.ErrNotFound
+			if !errors.As(err, &e) {
+				logger.WithError(err).WithField("docID", docID).
+					Error("read vector of queued object, retrying with backoff")
+				failed[i] = true
+			}
+
+			// otherwise the object was deleted or updated with a new doc ID after
+			// it was queued, there is nothing left to index
+			continue
+		}
+
+		if err := q.shard.vectorIndex.Add(int(docID), vector); err != nil {
+			logger.WithError(err).WithField("docID", docID).
+				Error("insert queued vector into vector index, retrying with backoff")
+			failed[i] = true
+			continue
+		}
+
+		added[i] = true
+	}
+
+	q.Lock()
+	var deleted, done []uint32
+	for i, docID := range batch {
+		deletedInFlight := q.inFlight[docID]
+		delete(q.inFlight, docID)
+
+		if failed[i] && !deletedInFlight {
+			q.retryLater(docID)
+			continue
+		}
+
+		delete(q.failures, docID)
+		if deletedInFlight && added[i] {
+			deleted = append(deleted, docID)
+		}
+		done = append(done, docID)
+	}
+	q.Unlock()
+
+	for _, docID := range deleted {
+		if err := q.shard.vectorIndex.Delete(int(docID)); err != nil {
+			logger.WithError(err).WithField("docID", docID).
+				Error("delete object which was deleted while being indexed")
+		}
+	}
+
+	if len(done) == 0 {
+		return
+	}
+
+	if err := q.shard.db.Batch(func(tx *bolt.Tx) error {
+		b := tx.Bucket(helpers.VectorQueueBucket)
+		for _, docID := range done {
+			if err := b.Delete(vectorQueueKey(docID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		logger.WithError(err).Error("remove indexed doc ids from vector queue")
+	}
+}
+
+// retryLater makes the failed doc ID pending again once its backoff has
+// passed. It must be called with the lock held.
+func (q *vectorQueue) retryLater(docID uint32) {
+	q.failures[docID]++
+
+	var timer *time.Timer
+	timer = time.AfterFunc(q.backoff(q.failures[docID]), func() {
+		q.Lock()
+		if q.waiting[docID] != timer {
+			// removed or shut down in the meantime
+			q.Unlock()
+			return
+		}
+
+		delete(q.waiting, docID)
+		q.order = append(q.order, docID)
+		q.pending[docID] = struct{}{}
+		q.Unlock()
+
+		q.signal()
+	})
+	q.waiting[docID] = timer
+}
+
+// backoff is the delay before the next attempt after the given number of
+// consecutive failed attempts
+func (q *vectorQueue) backoff(attempts int) time.Duration {
+	backoff := q.minBackoff
+	for i := 1; i < attempts && backoff < q.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > q.maxBackoff {
+		return q.maxBackoff
+	}
+
+	return backoff
+}
+
+// enqueueInTx persists the new doc ID of a written object, so that its
+// vector is indexed even if the shard is shut down before the workers got to
+// it. If the doc ID changed, the previous one is no longer of interest.
+func (q *vectorQueue) enqueueInTx(tx *bolt.Tx, status objectInsertStatus) error {
+	if status.isUpdate && !status.docIDChanged {
+		return nil
+	}
+
+	b := tx.Bucket(helpers.VectorQueueBucket)
+	if status.docIDChanged {
+		if err := b.Delete(vectorQueueKey(status.oldDocID)); err != nil {
+			return errors.Wrapf(err, "remove doc id %d from vector queue", status.oldDocID)
+		}
+	}
+
+	if err := b.Put(vectorQueueKey(status.docID), []byte{}); err != nil {
+		return errors.Wrapf(err, "add doc id %d to vector queue", status.docID)
+	}
+
+	return nil
+}
+
+// dequeueInTx removes the doc ID of a deleted object from the bucket
+func (q *vectorQueue) dequeueInTx(tx *bolt.Tx, docID uint32) error {
+	return tx.Bucket(helpers.VectorQueueBucket).Delete(vectorQueueKey(docID))
+}
+
+// big endian keys make the bucket iterate in the order the doc IDs were
+// assigned, so a loaded queue is still processed first in, first out
+func vectorQueueKey(docID uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, docID)
+	return key
+}
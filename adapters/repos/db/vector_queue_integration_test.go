@@ -0,0 +1,429 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/shards"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncVectorIndexing(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:      "AsyncVectorIndexing",
+		ShardCount: 2,
+		VectorIndexConfig: &models.VectorIndexConfig{
+			Distance:      "l2-squared",
+			AsyncIndexing: true,
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "even",
+				DataType: []string{string(schema.DataTypeBoolean)},
+			},
+		},
+	}
+	require.Nil(t,
+		migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	type object struct {
+		id     strfmt.UUID
+		even   bool
+		vector []float32
+	}
+
+	var objects []object
+	deleted := map[strfmt.UUID]struct{}{}
+	put := func(repo *DB, count int) {
+		for i := 0; i < count; i++ {
+			obj := object{
+				id:     strfmt.UUID(uuid.New().String()),
+				even:   len(objects)%2 == 0,
+				vector: []float32{rand.Float32(), rand.Float32()},
+			}
+			objects = append(objects, obj)
+
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class:  class.Class,
+				ID:     obj.id,
+				Schema: map[string]interface{}{"even": obj.even},
+			}, obj.vector)
+			require.Nil(t, err)
+		}
+	}
+
+	query := []float32{0.5, 0.5}
+	expected := func(onlyEven bool, k int) []strfmt.UUID {
+		var candidates []object
+		for _, obj := range objects {
+			if _, ok := deleted[obj.id]; ok {
+				continue
+			}
+			if onlyEven && !obj.even {
+				continue
+			}
+			candidates = append(candidates, obj)
+		}
+
+		dist := func(v []float32) float32 {
+			dx, dy := v[0]-query[0], v[1]-query[1]
+			return dx*dx + dy*dy
+		}
+		sort.Slice(candidates, func(a, b int) bool {
+			return dist(candidates[a].vector) < dist(candidates[b].vector)
+		})
+
+		var out []strfmt.UUID
+		for i := 0; i < k && i < len(candidates); i++ {
+			out = append(out, candidates[i].id)
+		}
+		return out
+	}
+
+	evenFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "even",
+			},
+			Value: &filters.Value{
+				Value: true,
+				Type:  schema.DataTypeBoolean,
+			},
+		},
+	}
+
+	search := func(repo *DB, filter *filters.LocalFilter, k int) []strfmt.UUID {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: k},
+			SearchVector: query,
+			Filters:      filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	shards := func(repo *DB) map[string]*Shard {
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		return idx.Shards
+	}
+
+	queueLength := func(repo *DB) int {
+		status, err := repo.ShardsStatus(context.Background(), kind.Thing,
+			schema.ClassName(class.Class))
+		require.Nil(t, err)
+		require.Len(t, status, 2)
+
+		length := 0
+		for _, shard := range status {
+			length += shard.VectorQueueLength
+		}
+		return length
+	}
+
+	waitForEmptyQueue := func(repo *DB) {
+		deadline := time.Now().Add(2 * time.Minute)
+		for queueLength(repo) > 0 {
+			require.True(t, time.Now().Before(deadline),
+				"vector queue not drained in time")
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	t.Run("with the workers paused, vectors stay queued", func(t *testing.T) {
+		for _, shard := range shards(repo) {
+			shard.vectorQueue.shutdown()
+		}
+
+		put(repo, 2400)
+		assert.Equal(t, 2400, queueLength(repo))
+	})
+
+	t.Run("queued objects are found by vector search", func(t *testing.T) {
+		assert.Equal(t, expected(false, 10), search(repo, nil, 10))
+	})
+
+	t.Run("queued objects are found by filtered vector search", func(t *testing.T) {
+		assert.Equal(t, expected(true, 10), search(repo, evenFilter, 10))
+	})
+
+	t.Run("deleting queued objects removes them from the queue", func(t *testing.T) {
+		for _, id := range expected(false, 5) {
+			require.Nil(t, repo.DeleteThing(context.Background(), class.Class, id))
+			deleted[id] = struct{}{}
+		}
+
+		assert.Equal(t, 2395, queueLength(repo))
+		assert.Equal(t, expected(false, 10), search(repo, nil, 10))
+	})
+
+	t.Run("once the workers run, the queue is drained", func(t *testing.T) {
+		for _, shard := range shards(repo) {
+			shard.vectorQueue.start()
+		}
+
+		waitForEmptyQueue(repo)
+		ids := search(repo, nil, 10)
+		require.Len(t, ids, 10)
+		assert.Equal(t, expected(false, 1), ids[:1])
+	})
+
+	t.Run("results combine the graph and the queue", func(t *testing.T) {
+		for _, shard := range shards(repo) {
+			shard.vectorQueue.shutdown()
+		}
+
+		put(repo, 400)
+		assert.Equal(t, 400, queueLength(repo))
+
+		ids := search(repo, nil, 10)
+		require.Len(t, ids, 10)
+		assert.Equal(t, expected(false, 1), ids[:1])
+		assert.Equal(t, expected(true, 10), search(repo, evenFilter, 10))
+	})
+
+	t.Run("the queue is persisted across restarts", func(t *testing.T) {
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.Nil(t, idx.shutdown())
+
+		restarted := New(logger, Config{RootPath: dirName})
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(30*time.Second))
+
+		waitForEmptyQueue(restarted)
+		ids := search(restarted, nil, 10)
+		require.Len(t, ids, 10)
+		assert.Equal(t, expected(false, 1), ids[:1])
+		assert.Equal(t, expected(true, 10), search(restarted, evenFilter, 10))
+	})
+}
+
+// failingVectorIndex rejects every insert until it is healed
+type failingVectorIndex struct {
+	VectorIndex
+
+	sync.Mutex
+	healed bool
+}
+
+func (f *failingVectorIndex) Add(id int, vector []float32) error {
+	f.Lock()
+	healed := f.healed
+	f.Unlock()
+
+	if !healed {
+		return errors.New("vector index is failing")
+	}
+
+	return f.VectorIndex.Add(id, vector)
+}
+
+func (f *failingVectorIndex) heal() {
+	f.Lock()
+	f.healed = true
+	f.Unlock()
+}
+
+func TestAsyncVectorIndexingRetriesFailedInserts(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:      "AsyncVectorIndexingFailures",
+		ShardCount: 1,
+		VectorIndexConfig: &models.VectorIndexConfig{
+			Distance:      "l2-squared",
+			AsyncIndexing: true,
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	require.Nil(t,
+		migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	require.Len(t, idx.Shards, 1)
+	var shard *Shard
+	for _, s := range idx.Shards {
+		shard = s
+	}
+
+	status := func() shards.Status {
+		res, err := repo.ShardsStatus(context.Background(), kind.Thing,
+			schema.ClassName(class.Class))
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		return res[0]
+	}
+
+	waitFor := func(condition func(shards.Status) bool) {
+		deadline := time.Now().Add(30 * time.Second)
+		for !condition(status()) {
+			require.True(t, time.Now().Before(deadline),
+				"condition not met in time, status %#v", status())
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	persistedLength := func() int {
+		length := 0
+		require.Nil(t, shard.db.View(func(tx *bolt.Tx) error {
+			length = tx.Bucket(helpers.VectorQueueBucket).Stats().KeyN
+			return nil
+		}))
+		return length
+	}
+
+	failing := &failingVectorIndex{VectorIndex: shard.vectorIndex}
+	var ids []strfmt.UUID
+
+	t.Run("with a failing vector index, the inserts fail", func(t *testing.T) {
+		shard.vectorQueue.shutdown()
+		shard.vectorIndex = failing
+		shard.vectorQueue.minBackoff = 10 * time.Millisecond
+		shard.vectorQueue.maxBackoff = 50 * time.Millisecond
+
+		for i := 0; i < 10; i++ {
+			id := strfmt.UUID(uuid.New().String())
+			ids = append(ids, id)
+			require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+				Class:  class.Class,
+				ID:     id,
+				Schema: map[string]interface{}{"name": "failing"},
+			}, []float32{float32(i), 1}))
+		}
+
+		shard.vectorQueue.start()
+		waitFor(func(s shards.Status) bool { return s.VectorQueueFailures == 10 })
+	})
+
+	t.Run("the failed vectors stay queued", func(t *testing.T) {
+		assert.Equal(t, 10, status().VectorQueueLength)
+		assert.Equal(t, 10, persistedLength())
+	})
+
+	t.Run("the failed vectors are still found by vector search", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 1},
+			SearchVector: []float32{0, 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[0], res[0].ID)
+	})
+
+	t.Run("deleting a failed object removes it from the queue", func(t *testing.T) {
+		require.Nil(t, repo.DeleteThing(context.Background(), class.Class, ids[9]))
+		ids = ids[:9]
+
+		assert.Equal(t, shards.Status{
+			Name:                shard.name,
+			VectorQueueLength:   9,
+			VectorQueueFailures: 9,
+		}, status())
+		assert.Equal(t, 9, persistedLength())
+	})
+
+	t.Run("once the vector index recovers, the retries succeed", func(t *testing.T) {
+		failing.heal()
+
+		// the indexed doc ids are removed from the bucket right after they
+		// leave the in-memory queue
+		waitFor(func(s shards.Status) bool {
+			return s.VectorQueueLength == 0 && s.VectorQueueFailures == 0 &&
+				persistedLength() == 0
+		})
+
+		res, err := failing.VectorIndex.SearchByVector([]float32{0, 1}, 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 9)
+	})
+
+	require.Nil(t, idx.shutdown())
+}
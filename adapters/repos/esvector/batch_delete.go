@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// BatchDeleteObjects deletes all objects of the class which match the filters
+// with a delete-by-query. As the delete-by-query only reports failures, the
+// ids of the matching objects are listed with a scroll search first, so that
+// every matched object can be reported. With DryRun set, the matching objects
+// are only listed.
+func (r *Repo) BatchDeleteObjects(ctx context.Context,
+	params kinds.BatchDeleteParams) (kinds.BatchDeleteResult, error) {
+	query, err := r.queryFromFilter(ctx, params.Filters)
+	if err != nil {
+		if _, ok := err.(SubQueryNoResultsErr); ok {
+			// a sub-query error'd with no results, so there is nothing to delete
+			return kinds.BatchDeleteResult{}, nil
+		}
+		return kinds.BatchDeleteResult{}, fmt.Errorf("batch delete: build filter: %v", err)
+	}
+
+	index := classIndexFromClassName(params.Kind, params.ClassName.String())
+	ids, err := r.scrollIDs(ctx, index, query)
+	if err != nil {
+		return kinds.BatchDeleteResult{}, fmt.Errorf("batch delete: list matches: %v", err)
+	}
+
+	objects := make(kinds.BatchDeleteObjects, len(ids))
+	for i, id := range ids {
+		objects[i] = kinds.BatchDeleteObject{UUID: id}
+	}
+
+	if !params.DryRun && len(ids) > 0 {
+		failures, err := r.deleteByQuery(ctx, index, query)
+		if err != nil {
+			return kinds.BatchDeleteResult{}, fmt.Errorf("batch delete: %v", err)
+		}
+
+		for i := range objects {
+			objects[i].Err = failures[objects[i].UUID]
+		}
+	}
+
+	return kinds.BatchDeleteResult{
+		Matches: int64(len(ids)),
+		Objects: objects,
+	}, nil
+}
+
+type deleteByQueryResponse struct {
+	Deleted  int `json:"deleted"`
+	Failures []struct {
+		ID    string      `json:"id"`
+		Cause interface{} `json:"cause"`
+	} `json:"failures"`
+}
+
+// deleteByQuery returns the errors of all documents which could not be
+// deleted
+func (r *Repo) deleteByQuery(ctx context.Context, index string,
+	query map[string]interface{}) (map[strfmt.UUID]error, error) {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"query": query,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode json: %v", err)
+	}
+
+	refresh := true
+	req := esapi.DeleteByQueryRequest{
+		Index: []string{index},
+		Body:  &buf,
+		// objects which are altered while the deletion is running are not
+		// deleted, but reported as a failure, rather than aborting the request
+		Conflicts: "proceed",
+		Refresh:   &refresh,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("delete by query request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("delete by query: %v", err)
+	}
+
+	var parsed deleteByQueryResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("delete by query: decode json: %v", err)
+	}
+
+	failures := map[strfmt.UUID]error{}
+	for _, failure := range parsed.Failures {
+		failures[strfmt.UUID(failure.ID)] = fmt.Errorf("%v", failure.Cause)
+	}
+
+	return failures, nil
+}
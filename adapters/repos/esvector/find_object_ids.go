@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+const (
+	scrollIDsSize = 1000
+	scrollIDsTTL  = time.Minute
+)
+
+// FindObjectIDs lists the ids of all objects of the class which match the
+// filters. Other than a class search it is not limited by the max result
+// window.
+func (r *Repo) FindObjectIDs(ctx context.Context, k kind.Kind,
+	className schema.ClassName, filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	query, err := r.queryFromFilter(ctx, filters)
+	if err != nil {
+		if _, ok := err.(SubQueryNoResultsErr); ok {
+			// a sub-query error'd with no results, so nothing can match
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find object ids: build filter: %v", err)
+	}
+
+	index := classIndexFromClassName(k, className.String())
+	ids, err := r.scrollIDs(ctx, index, query)
+	if err != nil {
+		return nil, fmt.Errorf("find object ids: %v", err)
+	}
+
+	return ids, nil
+}
+
+type scrollIDsResponse struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []struct {
+			ID string `json:"_id"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// scrollIDs lists the ids of all documents matching the query ordered by id.
+// Other than a regular search it is not limited by the max result window.
+func (r *Repo) scrollIDs(ctx context.Context, index string,
+	query map[string]interface{}) ([]strfmt.UUID, error) {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"query":   query,
+		"size":    scrollIDsSize,
+		"_source": false,
+		"sort":    []string{"_doc"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(index),
+		r.client.Search.WithBody(&buf),
+		r.client.Search.WithScroll(scrollIDsTTL),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("scroll search: %v", err)
+	}
+
+	var ids []strfmt.UUID
+	for {
+		page, err := r.scrollIDsPage(res)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hit := range page.Hits.Hits {
+			ids = append(ids, strfmt.UUID(hit.ID))
+		}
+
+		if len(page.Hits.Hits) == 0 {
+			r.clearScroll(page.ScrollID)
+			break
+		}
+
+		res, err = r.client.Scroll(
+			r.client.Scroll.WithContext(ctx),
+			r.client.Scroll.WithScrollID(page.ScrollID),
+			r.client.Scroll.WithScroll(scrollIDsTTL),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scroll: %v", err)
+		}
+	}
+
+	sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+	return ids, nil
+}
+
+func (r *Repo) scrollIDsPage(res *esapi.Response) (scrollIDsResponse, error) {
+	defer res.Body.Close()
+	if err := errorResToErr(res, r.logger); err != nil {
+		return scrollIDsResponse{}, fmt.Errorf("scroll: %v", err)
+	}
+
+	var page scrollIDsResponse
+	if err := json.NewDecoder(res.Body).Decode(&page); err != nil {
+		return scrollIDsResponse{}, fmt.Errorf("scroll: decode json: %v", err)
+	}
+
+	return page, nil
+}
+
+// clearScroll frees the search context early, it would otherwise be kept
+// until the scroll ttl has passed. There is no need to fail the entire
+// request if this does not succeed.
+func (r *Repo) clearScroll(scrollID string) {
+	if scrollID == "" {
+		return
+	}
+
+	res, err := r.client.ClearScroll(r.client.ClearScroll.WithScrollID(scrollID))
+	if err != nil {
+		r.logger.WithField("action", "esvector_clear_scroll").
+			WithError(err).Warn("could not clear scroll")
+		return
+	}
+	res.Body.Close()
+}
@@ -70,6 +70,12 @@ func (m *Migrator) UpdateClass(ctx context.Context, kind kind.Kind, className st
 	return nil
 }
 
+// UpdateVectorIndexConfig has no effect, as the vector index settings only
+// apply to the standalone db
+func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context, kind kind.Kind, className string, updated *models.VectorIndexConfig) error {
+	return nil
+}
+
 // AddProperty adds the new property without affecting existing properties
 func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
 	// put mappings does not delete existing properties, so we can use it to add
@@ -19,6 +19,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	libschema "github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
@@ -50,11 +51,11 @@ func (r *NoOpRepo) ActionByID(ctx context.Context, id strfmt.UUID, props travers
 	panic("no op repo: not implemented")
 }
 
-func (r *NoOpRepo) ThingSearch(ctx context.Context, limit int, filters *filters.LocalFilter) (search.Results, error) {
+func (r *NoOpRepo) ThingSearch(ctx context.Context, pagination *filters.Pagination, sort []filters.Sort, filters *filters.LocalFilter) (search.Results, error) {
 	panic("no op repo: not implemented")
 }
 
-func (r *NoOpRepo) ActionSearch(ctx context.Context, limit int, filters *filters.LocalFilter) (search.Results, error) {
+func (r *NoOpRepo) ActionSearch(ctx context.Context, pagination *filters.Pagination, sort []filters.Sort, filters *filters.LocalFilter) (search.Results, error) {
 	panic("no op repo: not implemented")
 }
 
@@ -92,6 +93,15 @@ func (r *NoOpRepo) DeleteAction(ctx context.Context, className string, id strfmt
 	return nil
 }
 
+func (r *NoOpRepo) BatchDeleteObjects(ctx context.Context, params kinds.BatchDeleteParams) (kinds.BatchDeleteResult, error) {
+	return kinds.BatchDeleteResult{}, nil
+}
+
+func (r *NoOpRepo) FindObjectIDs(ctx context.Context, k kind.Kind, className libschema.ClassName,
+	filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	return nil, nil
+}
+
 func (r *NoOpRepo) SetSchemaGetter(sg schema.SchemaGetter) {
 }
 
@@ -31,17 +31,23 @@ import (
 )
 
 // ThingSearch searches for all things with optional filters without vector scoring
-func (r *Repo) ThingSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, underscore traverser.UnderscoreProperties) (search.Results, error) {
-	return r.search(ctx, allThingIndices, nil, limit, filters, traverser.GetParams{
+func (r *Repo) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties) (search.Results, error) {
+	return r.search(ctx, allThingIndices, nil, pagination.Limit, filters, traverser.GetParams{
+		Pagination:           pagination,
+		Sort:                 sort,
 		UnderscoreProperties: underscore,
 	})
 }
 
 // ActionSearch searches for all things with optional filters without vector scoring
-func (r *Repo) ActionSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, underscore traverser.UnderscoreProperties) (search.Results, error) {
-	return r.search(ctx, allActionIndices, nil, limit, filters, traverser.GetParams{
+func (r *Repo) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties) (search.Results, error) {
+	return r.search(ctx, allActionIndices, nil, pagination.Limit, filters, traverser.GetParams{
+		Pagination:           pagination,
+		Sort:                 sort,
 		UnderscoreProperties: underscore,
 	})
 }
@@ -222,7 +228,11 @@ func (r *Repo) search(ctx context.Context, index string,
 		return nil, err
 	}
 
-	body := r.buildSearchBody(query, vector, limit)
+	if params.KeywordRanking != nil {
+		query = keywordRankingQuery(query, params.KeywordRanking)
+	}
+
+	body := r.buildSearchBody(query, vector, limit, params.Pagination, params.Sort)
 
 	err = json.NewEncoder(&buf).Encode(body)
 	if err != nil {
@@ -241,9 +251,27 @@ func (r *Repo) search(ctx context.Context, index string,
 	return r.searchResponse(ctx, res, params.Properties, params.UnderscoreProperties)
 }
 
-func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []float32, limit int) map[string]interface{} {
+func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []float32, limit int,
+	pagination *filters.Pagination, sort []filters.Sort) map[string]interface{} {
 	var query map[string]interface{}
 
+	if pagination != nil && pagination.After != "" {
+		filterQuery = map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": []interface{}{
+					filterQuery,
+					map[string]interface{}{
+						"range": map[string]interface{}{
+							keyID.String(): map[string]interface{}{
+								"gt": pagination.After,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
 	if vector == nil {
 		query = filterQuery
 	} else {
@@ -270,10 +298,81 @@ func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []floa
 		}
 	}
 
-	return map[string]interface{}{
+	body := map[string]interface{}{
 		"query": query,
 		"size":  limit,
 	}
+
+	if len(sort) > 0 {
+		body["sort"] = sortBody(sort, vector != nil)
+	}
+
+	if pagination == nil {
+		return body
+	}
+
+	if pagination.Offset > 0 {
+		body["from"] = pagination.Offset
+	}
+
+	if pagination.After != "" {
+		// a cursor is only meaningful if the results are ordered by id
+		body["sort"] = []interface{}{
+			map[string]interface{}{
+				keyID.String(): "asc",
+			},
+		}
+	}
+
+	return body
+}
+
+// keywordRankingQuery scores the results by the relevance of their text
+// fields to the keyword query. The similarity of the text fields is BM25, the
+// filter query is only used to narrow down the results, it does not affect
+// the score.
+func keywordRankingQuery(filterQuery map[string]interface{},
+	kr *traverser.KeywordRankingParams) map[string]interface{} {
+	match := map[string]interface{}{
+		"query":   kr.Query,
+		"lenient": true,
+	}
+
+	if len(kr.Properties) > 0 {
+		match["fields"] = kr.Properties
+	}
+
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": map[string]interface{}{
+				"multi_match": match,
+			},
+			"filter": filterQuery,
+		},
+	}
+}
+
+// sortBody orders the results by the specified properties. On a vector
+// search the score is kept as the primary order and the properties are only
+// used to break ties.
+func sortBody(sort []filters.Sort, vectorSearch bool) []interface{} {
+	var out []interface{}
+	if vectorSearch {
+		out = append(out, map[string]interface{}{
+			"_score": "desc",
+		})
+	}
+
+	for _, s := range sort {
+		out = append(out, map[string]interface{}{
+			s.Property(): map[string]interface{}{
+				"order":   s.Order,
+				"missing": "_last",
+			},
+		})
+	}
+
+	return out
 }
 
 type searchResponse struct {
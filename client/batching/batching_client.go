@@ -40,10 +40,18 @@ type Client struct {
 type ClientService interface {
 	BatchingActionsCreate(params *BatchingActionsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsCreateOK, error)
 
+	BatchingActionsDelete(params *BatchingActionsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsDeleteOK, error)
+
+	BatchingActionsMerge(params *BatchingActionsMergeParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsMergeOK, error)
+
 	BatchingReferencesCreate(params *BatchingReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesCreateOK, error)
 
 	BatchingThingsCreate(params *BatchingThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsCreateOK, error)
 
+	BatchingThingsDelete(params *BatchingThingsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsDeleteOK, error)
+
+	BatchingThingsMerge(params *BatchingThingsMergeParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsMergeOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -84,6 +92,80 @@ func (a *Client) BatchingActionsCreate(params *BatchingActionsCreateParams, auth
 	panic(msg)
 }
 
+/*
+  BatchingActionsDelete deletes actions based on a match filter as a batch
+
+  Delete Actions in bulk that match a certain filter.
+*/
+func (a *Client) BatchingActionsDelete(params *BatchingActionsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingActionsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.actions.delete",
+		Method:             "DELETE",
+		PathPattern:        "/batching/actions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingActionsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingActionsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.actions.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  BatchingActionsMerge merges properties into Actions based on a match filter as a batch
+
+  Merge the same properties into all Actions that match a certain filter. Vectors are recomputed if a vectorized property changes.
+*/
+func (a *Client) BatchingActionsMerge(params *BatchingActionsMergeParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsMergeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingActionsMergeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.actions.merge",
+		Method:             "PATCH",
+		PathPattern:        "/batching/actions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingActionsMergeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingActionsMergeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.actions.merge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   BatchingReferencesCreate creates new cross references between arbitrary classes in bulk
 
@@ -158,6 +240,80 @@ func (a *Client) BatchingThingsCreate(params *BatchingThingsCreateParams, authIn
 	panic(msg)
 }
 
+/*
+  BatchingThingsDelete deletes things based on a match filter as a batch
+
+  Delete Things in bulk that match a certain filter.
+*/
+func (a *Client) BatchingThingsDelete(params *BatchingThingsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingThingsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.things.delete",
+		Method:             "DELETE",
+		PathPattern:        "/batching/things",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingThingsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingThingsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.things.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  BatchingThingsMerge merges properties into Things based on a match filter as a batch
+
+  Merge the same properties into all Things that match a certain filter. Vectors are recomputed if a vectorized property changes.
+*/
+func (a *Client) BatchingThingsMerge(params *BatchingThingsMergeParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsMergeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingThingsMergeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.things.merge",
+		Method:             "PATCH",
+		PathPattern:        "/batching/things",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingThingsMergeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingThingsMergeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.things.merge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -44,6 +44,10 @@ type ClientService interface {
 
 	SchemaActionsPropertiesAdd(params *SchemaActionsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsPropertiesAddOK, error)
 
+	SchemaActionsShardsGet(params *SchemaActionsShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsShardsGetOK, error)
+
+	SchemaActionsShardsVectorIndexGet(params *SchemaActionsShardsVectorIndexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsShardsVectorIndexGetOK, error)
+
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaDumpOK, error)
 
 	SchemaThingsCreate(params *SchemaThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsCreateOK, error)
@@ -52,6 +56,10 @@ type ClientService interface {
 
 	SchemaThingsPropertiesAdd(params *SchemaThingsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsPropertiesAddOK, error)
 
+	SchemaThingsShardsGet(params *SchemaThingsShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsShardsGetOK, error)
+
+	SchemaThingsShardsVectorIndexGet(params *SchemaThingsShardsVectorIndexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsShardsVectorIndexGetOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -160,6 +168,80 @@ func (a *Client) SchemaActionsPropertiesAdd(params *SchemaActionsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+  SchemaActionsShardsGet get the status of the shards of an Action class
+
+  Lists the shards of an Action class together with their status, such as the number of vectors which are queued for asynchronous indexing. Only available in standalone mode.
+*/
+func (a *Client) SchemaActionsShardsGet(params *SchemaActionsShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsShardsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsShardsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.shards.get",
+		Method:             "GET",
+		PathPattern:        "/schema/actions/{className}/shards",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsShardsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsShardsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaActionsShardsVectorIndexGet get diagnostics of the vector index of a shard of an Action class
+
+  Reports diagnostics of the vector index of a single shard of an Action class, such as the number of nodes, tombstones and the average degree per layer of the graph. Optionally checks whether every node can be reached from the entrypoint. Only available in standalone mode.
+*/
+func (a *Client) SchemaActionsShardsVectorIndexGet(params *SchemaActionsShardsVectorIndexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsShardsVectorIndexGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsShardsVectorIndexGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.shards.vectorIndex.get",
+		Method:             "GET",
+		PathPattern:        "/schema/actions/{className}/shards/{shardName}/vectorIndex",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsShardsVectorIndexGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsShardsVectorIndexGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.shards.vectorIndex.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaDump dumps the current the database schema
 */
@@ -300,6 +382,80 @@ func (a *Client) SchemaThingsPropertiesAdd(params *SchemaThingsPropertiesAddPara
 	panic(msg)
 }
 
+/*
+  SchemaThingsShardsGet get the status of the shards of a Thing class
+
+  Lists the shards of a Thing class together with their status, such as the number of vectors which are queued for asynchronous indexing. Only available in standalone mode.
+*/
+func (a *Client) SchemaThingsShardsGet(params *SchemaThingsShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsShardsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsShardsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.shards.get",
+		Method:             "GET",
+		PathPattern:        "/schema/things/{className}/shards",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsShardsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsShardsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaThingsShardsVectorIndexGet get diagnostics of the vector index of a shard of a Thing class
+
+  Reports diagnostics of the vector index of a single shard of a Thing class, such as the number of nodes, tombstones and the average degree per layer of the graph. Optionally checks whether every node can be reached from the entrypoint. Only available in standalone mode.
+*/
+func (a *Client) SchemaThingsShardsVectorIndexGet(params *SchemaThingsShardsVectorIndexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsShardsVectorIndexGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsShardsVectorIndexGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.shards.vectorIndex.get",
+		Method:             "GET",
+		PathPattern:        "/schema/things/{className}/shards/{shardName}/vectorIndex",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsShardsVectorIndexGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsShardsVectorIndexGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.shards.vectorIndex.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -72,6 +72,11 @@ for the things list operation typically these are written to a http.Request
 */
 type ThingsListParams struct {
 
+	/*After
+	  Only return items with an id greater than the specified one. Items are ordered by their id, so the id of the last item of a page can be used as a cursor for the next page. Cannot be combined with offset.
+
+	*/
+	After *strfmt.UUID
 	/*Include
 	  Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation
 
@@ -87,6 +92,21 @@ type ThingsListParams struct {
 
 	*/
 	Meta *bool
+	/*Offset
+	  The number of items to skip before the first returned item. Defaults to 0.
+
+	*/
+	Offset *int64
+	/*Order
+	  Order of the sort, either 'asc' or 'desc', separated by commas with one entry per property of the sort parameter. Defaults to 'asc'.
+
+	*/
+	Order *string
+	/*Sort
+	  Name(s) of the properties to sort the items by, separated by commas, e.g. 'price,name'. Subsequent properties are only used to break ties. Cannot be combined with after.
+
+	*/
+	Sort *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -126,6 +146,17 @@ func (o *ThingsListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAfter adds the after to the things list params
+func (o *ThingsListParams) WithAfter(after *strfmt.UUID) *ThingsListParams {
+	o.SetAfter(after)
+	return o
+}
+
+// SetAfter adds the after to the things list params
+func (o *ThingsListParams) SetAfter(after *strfmt.UUID) {
+	o.After = after
+}
+
 // WithInclude adds the include to the things list params
 func (o *ThingsListParams) WithInclude(include *string) *ThingsListParams {
 	o.SetInclude(include)
@@ -159,6 +190,39 @@ func (o *ThingsListParams) SetMeta(meta *bool) {
 	o.Meta = meta
 }
 
+// WithOffset adds the offset to the things list params
+func (o *ThingsListParams) WithOffset(offset *int64) *ThingsListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the things list params
+func (o *ThingsListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrder adds the order to the things list params
+func (o *ThingsListParams) WithOrder(order *string) *ThingsListParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the things list params
+func (o *ThingsListParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSort adds the sort to the things list params
+func (o *ThingsListParams) WithSort(sort *string) *ThingsListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the things list params
+func (o *ThingsListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -167,6 +231,22 @@ func (o *ThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.After != nil {
+
+		// query param after
+		var qrAfter strfmt.UUID
+		if o.After != nil {
+			qrAfter = *o.After
+		}
+		qAfter := qrAfter.String()
+		if qAfter != "" {
+			if err := r.SetQueryParam("after", qAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Include != nil {
 
 		// query param include
@@ -215,6 +295,54 @@ func (o *ThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 
 	}
 
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/semi-technologies/weaviate/client/actions"
+	"github.com/semi-technologies/weaviate/client/backups"
 	"github.com/semi-technologies/weaviate/client/batching"
 	"github.com/semi-technologies/weaviate/client/classifications"
 	"github.com/semi-technologies/weaviate/client/contextionary_api"
@@ -76,6 +77,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 	cli := new(Weaviate)
 	cli.Transport = transport
 	cli.Actions = actions.New(transport, formats)
+	cli.Backups = backups.New(transport, formats)
 	cli.Batching = batching.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
 	cli.ContextionaryAPI = contextionary_api.New(transport, formats)
@@ -131,6 +133,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type Weaviate struct {
 	Actions actions.ClientService
 
+	Backups backups.ClientService
+
 	Batching batching.ClientService
 
 	Classifications classifications.ClientService
@@ -156,6 +160,7 @@ type Weaviate struct {
 func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 	c.Actions.SetTransport(transport)
+	c.Backups.SetTransport(transport)
 	c.Batching.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
 	c.ContextionaryAPI.SetTransport(transport)
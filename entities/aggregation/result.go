@@ -11,6 +11,8 @@
 
 package aggregation
 
+import "time"
+
 type Result struct {
 	Groups []Group
 }
@@ -26,6 +28,7 @@ type Property struct {
 	NumericalAggregations map[string]float64
 	TextAggregation       Text
 	BooleanAggregation    Boolean
+	DateAggregation       Date
 	SchemaType            string
 	ReferenceAggregation  Reference
 }
@@ -42,6 +45,7 @@ const (
 	PropertyTypeBoolean   PropertyType = "boolean"
 	PropertyTypeText      PropertyType = "text"
 	PropertyTypeReference PropertyType = "cref"
+	PropertyTypeDate      PropertyType = "date"
 )
 
 type GroupedBy struct {
@@ -65,3 +69,16 @@ type Boolean struct {
 type Reference struct {
 	PointingTo []string
 }
+
+type Date struct {
+	Count     int
+	Minimum   time.Time
+	Maximum   time.Time
+	Histogram []DateHistogramBucket
+}
+
+// DateHistogramBucket counts the dates in the interval starting at Start
+type DateHistogramBucket struct {
+	Start time.Time
+	Count int
+}
@@ -11,21 +11,73 @@
 
 package filters
 
-// Pagination for now only contains a limit parameter, but might be extended in
-// the future
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// LimitFlagNotSet indicates that the user has not specified a limit, so the
+// caller should fall back to its default
+const LimitFlagNotSet = -1
+
+// Pagination selects a single page of results. A page can either be
+// selected by an Offset or by a cursor: If After is set, only objects with an
+// id greater than After are returned. As results are ordered by id in this
+// case, the id of the last object of a page can be used as the cursor for the
+// next page.
 type Pagination struct {
-	Limit int
+	Offset int
+	Limit  int
+	After  strfmt.UUID
+}
+
+// Validate makes sure that at most one way of paging is used
+func (p *Pagination) Validate() error {
+	if p.Offset < 0 {
+		return fmt.Errorf("offset cannot be negative, got %d", p.Offset)
+	}
+
+	if p.After != "" && p.Offset > 0 {
+		return fmt.Errorf("parameters 'after' and 'offset' cannot be combined")
+	}
+
+	return nil
 }
 
-// ExtractPaginationFromArgs gets the limit key out of a map. Not specific to
-// GQL, but can be used from GQL
+// ExtractPaginationFromArgs gets the limit, offset and after keys out of a
+// map. Not specific to GQL, but can be used from GQL
 func ExtractPaginationFromArgs(args map[string]interface{}) (*Pagination, error) {
-	limit, ok := args["limit"]
-	if !ok {
+	limit, limitOk := args["limit"]
+	offset, offsetOk := args["offset"]
+	after, afterOk := args["after"]
+	if !limitOk && !offsetOk && !afterOk {
 		return nil, nil
 	}
 
-	return &Pagination{
-		Limit: limit.(int),
-	}, nil
+	p := &Pagination{
+		Limit: LimitFlagNotSet,
+	}
+
+	if limitOk {
+		p.Limit = limit.(int)
+	}
+
+	if offsetOk {
+		p.Offset = offset.(int)
+	}
+
+	if afterOk {
+		id := after.(string)
+		if !strfmt.IsUUID(id) {
+			return nil, fmt.Errorf("after: '%s' is not a valid uuid", id)
+		}
+		p.After = strfmt.UUID(id)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
 }
@@ -34,3 +34,48 @@ func TestExtractPagination(t *testing.T) {
 		assert.Equal(t, 25, p.Limit)
 	})
 }
+
+func TestExtractPaginationWithOffsetAndCursor(t *testing.T) {
+	t.Run("with only an offset present", func(t *testing.T) {
+		p, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"offset": 10,
+		})
+		require.Nil(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, 10, p.Offset)
+		assert.Equal(t, LimitFlagNotSet, p.Limit)
+	})
+
+	t.Run("with a limit and a cursor present", func(t *testing.T) {
+		p, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"limit": 25,
+			"after": "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11",
+		})
+		require.Nil(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, 25, p.Limit)
+		assert.Equal(t, "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11", p.After.String())
+	})
+
+	t.Run("with an invalid cursor", func(t *testing.T) {
+		_, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"after": "not-a-uuid",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with both an offset and a cursor", func(t *testing.T) {
+		_, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"offset": 10,
+			"after":  "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a negative offset", func(t *testing.T) {
+		_, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"offset": -1,
+		})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// SortOrderAsc orders the results from the smallest to the largest value
+	SortOrderAsc = "asc"
+	// SortOrderDesc orders the results from the largest to the smallest value
+	SortOrderDesc = "desc"
+)
+
+// Sort orders the results by the value of the property at Path. If more than
+// one Sort is specified, the subsequent ones are used to break ties.
+type Sort struct {
+	Path  []string
+	Order string
+}
+
+// Property is the name of the property the results are sorted by
+func (s Sort) Property() string {
+	return s.Path[0]
+}
+
+// Desc is true if the largest value should come first
+func (s Sort) Desc() bool {
+	return s.Order == SortOrderDesc
+}
+
+// Validate makes sure that the sort refers to a single primitive property and
+// that the order is valid
+func (s Sort) Validate() error {
+	if len(s.Path) == 0 {
+		return fmt.Errorf("path cannot be empty")
+	}
+
+	if len(s.Path) > 1 {
+		return fmt.Errorf("sorting by a property of a referenced class "+
+			"is not supported, got path %v", s.Path)
+	}
+
+	switch s.Order {
+	case SortOrderAsc, SortOrderDesc:
+		return nil
+	default:
+		return fmt.Errorf("invalid order '%s', must be one of [%s, %s]",
+			s.Order, SortOrderAsc, SortOrderDesc)
+	}
+}
+
+// ExtractSortFromArgs gets the sort key out of a map. Not specific to GQL,
+// but can be used from GQL
+func ExtractSortFromArgs(args map[string]interface{}) ([]Sort, error) {
+	sort, ok := args["sort"]
+	if !ok {
+		return nil, nil
+	}
+
+	list := sort.([]interface{})
+	out := make([]Sort, len(list))
+	for i, elem := range list {
+		asMap := elem.(map[string]interface{})
+
+		s := Sort{Order: SortOrderAsc}
+		if order, ok := asMap["order"]; ok && order != nil {
+			s.Order = order.(string)
+		}
+
+		if path, ok := asMap["path"].([]interface{}); ok {
+			for _, segment := range path {
+				s.Path = append(s.Path, segment.(string))
+			}
+		}
+
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("sort at position %d: %v", i, err)
+		}
+
+		out[i] = s
+	}
+
+	return out, nil
+}
+
+// SortFromStrings builds the sort instructions from comma-separated lists of
+// properties and orders, such as they are used in a url query. If fewer
+// orders than properties are specified, the remaining properties are sorted
+// in ascending order.
+func SortFromStrings(properties string, orders string) ([]Sort, error) {
+	if properties == "" {
+		if orders != "" {
+			return nil, fmt.Errorf("an order can only be set together with a property to sort by")
+		}
+		return nil, nil
+	}
+
+	props := strings.Split(properties, ",")
+	var ords []string
+	if orders != "" {
+		ords = strings.Split(orders, ",")
+	}
+
+	if len(ords) > len(props) {
+		return nil, fmt.Errorf("got %d orders, but only %d properties to sort by",
+			len(ords), len(props))
+	}
+
+	out := make([]Sort, len(props))
+	for i, prop := range props {
+		s := Sort{
+			Path:  []string{strings.TrimSpace(prop)},
+			Order: SortOrderAsc,
+		}
+
+		if i < len(ords) {
+			s.Order = strings.ToLower(strings.TrimSpace(ords[i]))
+		}
+
+		if s.Path[0] == "" {
+			return nil, fmt.Errorf("sort at position %d: property cannot be empty", i)
+		}
+
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("sort at position %d: %v", i, err)
+		}
+
+		out[i] = s
+	}
+
+	return out, nil
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSort(t *testing.T) {
+	t.Run("without a sort present", func(t *testing.T) {
+		s, err := ExtractSortFromArgs(map[string]interface{}{})
+		require.Nil(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("with multiple sorts present", func(t *testing.T) {
+		s, err := ExtractSortFromArgs(map[string]interface{}{
+			"sort": []interface{}{
+				map[string]interface{}{
+					"path":  []interface{}{"price"},
+					"order": "desc",
+				},
+				map[string]interface{}{
+					"path": []interface{}{"name"},
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []Sort{
+			{Path: []string{"price"}, Order: SortOrderDesc},
+			{Path: []string{"name"}, Order: SortOrderAsc},
+		}, s)
+	})
+
+	t.Run("with a path on a reference", func(t *testing.T) {
+		_, err := ExtractSortFromArgs(map[string]interface{}{
+			"sort": []interface{}{
+				map[string]interface{}{
+					"path": []interface{}{"inCity", "City", "name"},
+				},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSortFromStrings(t *testing.T) {
+	t.Run("without any properties", func(t *testing.T) {
+		s, err := SortFromStrings("", "")
+		require.Nil(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("with fewer orders than properties", func(t *testing.T) {
+		s, err := SortFromStrings("price,name", "DESC")
+		require.Nil(t, err)
+		assert.Equal(t, []Sort{
+			{Path: []string{"price"}, Order: SortOrderDesc},
+			{Path: []string{"name"}, Order: SortOrderAsc},
+		}, s)
+	})
+
+	t.Run("with more orders than properties", func(t *testing.T) {
+		_, err := SortFromStrings("price", "asc,desc")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with an invalid order", func(t *testing.T) {
+		_, err := SortFromStrings("price", "up")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with an order, but no property", func(t *testing.T) {
+		_, err := SortFromStrings("", "asc")
+		assert.NotNil(t, err)
+	})
+}
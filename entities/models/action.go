@@ -40,7 +40,7 @@ type Action struct {
 	// Additional information about the neighboring concepts of this element
 	NearestNeighbors *NearestNeighbors `json:"_nearestNeighbors,omitempty"`
 
-	// This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_<propName> parameter)
+	// This object's position in the vector space. Can be set on import to use your own vector instead of the vectorizer's. (Underscore properties are optional, include them using the ?include=_<propName> parameter)
 	Vector C11yVector `json:"_vector,omitempty"`
 
 	// Type of the Action, defined in the schema.
@@ -41,8 +41,17 @@ type Class struct {
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// Number of shards the data of this class is split into. Objects are assigned to a shard based on their id. Defaults to 1, at most 64 shards are allowed. Cannot be changed once the class has been created.
+	ShardCount int64 `json:"shardCount,omitempty"`
+
+	// vector index config
+	VectorIndexConfig *VectorIndexConfig `json:"vectorIndexConfig,omitempty"`
+
 	// Set this to true if the object vector should include the class name in calculating the overall vector position
 	VectorizeClassName *bool `json:"vectorizeClassName,omitempty"`
+
+	// Specify how the vectors of this class' objects are created. 'text2vec-contextionary' (default) builds them from the object's properties using the contextionary. 'none' means the contextionary is never contacted for this class, so every object has to be imported with its own vector. Cannot be changed once the class has been created.
+	Vectorizer string `json:"vectorizer,omitempty"`
 }
 
 // Validate validates this class
@@ -57,6 +66,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectorIndexConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -104,6 +117,24 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateVectorIndexConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.VectorIndexConfig) { // not required
+		return nil
+	}
+
+	if m.VectorIndexConfig != nil {
+		if err := m.VectorIndexConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vectorIndexConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -45,6 +45,9 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
+	// text analyzer
+	TextAnalyzer *TextAnalyzerConfig `json:"textAnalyzer,omitempty"`
+
 	// Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.
 	VectorizePropertyName bool `json:"vectorizePropertyName,omitempty"`
 }
@@ -57,6 +60,10 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTextAnalyzer(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -79,6 +86,24 @@ func (m *Property) validateKeywords(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateTextAnalyzer(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TextAnalyzer) { // not required
+		return nil
+	}
+
+	if m.TextAnalyzer != nil {
+		if err := m.TextAnalyzer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("textAnalyzer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Property) MarshalBinary() ([]byte, error) {
 	if m == nil {
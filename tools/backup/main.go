@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Command backup creates and restores backups of a weaviate running in
+// standalone mode with backups enabled, i.e. with BACKUP_FILESYSTEM_PATH or
+// BACKUP_S3_ENDPOINT set.
+//
+// Usage:
+//
+//	go run ./tools/backup [-host localhost:8080] [-id nightly] create [class...]
+//	go run ./tools/backup [-host localhost:8080] restore <id>
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	apiclient "github.com/semi-technologies/weaviate/client"
+	"github.com/semi-technologies/weaviate/client/backups"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+func main() {
+	host := flag.String("host", "localhost:8080", "host and port of weaviate")
+	scheme := flag.String("scheme", "http", "scheme to connect to weaviate with")
+	token := flag.String("token", "", "bearer token, if weaviate requires authentication")
+	id := flag.String("id", "", "id of the backup to create, generated if not set")
+	timeout := flag.Duration("timeout", 30*time.Minute, "maximum duration of the backup or restore")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(),
+			"usage: %s [flags] create [class...] | restore <id>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	transport := httptransport.New(*host, "/v1", []string{*scheme})
+	client := apiclient.New(transport, strfmt.Default)
+
+	var auth runtime.ClientAuthInfoWriter
+	if *token != "" {
+		auth = httptransport.BearerToken(*token)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var res *models.Backup
+	var err error
+	switch args[0] {
+	case "create":
+		res, err = create(ctx, client, auth, *id, args[1:])
+	case "restore":
+		if len(args) != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		res, err = restore(ctx, client, auth, args[1])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s failed: %s\n", args[0], errorMessage(err))
+		os.Exit(1)
+	}
+
+	out, _ := json.MarshalIndent(res, "", "  ")
+	fmt.Println(string(out))
+}
+
+func create(ctx context.Context, client *apiclient.Weaviate,
+	auth runtime.ClientAuthInfoWriter, id string, classes []string) (*models.Backup, error) {
+	params := backups.NewBackupsCreateParamsWithContext(ctx).
+		WithBody(&models.Backup{ID: id, Classes: classes})
+	res, err := client.Backups.BackupsCreate(params, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Payload, nil
+}
+
+func restore(ctx context.Context, client *apiclient.Weaviate,
+	auth runtime.ClientAuthInfoWriter, id string) (*models.Backup, error) {
+	params := backups.NewBackupsRestoreParamsWithContext(ctx).WithID(id)
+	res, err := client.Backups.BackupsRestore(params, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Payload, nil
+}
+
+// errorMessage extracts the messages of the ErrorResponse, if the server sent
+// one
+func errorMessage(err error) string {
+	var payload *models.ErrorResponse
+	switch e := err.(type) {
+	case *backups.BackupsCreateForbidden:
+		payload = e.Payload
+	case *backups.BackupsCreateUnprocessableEntity:
+		payload = e.Payload
+	case *backups.BackupsCreateInternalServerError:
+		payload = e.Payload
+	case *backups.BackupsRestoreForbidden:
+		payload = e.Payload
+	case *backups.BackupsRestoreUnprocessableEntity:
+		payload = e.Payload
+	case *backups.BackupsRestoreInternalServerError:
+		payload = e.Payload
+	case *backups.BackupsRestoreNotFound:
+		return "backup does not exist"
+	}
+
+	if payload == nil || len(payload.Error) == 0 {
+		return err.Error()
+	}
+
+	msg := ""
+	for i, item := range payload.Error {
+		if i > 0 {
+			msg += "; "
+		}
+		msg += item.Message
+	}
+	return msg
+}
@@ -33,6 +33,8 @@ case $CONFIG in
       AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED=true \
       STANDALONE_MODE=true \
       PERSISTENCE_DATA_PATH="./data" \
+      BACKUP_FILESYSTEM_PATH="./backups" \
+      PROMETHEUS_MONITORING_ENABLED=true \
       go run ./cmd/weaviate-server \
         --scheme http \
         --host "127.0.0.1" \
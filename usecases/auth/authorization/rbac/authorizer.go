@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+)
+
+const AnonymousPrinicpalUsername = "anonymous"
+
+// Authorizer grants a request if any of the roles of the principal contains
+// a permission for the verb on the resource. The roles of a principal are the
+// ones assigned to its username as well as the ones assigned to its groups.
+type Authorizer struct {
+	userPermissions  map[string][]permission
+	groupPermissions map[string][]permission
+}
+
+// permission is the pre-processed form of Permission for faster lookups
+type permission struct {
+	verbs     map[string]struct{}
+	resources [][]string
+}
+
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{
+		userPermissions:  map[string][]permission{},
+		groupPermissions: map[string][]permission{},
+	}
+
+	for _, role := range cfg.Roles {
+		permissions := make([]permission, len(role.Permissions))
+		for i, p := range role.Permissions {
+			permissions[i] = newPermission(p)
+		}
+
+		for _, user := range role.Users {
+			a.userPermissions[user] = append(a.userPermissions[user], permissions...)
+		}
+
+		for _, group := range role.Groups {
+			a.groupPermissions[group] = append(a.groupPermissions[group], permissions...)
+		}
+	}
+
+	return a
+}
+
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = newAnonymousPrincipal()
+	}
+
+	segments := strings.Split(resource, "/")
+	if grants(a.userPermissions[principal.Username], verb, segments) {
+		return nil
+	}
+
+	for _, group := range principal.Groups {
+		if grants(a.groupPermissions[group], verb, segments) {
+			return nil
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func grants(permissions []permission, verb string, resource []string) bool {
+	for _, p := range permissions {
+		if p.grants(verb, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newPermission(p Permission) permission {
+	out := permission{
+		verbs:     map[string]struct{}{},
+		resources: make([][]string, len(p.Resources)),
+	}
+
+	for _, verb := range p.Verbs {
+		out.verbs[verb] = struct{}{}
+	}
+
+	for i, resource := range p.Resources {
+		out.resources[i] = strings.Split(resource, "/")
+	}
+
+	return out
+}
+
+func (p permission) grants(verb string, resource []string) bool {
+	_, ok := p.verbs[verb]
+	if _, all := p.verbs[AllVerbs]; !ok && !all {
+		return false
+	}
+
+	for _, pattern := range p.resources {
+		if matches(pattern, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches a resource against a pattern segment by segment. A "*" matches any
+// single segment, a trailing "*" matches any number of remaining segments,
+// including none, so that "things/*" also matches "things".
+func matches(pattern, resource []string) bool {
+	for i, segment := range pattern {
+		if segment == "*" && i == len(pattern)-1 {
+			return len(resource) >= i
+		}
+
+		if i >= len(resource) {
+			return false
+		}
+
+		if segment != "*" && segment != resource[i] {
+			return false
+		}
+	}
+
+	return len(pattern) == len(resource)
+}
+
+func newAnonymousPrincipal() *models.Principal {
+	return &models.Principal{
+		Username: AnonymousPrinicpalUsername,
+	}
+}
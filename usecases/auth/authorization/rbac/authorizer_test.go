@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Roles: []Role{
+			{
+				Name:  "admin",
+				Users: []string{"alice"},
+				Permissions: []Permission{
+					{Verbs: []string{AllVerbs}, Resources: []string{"*"}},
+				},
+			},
+			{
+				Name:   "article-editor",
+				Users:  []string{"bob"},
+				Groups: []string{"editors"},
+				Permissions: []Permission{
+					{
+						Verbs:     []string{"get", "create", "update", "delete"},
+						Resources: []string{"things/Article/*"},
+					},
+					{
+						Verbs:     []string{"list"},
+						Resources: []string{"schema/*"},
+					},
+				},
+			},
+			{
+				Name:   "classifier",
+				Groups: []string{"data-science"},
+				Permissions: []Permission{
+					{
+						Verbs:     []string{"get", "create"},
+						Resources: []string{"classifications/*"},
+					},
+					{
+						Verbs:     []string{"get"},
+						Resources: []string{"things/*/*"},
+					},
+				},
+			},
+		},
+	}
+
+	authorizer := New(cfg)
+
+	type test struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resource  string
+		allowed   bool
+	}
+
+	tests := []test{
+		{
+			name:      "admin can do anything",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "delete",
+			resource:  "schema/things",
+			allowed:   true,
+		},
+		{
+			name:      "editor can update articles",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "update",
+			resource:  "things/Article/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			allowed:   true,
+		},
+		{
+			name:      "editor can create articles",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "create",
+			resource:  "things/Article",
+			allowed:   true,
+		},
+		{
+			name:      "editor cannot update other classes",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "update",
+			resource:  "things/Author/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			allowed:   false,
+		},
+		{
+			name:      "editor cannot update the schema",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "update",
+			resource:  "schema/things",
+			allowed:   false,
+		},
+		{
+			name:      "editor can read the schema",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "list",
+			resource:  "schema/*",
+			allowed:   true,
+		},
+		{
+			name:      "roles are granted through groups",
+			principal: &models.Principal{Username: "carol", Groups: []string{"editors"}},
+			verb:      "delete",
+			resource:  "things/Article/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			allowed:   true,
+		},
+		{
+			name:      "a wildcard in the middle matches a single segment",
+			principal: &models.Principal{Username: "dave", Groups: []string{"data-science"}},
+			verb:      "get",
+			resource:  "things/Author/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			allowed:   true,
+		},
+		{
+			name:      "a wildcard does not match other resources",
+			principal: &models.Principal{Username: "dave", Groups: []string{"data-science"}},
+			verb:      "get",
+			resource:  "actions/Author/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			allowed:   false,
+		},
+		{
+			name:      "a user without roles is denied",
+			principal: &models.Principal{Username: "eve"},
+			verb:      "get",
+			resource:  "things/Article/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			allowed:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.Authorize(test.principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, errors.NewForbidden(test.principal, test.verb,
+					test.resource), err)
+			}
+		})
+	}
+
+	t.Run("with a nil principal", func(t *testing.T) {
+		err := authorizer.Authorize(nil, "get", "things")
+		assert.Equal(t, errors.NewForbidden(newAnonymousPrincipal(), "get", "things"), err)
+	})
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Config of the role-based authorizer. Each role grants its permissions to
+// the listed users and to all members of the listed (OIDC) groups.
+type Config struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Roles   []Role `json:"roles" yaml:"roles"`
+}
+
+type Role struct {
+	Name        string       `json:"name" yaml:"name"`
+	Users       []string     `json:"users" yaml:"users"`
+	Groups      []string     `json:"groups" yaml:"groups"`
+	Permissions []Permission `json:"permissions" yaml:"permissions"`
+}
+
+// Permission grants all verbs on all resources matching one of the resource
+// patterns. A pattern consists of segments separated by "/", a "*" matches
+// any single segment, a trailing "*" matches any number of segments, e.g.
+// "things/Article/*", "schema/*" or "*"
+type Permission struct {
+	Verbs     []string `json:"verbs" yaml:"verbs"`
+	Resources []string `json:"resources" yaml:"resources"`
+}
+
+// AllVerbs can be used in place of the individual verbs
+const AllVerbs = "*"
+
+var validVerbs = map[string]struct{}{
+	AllVerbs:   {},
+	"get":      {},
+	"list":     {},
+	"create":   {},
+	"update":   {},
+	"delete":   {},
+	"validate": {},
+}
+
+func (c Config) Validate() error {
+	names := map[string]struct{}{}
+	for i, role := range c.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("rbac: role at position %d has no name", i)
+		}
+
+		if _, ok := names[role.Name]; ok {
+			return fmt.Errorf("rbac: role '%s' is defined more than once", role.Name)
+		}
+		names[role.Name] = struct{}{}
+
+		if err := role.validate(); err != nil {
+			return fmt.Errorf("rbac: role '%s': %s", role.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r Role) validate() error {
+	if len(r.Users) == 0 && len(r.Groups) == 0 {
+		return fmt.Errorf("must be assigned to at least one user or group")
+	}
+
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("must grant at least one permission")
+	}
+
+	for _, p := range r.Permissions {
+		if err := p.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p Permission) validate() error {
+	if len(p.Verbs) == 0 {
+		return fmt.Errorf("permission must contain at least one verb")
+	}
+
+	for _, verb := range p.Verbs {
+		if _, ok := validVerbs[verb]; !ok {
+			return fmt.Errorf("unknown verb '%s'", verb)
+		}
+	}
+
+	if len(p.Resources) == 0 {
+		return fmt.Errorf("permission must contain at least one resource")
+	}
+
+	for _, resource := range p.Resources {
+		for _, segment := range strings.Split(resource, "/") {
+			if segment == "" {
+				return fmt.Errorf("resource '%s' contains an empty segment", resource)
+			}
+		}
+	}
+
+	return nil
+}
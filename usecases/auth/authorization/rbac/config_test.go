@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validation(t *testing.T) {
+	validRole := func(name string) Role {
+		return Role{
+			Name:   name,
+			Users:  []string{"alice"},
+			Groups: []string{"editors"},
+			Permissions: []Permission{
+				{
+					Verbs:     []string{"get", "list"},
+					Resources: []string{"things/Article/*", "schema/*"},
+				},
+			},
+		}
+	}
+
+	type test struct {
+		name        string
+		cfg         Config
+		expectedErr error
+	}
+
+	tests := []test{
+		{
+			name: "with valid roles",
+			cfg: Config{
+				Enabled: true,
+				Roles:   []Role{validRole("reader"), validRole("other-reader")},
+			},
+		},
+		{
+			name: "with a role without a name",
+			cfg: Config{
+				Enabled: true,
+				Roles:   []Role{validRole("")},
+			},
+			expectedErr: fmt.Errorf("rbac: role at position 0 has no name"),
+		},
+		{
+			name: "with a role defined twice",
+			cfg: Config{
+				Enabled: true,
+				Roles:   []Role{validRole("reader"), validRole("reader")},
+			},
+			expectedErr: fmt.Errorf("rbac: role 'reader' is defined more than once"),
+		},
+		{
+			name: "with a role without users or groups",
+			cfg: Config{
+				Enabled: true,
+				Roles: []Role{func() Role {
+					r := validRole("reader")
+					r.Users = nil
+					r.Groups = nil
+					return r
+				}()},
+			},
+			expectedErr: fmt.Errorf("rbac: role 'reader': must be assigned to at least one user or group"),
+		},
+		{
+			name: "with an unknown verb",
+			cfg: Config{
+				Enabled: true,
+				Roles: []Role{func() Role {
+					r := validRole("reader")
+					r.Permissions[0].Verbs = []string{"read"}
+					return r
+				}()},
+			},
+			expectedErr: fmt.Errorf("rbac: role 'reader': unknown verb 'read'"),
+		},
+		{
+			name: "with an empty resource segment",
+			cfg: Config{
+				Enabled: true,
+				Roles: []Role{func() Role {
+					r := validRole("reader")
+					r.Permissions[0].Resources = []string{"things//*"}
+					return r
+				}()},
+			},
+			expectedErr: fmt.Errorf("rbac: role 'reader': resource 'things//*' contains an empty segment"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
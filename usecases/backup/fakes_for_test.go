@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+// fakeBackend keeps all objects in memory
+type fakeBackend struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{objects: map[string][]byte{}}
+}
+
+func (f *fakeBackend) PutFile(ctx context.Context, key, path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return f.PutObject(ctx, key, data)
+}
+
+func (f *fakeBackend) GetFile(ctx context.Context, key, path string) error {
+	data, err := f.GetObject(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0o666)
+}
+
+func (f *fakeBackend) PutObject(ctx context.Context, key string, data []byte) error {
+	f.Lock()
+	defer f.Unlock()
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeBackend) GetObject(ctx context.Context, key string) ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+	data, ok := f.objects[key]
+	if !ok {
+		return nil, NewErrNotFound("%s does not exist", key)
+	}
+	return data, nil
+}
+
+// fakeDB writes a single file per class on backup and keeps the contents of
+// the restored files
+type fakeDB struct {
+	restored map[string]map[string]string // by class name, then file name
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{restored: map[string]map[string]string{}}
+}
+
+func (f *fakeDB) BackupClass(ctx context.Context, kind kind.Kind,
+	className schema.ClassName, dir string) ([]string, error) {
+	file := "shard.d/" + className.String() + ".db"
+	if err := os.MkdirAll(filepath.Join(dir, "shard.d"), 0o777); err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, filepath.FromSlash(file)),
+		[]byte("contents of "+className.String()), 0o666); err != nil {
+		return nil, err
+	}
+
+	return []string{file}, nil
+}
+
+func (f *fakeDB) RestoreClass(ctx context.Context, kind kind.Kind,
+	className schema.ClassName, dir string) error {
+	files := map[string]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(dir, path)
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	f.restored[className.String()] = files
+	return nil
+}
+
+// fakeSchemaManager records the order in which classes and properties are
+// added as "Class" and "Class.prop"
+type fakeSchemaManager struct {
+	schema schema.Schema
+	added  []string
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func (f *fakeSchemaManager) AddThing(ctx context.Context, principal *models.Principal,
+	class *models.Class) error {
+	return f.addClass(kind.Thing, class)
+}
+
+func (f *fakeSchemaManager) AddAction(ctx context.Context, principal *models.Principal,
+	class *models.Class) error {
+	return f.addClass(kind.Action, class)
+}
+
+func (f *fakeSchemaManager) addClass(k kind.Kind, class *models.Class) error {
+	semanticSchema := f.schema.SemanticSchemaFor(k)
+	semanticSchema.Classes = append(semanticSchema.Classes, class)
+	f.added = append(f.added, class.Class)
+	return nil
+}
+
+func (f *fakeSchemaManager) AddThingProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property) error {
+	return f.addProperty(class, property)
+}
+
+func (f *fakeSchemaManager) AddActionProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property) error {
+	return f.addProperty(class, property)
+}
+
+func (f *fakeSchemaManager) addProperty(className string, property *models.Property) error {
+	class := f.schema.FindClassByName(schema.ClassName(className))
+	class.Properties = append(class.Properties, property)
+	f.added = append(f.added, className+"."+property.Name)
+	return nil
+}
+
+type fakeClassificationRepo struct {
+	classifications []*models.Classification
+}
+
+func (f *fakeClassificationRepo) List(ctx context.Context) ([]*models.Classification, error) {
+	return f.classifications, nil
+}
+
+func (f *fakeClassificationRepo) Put(ctx context.Context, c models.Classification) error {
+	f.classifications = append(f.classifications, &c)
+	return nil
+}
@@ -0,0 +1,497 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package backup takes online backups of the data of standalone mode and
+// restores them. A backup is a consistent copy of all files of one or more
+// classes together with their schema and classifications. It is stored on a
+// Backend, such as a local directory or an S3 bucket.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus"
+)
+
+// Backend stores the files of backups, keys are slash-separated paths
+// relative to the root of the backend. Objects which don't exist must be
+// reported with an ErrNotFound.
+type Backend interface {
+	// PutFile stores the contents of the local file at path under the key
+	PutFile(ctx context.Context, key, path string) error
+
+	// GetFile writes the contents stored under the key into the local file at
+	// path
+	GetFile(ctx context.Context, key, path string) error
+
+	PutObject(ctx context.Context, key string, data []byte) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+}
+
+// DB copies the files of a class, see db.BackupClass and db.RestoreClass
+type DB interface {
+	BackupClass(ctx context.Context, kind kind.Kind, className schema.ClassName,
+		dir string) ([]string, error)
+	RestoreClass(ctx context.Context, kind kind.Kind, className schema.ClassName,
+		dir string) error
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	AddThing(ctx context.Context, principal *models.Principal, class *models.Class) error
+	AddAction(ctx context.Context, principal *models.Principal, class *models.Class) error
+	AddThingProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
+	AddActionProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
+}
+
+// ClassificationRepo is the classification.Repo of standalone mode, which
+// can list all classifications
+type ClassificationRepo interface {
+	List(ctx context.Context) ([]*models.Classification, error)
+	Put(ctx context.Context, classification models.Classification) error
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type Manager struct {
+	backend         Backend
+	db              DB
+	schemaManager   schemaManager
+	classifications ClassificationRepo
+	authorizer      authorizer
+	logger          logrus.FieldLogger
+
+	// only one backup or restore can run at a time
+	sync.Mutex
+	running bool
+}
+
+func NewManager(backend Backend, db DB, sm schemaManager,
+	classifications ClassificationRepo, authorizer authorizer,
+	logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		backend:         backend,
+		db:              db,
+		schemaManager:   sm,
+		classifications: classifications,
+		authorizer:      authorizer,
+		logger:          logger,
+	}
+}
+
+// descriptor is stored next to the files of a backup. It is written last, so
+// a backup without a descriptor is incomplete and can't be restored.
+type descriptor struct {
+	ID              string                   `json:"id"`
+	CreatedAt       time.Time                `json:"createdAt"`
+	Classes         []classDescriptor        `json:"classes"`
+	Classifications []*models.Classification `json:"classifications"`
+}
+
+type classDescriptor struct {
+	Kind  kind.Kind     `json:"kind"`
+	Class *models.Class `json:"class"`
+	Files []string      `json:"files"`
+}
+
+const descriptorName = "backup.json"
+
+var validID = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+func descriptorKey(id string) string {
+	return path.Join(id, descriptorName)
+}
+
+func fileKey(id, className, file string) string {
+	return path.Join(id, className, file)
+}
+
+// Backup copies the files of the specified classes, or of all classes if none
+// are specified, to the backend. Writes to each class are paused while its
+// files are copied.
+func (m *Manager) Backup(ctx context.Context, principal *models.Principal,
+	params models.Backup) (*models.Backup, error) {
+	err := m.authorizer.Authorize(principal, "create", "backups/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if params.ID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, fmt.Errorf("backup: assign id: %v", err)
+		}
+		params.ID = id.String()
+	}
+
+	if err := validateID(params.ID); err != nil {
+		return nil, err
+	}
+
+	done, err := m.start()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if _, err := m.backend.GetObject(ctx, descriptorKey(params.ID)); err == nil {
+		return nil, NewErrInvalidUserInput("backup %q already exists", params.ID)
+	} else if _, ok := err.(ErrNotFound); !ok {
+		return nil, fmt.Errorf("backup %s: check for existing backup: %v", params.ID, err)
+	}
+
+	desc := descriptor{ID: params.ID, CreatedAt: time.Now()}
+	desc.Classes, err = m.classesToBackup(params.Classes)
+	if err != nil {
+		return nil, err
+	}
+
+	staging, err := ioutil.TempDir("", "weaviate-backup-")
+	if err != nil {
+		return nil, fmt.Errorf("backup %s: create staging directory: %v", params.ID, err)
+	}
+	defer os.RemoveAll(staging)
+
+	classNames := make([]string, len(desc.Classes))
+	for i := range desc.Classes {
+		class := &desc.Classes[i]
+		classNames[i] = class.Class.Class
+		if err := m.backupClass(ctx, params.ID, class, staging); err != nil {
+			return nil, fmt.Errorf("backup %s: %v", params.ID, err)
+		}
+	}
+
+	desc.Classifications, err = m.classificationsOf(ctx, classNames)
+	if err != nil {
+		return nil, fmt.Errorf("backup %s: %v", params.ID, err)
+	}
+
+	descJSON, err := json.Marshal(desc)
+	if err != nil {
+		return nil, fmt.Errorf("backup %s: marshal descriptor: %v", params.ID, err)
+	}
+
+	if err := m.backend.PutObject(ctx, descriptorKey(params.ID), descJSON); err != nil {
+		return nil, fmt.Errorf("backup %s: store descriptor: %v", params.ID, err)
+	}
+
+	m.logger.WithField("action", "backup_created").
+		WithField("backup_id", params.ID).
+		WithField("classes", classNames).
+		Info("backup created")
+
+	return &models.Backup{ID: params.ID, Classes: classNames}, nil
+}
+
+func validateID(id string) error {
+	if !validID.MatchString(id) || id == "." || id == ".." {
+		return NewErrInvalidUserInput("invalid backup id %q: only letters, digits, "+
+			"'_', '-' and '.' are allowed", id)
+	}
+
+	return nil
+}
+
+// start makes sure that only one backup or restore runs at a time, the
+// returned func must be called once it is done
+func (m *Manager) start() (func(), error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.running {
+		return nil, NewErrInvalidUserInput("another backup or restore is already running")
+	}
+
+	m.running = true
+	return func() {
+		m.Lock()
+		m.running = false
+		m.Unlock()
+	}, nil
+}
+
+func (m *Manager) classesToBackup(names []string) ([]classDescriptor, error) {
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	if len(names) == 0 {
+		var out []classDescriptor
+		for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+			semanticSchema := sch.SemanticSchemaFor(k)
+			if semanticSchema == nil {
+				continue
+			}
+
+			for _, class := range semanticSchema.Classes {
+				out = append(out, classDescriptor{Kind: k, Class: class})
+			}
+		}
+
+		if len(out) == 0 {
+			return nil, NewErrInvalidUserInput("there are no classes to back up")
+		}
+
+		return out, nil
+	}
+
+	out := make([]classDescriptor, 0, len(names))
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		class := sch.FindClassByName(schema.ClassName(name))
+		if class == nil {
+			return nil, NewErrInvalidUserInput("class %q does not exist", name)
+		}
+
+		k, _ := sch.GetKindOfClass(schema.ClassName(name))
+		out = append(out, classDescriptor{Kind: k, Class: class})
+	}
+
+	return out, nil
+}
+
+// backupClass copies the files of the class into the staging directory and
+// uploads them from there, so writes are only paused for the local copy
+func (m *Manager) backupClass(ctx context.Context, id string,
+	class *classDescriptor, staging string) error {
+	className := class.Class.Class
+	dir := filepath.Join(staging, className)
+	defer os.RemoveAll(dir)
+
+	files, err := m.db.BackupClass(ctx, class.Kind, schema.ClassName(className), dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := m.backend.PutFile(ctx, fileKey(id, className, file),
+			filepath.Join(dir, filepath.FromSlash(file))); err != nil {
+			return fmt.Errorf("class %s: upload %s: %v", className, file, err)
+		}
+	}
+
+	class.Files = files
+	return nil
+}
+
+func (m *Manager) classificationsOf(ctx context.Context,
+	classNames []string) ([]*models.Classification, error) {
+	if m.classifications == nil {
+		return nil, nil
+	}
+
+	all, err := m.classifications.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list classifications: %v", err)
+	}
+
+	contained := map[string]bool{}
+	for _, name := range classNames {
+		contained[name] = true
+	}
+
+	var out []*models.Classification
+	for _, c := range all {
+		if contained[c.Class] {
+			out = append(out, c)
+		}
+	}
+
+	return out, nil
+}
+
+// Restore recreates all classes of the backup from its files. None of the
+// classes must exist. The classes are added to the schema without their
+// reference properties first, so that references between the restored
+// classes can be added once all of them exist.
+func (m *Manager) Restore(ctx context.Context, principal *models.Principal,
+	id string) (*models.Backup, error) {
+	err := m.authorizer.Authorize(principal, "restore", "backups/"+id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+
+	done, err := m.start()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	descJSON, err := m.backend.GetObject(ctx, descriptorKey(id))
+	if err != nil {
+		if _, ok := err.(ErrNotFound); ok {
+			return nil, NewErrNotFound("backup %q does not exist", id)
+		}
+		return nil, fmt.Errorf("restore %s: get descriptor: %v", id, err)
+	}
+
+	var desc descriptor
+	if err := json.Unmarshal(descJSON, &desc); err != nil {
+		return nil, fmt.Errorf("restore %s: parse descriptor: %v", id, err)
+	}
+
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	for _, class := range desc.Classes {
+		if class.Class == nil {
+			return nil, fmt.Errorf("restore %s: descriptor contains a class without schema", id)
+		}
+
+		if sch.FindClassByName(schema.ClassName(class.Class.Class)) != nil {
+			return nil, NewErrInvalidUserInput("class %q already exists", class.Class.Class)
+		}
+	}
+
+	staging, err := ioutil.TempDir("", "weaviate-restore-")
+	if err != nil {
+		return nil, fmt.Errorf("restore %s: create staging directory: %v", id, err)
+	}
+	defer os.RemoveAll(staging)
+
+	classNames := make([]string, len(desc.Classes))
+	for i, class := range desc.Classes {
+		classNames[i] = class.Class.Class
+		if err := m.restoreClassFiles(ctx, id, class, staging); err != nil {
+			return nil, fmt.Errorf("restore %s: %v", id, err)
+		}
+	}
+
+	if err := m.restoreSchema(ctx, principal, desc.Classes); err != nil {
+		return nil, fmt.Errorf("restore %s: %v", id, err)
+	}
+
+	if m.classifications != nil {
+		for _, c := range desc.Classifications {
+			if err := m.classifications.Put(ctx, *c); err != nil {
+				return nil, fmt.Errorf("restore %s: classification %s: %v", id, c.ID, err)
+			}
+		}
+	}
+
+	m.logger.WithField("action", "backup_restored").
+		WithField("backup_id", id).
+		WithField("classes", classNames).
+		Info("backup restored")
+
+	return &models.Backup{ID: id, Classes: classNames}, nil
+}
+
+func (m *Manager) restoreClassFiles(ctx context.Context, id string,
+	class classDescriptor, staging string) error {
+	className := class.Class.Class
+	dir, err := joinBackupPath(staging, className)
+	if err != nil {
+		return fmt.Errorf("class %s: %v", className, err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, file := range class.Files {
+		local, err := joinBackupPath(dir, file)
+		if err != nil {
+			return fmt.Errorf("class %s: %v", className, err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(local), 0o777); err != nil {
+			return fmt.Errorf("class %s: %v", className, err)
+		}
+
+		if err := m.backend.GetFile(ctx, fileKey(id, className, file), local); err != nil {
+			return fmt.Errorf("class %s: download %s: %v", className, file, err)
+		}
+	}
+
+	return m.db.RestoreClass(ctx, class.Kind, schema.ClassName(className), dir)
+}
+
+// joinBackupPath joins the slash-separated path of a backup descriptor to the
+// directory. The descriptor is read from the backend, so a path must neither
+// be absolute nor leave the directory, otherwise a restore could overwrite
+// any file.
+func joinBackupPath(dir, p string) (string, error) {
+	local := filepath.FromSlash(p)
+	if p == "" || path.IsAbs(p) || filepath.IsAbs(local) ||
+		filepath.VolumeName(local) != "" {
+		return "", fmt.Errorf("invalid path %q: must be relative", p)
+	}
+
+	cleaned := filepath.Clean(local)
+	if cleaned == "." || cleaned == ".." ||
+		strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid path %q: leaves the directory of the backup", p)
+	}
+
+	return filepath.Join(dir, cleaned), nil
+}
+
+func (m *Manager) restoreSchema(ctx context.Context, principal *models.Principal,
+	classes []classDescriptor) error {
+	refProps := make([][]*models.Property, len(classes))
+	for i, class := range classes {
+		withoutRefs := *class.Class
+		withoutRefs.Properties = nil
+		for _, prop := range class.Class.Properties {
+			if schema.IsRefDataType(prop.DataType) {
+				refProps[i] = append(refProps[i], prop)
+				continue
+			}
+			withoutRefs.Properties = append(withoutRefs.Properties, prop)
+		}
+
+		var err error
+		if class.Kind == kind.Action {
+			err = m.schemaManager.AddAction(ctx, principal, &withoutRefs)
+		} else {
+			err = m.schemaManager.AddThing(ctx, principal, &withoutRefs)
+		}
+		if err != nil {
+			return fmt.Errorf("add class %s: %v", class.Class.Class, err)
+		}
+	}
+
+	for i, class := range classes {
+		for _, prop := range refProps[i] {
+			var err error
+			if class.Kind == kind.Action {
+				err = m.schemaManager.AddActionProperty(ctx, principal, class.Class.Class, prop)
+			} else {
+				err = m.schemaManager.AddThingProperty(ctx, principal, class.Class.Class, prop)
+			}
+			if err != nil {
+				return fmt.Errorf("add property %s.%s: %v", class.Class.Class, prop.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+						{Name: "madeBy", DataType: []string{"Manufacturer"}},
+					},
+				},
+				{
+					Class: "Manufacturer",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+					},
+				},
+			},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Drive",
+					Properties: []*models.Property{
+						{Name: "car", DataType: []string{"Car"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func emptySchema() schema.Schema {
+	return schema.Schema{
+		Things:  &models.Schema{},
+		Actions: &models.Schema{},
+	}
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	backend := newFakeBackend()
+
+	source := NewManager(backend, newFakeDB(),
+		&fakeSchemaManager{schema: testSchema()},
+		&fakeClassificationRepo{classifications: []*models.Classification{
+			{ID: "6a2b9b8b-6f7b-4d5c-9c40-6b4b1bd7d3b1", Class: "Car"},
+			{ID: "e1d7a34c-6ac6-4c8e-8bc2-7a38b3e6f17e", Class: "Drive"},
+		}},
+		&fakeAuthorizer{}, logger)
+
+	t.Run("backing up unknown classes", func(t *testing.T) {
+		_, err := source.Backup(ctx, nil, models.Backup{ID: "b", Classes: []string{"Plane"}})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("backing up with an invalid id", func(t *testing.T) {
+		_, err := source.Backup(ctx, nil, models.Backup{ID: "../b"})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("backing up some classes", func(t *testing.T) {
+		res, err := source.Backup(ctx, nil, models.Backup{
+			ID:      "cars",
+			Classes: []string{"Manufacturer", "Car"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &models.Backup{
+			ID:      "cars",
+			Classes: []string{"Manufacturer", "Car"},
+		}, res)
+
+		assert.Equal(t, "contents of Car",
+			string(backend.objects["cars/Car/shard.d/Car.db"]))
+		assert.Contains(t, backend.objects, "cars/backup.json")
+	})
+
+	t.Run("backing up with an existing id", func(t *testing.T) {
+		_, err := source.Backup(ctx, nil, models.Backup{ID: "cars"})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("backing up all classes with a generated id", func(t *testing.T) {
+		res, err := source.Backup(ctx, nil, models.Backup{})
+		require.Nil(t, err)
+		assert.NotEmpty(t, res.ID)
+		assert.Equal(t, []string{"Car", "Manufacturer", "Drive"}, res.Classes)
+	})
+
+	t.Run("restoring a backup which doesn't exist", func(t *testing.T) {
+		_, err := source.Restore(ctx, nil, "trucks")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("restoring onto existing classes", func(t *testing.T) {
+		_, err := source.Restore(ctx, nil, "cars")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("restoring into an empty instance", func(t *testing.T) {
+		db := newFakeDB()
+		sm := &fakeSchemaManager{schema: emptySchema()}
+		classifications := &fakeClassificationRepo{}
+		target := NewManager(backend, db, sm, classifications,
+			&fakeAuthorizer{}, logger)
+
+		res, err := target.Restore(ctx, nil, "cars")
+		require.Nil(t, err)
+		assert.Equal(t, &models.Backup{
+			ID:      "cars",
+			Classes: []string{"Manufacturer", "Car"},
+		}, res)
+
+		// the reference is added once both classes exist
+		assert.Equal(t, []string{"Manufacturer", "Car", "Car.madeBy"}, sm.added)
+		expected := testSchema()
+		assert.Equal(t, expected.FindClassByName("Car"),
+			sm.schema.FindClassByName("Car"))
+
+		assert.Equal(t, map[string]string{"shard.d/Car.db": "contents of Car"},
+			db.restored["Car"])
+		assert.Equal(t, map[string]string{"shard.d/Manufacturer.db": "contents of Manufacturer"},
+			db.restored["Manufacturer"])
+
+		// only the classifications of the backed up classes are restored
+		require.Len(t, classifications.classifications, 1)
+		assert.Equal(t, "Car", classifications.classifications[0].Class)
+	})
+}
+
+func TestRestoreRejectsPathsOutsideOfTheBackup(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	// the staging directory of the restore is created below root, so that a
+	// path which escapes it can't touch anything outside of this test
+	root, err := ioutil.TempDir("", "weaviate-restore-test-")
+	require.Nil(t, err)
+	defer os.RemoveAll(root)
+
+	tmp := filepath.Join(root, "tmp")
+	require.Nil(t, os.MkdirAll(tmp, 0o777))
+	oldTmp, hadTmp := os.LookupEnv("TMPDIR")
+	os.Setenv("TMPDIR", tmp)
+	defer func() {
+		if hadTmp {
+			os.Setenv("TMPDIR", oldTmp)
+		} else {
+			os.Unsetenv("TMPDIR")
+		}
+	}()
+
+	tests := []struct {
+		name      string
+		className string
+		file      string
+	}{
+		{"a file which leaves the class", "Car", "../../escaped"},
+		{"a file which leaves the class after cleaning", "Car", "shard.d/../../../escaped"},
+		{"an absolute file", "Car", filepath.ToSlash(filepath.Join(root, "escaped"))},
+		{"a class which leaves the backup", "..", "escaped"},
+		{"an absolute class", filepath.ToSlash(root), "escaped"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backend := newFakeBackend()
+			desc, err := json.Marshal(descriptor{Classes: []classDescriptor{{
+				Kind:  kind.Thing,
+				Class: &models.Class{Class: test.className},
+				Files: []string{test.file},
+			}}})
+			require.Nil(t, err)
+			backend.objects["evil/backup.json"] = desc
+			backend.objects[fileKey("evil", test.className, test.file)] = []byte("evil")
+
+			db := newFakeDB()
+			target := NewManager(backend, db,
+				&fakeSchemaManager{schema: emptySchema()},
+				&fakeClassificationRepo{}, &fakeAuthorizer{}, logger)
+
+			_, err = target.Restore(ctx, nil, "evil")
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "invalid path")
+			assert.Empty(t, db.restored)
+
+			err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				assert.NotEqual(t, "escaped", info.Name(), "no file must be written outside")
+				return nil
+			})
+			require.Nil(t, err)
+
+			_, err = os.Stat(tmp)
+			assert.Nil(t, err, "no directory outside must be removed")
+		})
+	}
+
+	t.Run("paths inside of the class", func(t *testing.T) {
+		for _, file := range []string{"shard.d/Car.db", "./shard.d/../Car.db"} {
+			local, err := joinBackupPath(root, file)
+			require.Nil(t, err)
+			assert.True(t, strings.HasPrefix(local, root+string(filepath.Separator)))
+		}
+	})
+}
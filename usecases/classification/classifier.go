@@ -14,6 +14,7 @@ package classification
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -111,7 +112,8 @@ type filters struct {
 }
 
 func (c *Classifier) Schedule(ctx context.Context, principal *models.Principal, params models.Classification) (*models.Classification, error) {
-	err := c.authorizer.Authorize(principal, "create", "classifications/*")
+	err := c.authorizer.Authorize(principal, "create",
+		classificationResource(params.Class, ""))
 	if err != nil {
 		return nil, err
 	}
@@ -189,12 +191,37 @@ func (c *Classifier) assignNewID(params *models.Classification) error {
 }
 
 func (c *Classifier) Get(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Classification, error) {
-	err := c.authorizer.Authorize(principal, "get", "classifications/*")
-	if err != nil {
-		return nil, err
+	// the classification is looked up first, as the permission depends on the
+	// class it classifies. If it cannot be found, the class is omitted, so
+	// that only permissions which are not bound to a class apply.
+	classification, err := c.repo.Get(ctx, id)
+	className := ""
+	if classification != nil {
+		className = classification.Class
+	}
+
+	authErr := c.authorizer.Authorize(principal, "get",
+		classificationResource(className, id))
+	if authErr != nil {
+		return nil, authErr
+	}
+
+	return classification, err
+}
+
+// classificationResource builds the resource an authorizer decides on, such as
+// "classifications/Article/<id>", so that permissions can be granted per
+// class. Empty segments are omitted.
+func classificationResource(className string, id strfmt.UUID) string {
+	segments := []string{"classifications"}
+	if className != "" {
+		segments = append(segments, className)
+	}
+	if id != "" {
+		segments = append(segments, id.String())
 	}
 
-	return c.repo.Get(ctx, id)
+	return strings.Join(segments, "/")
 }
 
 func (c *Classifier) setDefaultValuesForOptionalFields(params *models.Classification) {
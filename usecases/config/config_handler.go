@@ -47,6 +47,8 @@ type Config struct {
 	Standalone           bool            `json:"standalone_mode" yaml:"standalone_mode"`
 	Origin               string          `json:"origin" yaml:"origin"`
 	Persistence          Persistence     `json:"persistence" yaml:"persistence"`
+	Monitoring           Monitoring      `json:"monitoring" yaml:"monitoring"`
+	Backup               Backup          `json:"backup" yaml:"backup"`
 }
 
 // Validate the non-nested parameters. Nested objects must provide their own
@@ -75,6 +77,46 @@ type Persistence struct {
 	DataPath string `json:"dataPath" yaml:"dataPath"`
 }
 
+// Monitoring exposes metrics for scraping by prometheus on the /metrics
+// endpoint
+type Monitoring struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// Backup configures where backups of standalone mode data are stored. Either
+// a path on the local filesystem or an S3-compatible endpoint can be set,
+// backups are turned off if neither is set.
+type Backup struct {
+	Path string   `json:"path" yaml:"path"`
+	S3   BackupS3 `json:"s3" yaml:"s3"`
+}
+
+// BackupS3 is any S3-compatible object store. The endpoint includes the
+// scheme, e.g. http://localhost:9000, buckets are addressed path-style.
+type BackupS3 struct {
+	Endpoint        string `json:"endpoint" yaml:"endpoint"`
+	Bucket          string `json:"bucket" yaml:"bucket"`
+	Region          string `json:"region" yaml:"region"`
+	AccessKeyID     string `json:"accessKeyId" yaml:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey" yaml:"secretAccessKey"`
+}
+
+func (b Backup) Enabled() bool {
+	return b.Path != "" || b.S3.Endpoint != ""
+}
+
+func (b Backup) Validate() error {
+	if b.Path != "" && b.S3.Endpoint != "" {
+		return fmt.Errorf("backup: only one of path and s3.endpoint can be set")
+	}
+
+	if b.S3.Endpoint != "" && b.S3.Bucket == "" {
+		return fmt.Errorf("backup.s3.bucket must be set")
+	}
+
+	return nil
+}
+
 func (p Persistence) Validate() error {
 	if p.DataPath == "" {
 		return fmt.Errorf("persistence.dataPath must be set")
@@ -206,6 +248,10 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		if err := f.Config.Persistence.Validate(); err != nil {
 			return fmt.Errorf("invalid config: %v", err)
 		}
+
+		if err := f.Config.Backup.Validate(); err != nil {
+			return fmt.Errorf("invalid config: %v", err)
+		}
 	}
 
 	return nil
@@ -68,6 +68,22 @@ func FromEnv(config *Config) error {
 		if v := os.Getenv("PERSISTENCE_DATA_PATH"); v != "" {
 			config.Persistence.DataPath = v
 		}
+
+		if v := os.Getenv("BACKUP_FILESYSTEM_PATH"); v != "" {
+			config.Backup.Path = v
+		}
+
+		if v := os.Getenv("BACKUP_S3_ENDPOINT"); v != "" {
+			config.Backup.S3.Endpoint = v
+			config.Backup.S3.Bucket = os.Getenv("BACKUP_S3_BUCKET")
+			config.Backup.S3.Region = os.Getenv("BACKUP_S3_REGION")
+			config.Backup.S3.AccessKeyID = os.Getenv("BACKUP_S3_ACCESS_KEY_ID")
+			config.Backup.S3.SecretAccessKey = os.Getenv("BACKUP_S3_SECRET_ACCESS_KEY")
+		}
+	}
+
+	if enabled(os.Getenv("PROMETHEUS_MONITORING_ENABLED")) {
+		config.Monitoring.Enabled = true
 	}
 
 	if v := os.Getenv("CONFIGURATION_STORAGE_URL"); v != "" {
@@ -27,6 +27,7 @@ import (
 type schemaManager interface {
 	UpdatePropertyAddDataType(context.Context, *models.Principal, kind.Kind, string, string, string) error
 	GetSchema(principal *models.Principal) (schema.Schema, error)
+	GetSchemaSkipAuth() schema.Schema
 }
 
 // AddAction Class Instance to the connected DB. If the class contains a network
@@ -34,11 +35,16 @@ type schemaManager interface {
 // include this particular network ref class.
 func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
 	class *models.Action) (*models.Action, error) {
-	err := m.authorizer.Authorize(principal, "create", "actions")
+	err := m.authorizer.Authorize(principal, "create",
+		kindResource(kind.Action, actionClassName(class), ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if class == nil {
+		return nil, NewErrInvalidUserInput("invalid action: no action provided")
+	}
+
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -89,7 +95,7 @@ func (m *Manager) addActionToConnectorAndSchema(ctx context.Context, principal *
 	class.CreationTimeUnix = now
 	class.LastUpdateTimeUnix = now
 
-	err = m.vectorizeAndPutAction(ctx, class)
+	err = m.vectorizeAndPutAction(ctx, principal, class)
 	if err != nil {
 		return nil, NewErrInternal("add action: %v", err)
 	}
@@ -98,8 +104,9 @@ func (m *Manager) addActionToConnectorAndSchema(ctx context.Context, principal *
 	return class, nil
 }
 
-func (m *Manager) vectorizeAndPutAction(ctx context.Context, class *models.Action) error {
-	v, source, err := m.vectorizer.Action(ctx, class)
+func (m *Manager) vectorizeAndPutAction(ctx context.Context, principal *models.Principal,
+	class *models.Action) error {
+	v, source, err := m.obtainActionVector(ctx, principal, class)
 	if err != nil {
 		return fmt.Errorf("vectorize: %v", err)
 	}
@@ -155,11 +162,16 @@ func (m *Manager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (bool
 // include this particular network ref class.
 func (m *Manager) AddThing(ctx context.Context, principal *models.Principal,
 	class *models.Thing) (*models.Thing, error) {
-	err := m.authorizer.Authorize(principal, "create", "things")
+	err := m.authorizer.Authorize(principal, "create",
+		kindResource(kind.Thing, thingClassName(class), ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if class == nil {
+		return nil, NewErrInvalidUserInput("invalid thing: no thing provided")
+	}
+
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -191,7 +203,7 @@ func (m *Manager) addThingToConnectorAndSchema(ctx context.Context, principal *m
 	class.CreationTimeUnix = now
 	class.LastUpdateTimeUnix = now
 
-	err = m.vectorizeAndPutThing(ctx, class)
+	err = m.vectorizeAndPutThing(ctx, principal, class)
 	if err != nil {
 		return nil, NewErrInternal("add thing: %v", err)
 	}
@@ -200,8 +212,9 @@ func (m *Manager) addThingToConnectorAndSchema(ctx context.Context, principal *m
 	return class, nil
 }
 
-func (m *Manager) vectorizeAndPutThing(ctx context.Context, class *models.Thing) error {
-	v, source, err := m.vectorizer.Thing(ctx, class)
+func (m *Manager) vectorizeAndPutThing(ctx context.Context, principal *models.Principal,
+	class *models.Thing) error {
+	v, source, err := m.obtainThingVector(ctx, principal, class)
 	if err != nil {
 		return fmt.Errorf("vectorize: %v", err)
 	}
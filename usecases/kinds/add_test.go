@@ -124,6 +124,16 @@ func Test_Add_Action(t *testing.T) {
 		_, err := manager.AddAction(ctx, nil, class)
 		assert.Equal(t, NewErrInvalidUserInput("invalid action: uuid: incorrect UUID length: %s", id), err)
 	})
+
+	t.Run("without a action", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddAction(context.Background(), nil, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid action: no action provided"), err)
+
+		err = manager.ValidateAction(context.Background(), nil, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid action: no action provided"), err)
+	})
 }
 
 func Test_Add_Thing(t *testing.T) {
@@ -226,4 +236,14 @@ func Test_Add_Thing(t *testing.T) {
 		_, err := manager.AddThing(ctx, nil, class)
 		assert.Equal(t, NewErrInvalidUserInput("invalid thing: uuid: incorrect UUID length: %s", id), err)
 	})
+
+	t.Run("without a thing", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(context.Background(), nil, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid thing: no thing provided"), err)
+
+		err = manager.ValidateThing(context.Background(), nil, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid thing: no thing provided"), err)
+	})
 }
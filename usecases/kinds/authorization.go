@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// kindResource builds the resource an authorizer decides on, such as
+// "things/Article/<id>". The class name is part of the resource, so that
+// permissions can be granted per class. Empty segments are omitted.
+func kindResource(k kind.Kind, className string, id strfmt.UUID) string {
+	segments := []string{k.Name() + "s"}
+	if className != "" {
+		segments = append(segments, className)
+	}
+	if id != "" {
+		segments = append(segments, id.String())
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// allObjectsResource is the resource of every object of a class, it is
+// authorized by operations which may affect any of them, such as batch
+// deletes and merges. Without a class, it covers every object of the kind.
+func allObjectsResource(k kind.Kind, className string) string {
+	return kindResource(k, className, "") + "/*"
+}
+
+// authorizeAllClasses authorizes the verb on every class of the kind, as
+// listing them reveals the objects of all classes. Without any classes, the
+// kind as a whole is authorized.
+func (m *Manager) authorizeAllClasses(principal *models.Principal, verb string,
+	k kind.Kind) error {
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	s := sch.SemanticSchemaFor(k)
+	if s == nil || len(s.Classes) == 0 {
+		return m.authorizer.Authorize(principal, verb, kindResource(k, "", ""))
+	}
+
+	for _, class := range s.Classes {
+		err := m.authorizer.Authorize(principal, verb, kindResource(k, class.Class, ""))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorizeResources authorizes the verb on every distinct resource, such as
+// the classes of all objects in a batch. Without any resources, the fallback
+// resources are authorized instead.
+func authorizeResources(a authorizer, principal *models.Principal, verb string,
+	resources []string, fallback ...string) error {
+	if len(resources) == 0 {
+		resources = fallback
+	}
+
+	authorized := map[string]struct{}{}
+	for _, resource := range resources {
+		if _, ok := authorized[resource]; ok {
+			continue
+		}
+
+		if err := a.Authorize(principal, verb, resource); err != nil {
+			return err
+		}
+		authorized[resource] = struct{}{}
+	}
+
+	return nil
+}
+
+// thingClassName returns the class of a thing provided in a request body. If
+// the body is missing, the class is omitted from the resource and the
+// operation rejects the missing body once it is authorized.
+func thingClassName(thing *models.Thing) string {
+	if thing == nil {
+		return ""
+	}
+
+	return thing.Class
+}
+
+// actionClassName is the counterpart to thingClassName
+func actionClassName(action *models.Action) string {
+	if action == nil {
+		return ""
+	}
+
+	return action.Class
+}
+
+// batchDeleteClassName returns the class which is matched by a batch
+// delete. If the match is missing, the class is omitted from the resource and
+// the operation rejects the invalid match once it is authorized.
+func batchDeleteClassName(params *models.BatchDelete) string {
+	if params == nil || params.Match == nil {
+		return ""
+	}
+
+	return params.Match.Class
+}
+
+// batchMergeClassName is the counterpart to batchDeleteClassName
+func batchMergeClassName(params *models.BatchMerge) string {
+	if params == nil || params.Match == nil {
+		return ""
+	}
+
+	return params.Match.Class
+}
+
+// existingKindResource looks up the class of an existing thing or action to
+// build its resource. If it cannot be found, the class is omitted, so that
+// only permissions which are not bound to a class apply. The actual operation
+// then fails with the appropriate error.
+func (m *Manager) existingKindResource(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) string {
+	lookup := m.vectorRepo.ThingByID
+	if k == kind.Action {
+		lookup = m.vectorRepo.ActionByID
+	}
+
+	res, err := lookup(ctx, id, nil,
+		traverser.UnderscoreProperties{})
+	if err != nil || res == nil {
+		return kindResource(k, "", id)
+	}
+
+	return kindResource(k, res.ClassName, id)
+}
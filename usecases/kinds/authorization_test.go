@@ -20,10 +20,13 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,6 +43,31 @@ func Test_Kinds_Authorization(t *testing.T) {
 
 	tests := []testCase{
 		// single kind
+		testCase{
+			methodName:       "AddThing",
+			additionalArgs:   []interface{}{&models.Thing{Class: "Foo"}},
+			expectedVerb:     "create",
+			expectedResource: "things/Foo",
+		},
+		testCase{
+			methodName:       "AddAction",
+			additionalArgs:   []interface{}{&models.Action{Class: "Foo"}},
+			expectedVerb:     "create",
+			expectedResource: "actions/Foo",
+		},
+		testCase{
+			methodName:       "ValidateThing",
+			additionalArgs:   []interface{}{&models.Thing{Class: "Foo"}},
+			expectedVerb:     "validate",
+			expectedResource: "things/Foo",
+		},
+		testCase{
+			methodName:       "ValidateAction",
+			additionalArgs:   []interface{}{&models.Action{Class: "Foo"}},
+			expectedVerb:     "validate",
+			expectedResource: "actions/Foo",
+		},
+		// without a body the class is omitted from the resource
 		testCase{
 			methodName:       "AddThing",
 			additionalArgs:   []interface{}{(*models.Thing)(nil)},
@@ -68,61 +96,61 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "GetThing",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.UnderscoreProperties{}},
 			expectedVerb:     "get",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "GetAction",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.UnderscoreProperties{}},
 			expectedVerb:     "get",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 		testCase{
 			methodName:       "DeleteThing",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "DeleteAction",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 		testCase{
 			methodName:       "UpdateThing",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "MergeThing",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "UpdateAction",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Action)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 		testCase{
 			methodName:       "MergeAction",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Action)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 
 		// list kinds
 		testCase{
 			methodName:       "GetThings",
-			additionalArgs:   []interface{}{(*int64)(nil), traverser.UnderscoreProperties{}},
+			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), (*strfmt.UUID)(nil), (*string)(nil), (*string)(nil), traverser.UnderscoreProperties{}},
 			expectedVerb:     "list",
 			expectedResource: "things",
 		},
 		testCase{
 			methodName:       "GetActions",
-			additionalArgs:   []interface{}{(*int64)(nil), traverser.UnderscoreProperties{}},
+			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), (*strfmt.UUID)(nil), (*string)(nil), (*string)(nil), traverser.UnderscoreProperties{}},
 			expectedVerb:     "list",
 			expectedResource: "actions",
 		},
@@ -132,37 +160,37 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "AddThingReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "AddActionReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 		testCase{
 			methodName:       "DeleteThingReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "DeleteActionReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 		testCase{
 			methodName:       "UpdateThingReferences",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (models.MultipleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/Foo/foo",
 		},
 		testCase{
 			methodName:       "UpdateActionReferences",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (models.MultipleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/Foo/foo",
 		},
 	}
 
@@ -190,6 +218,10 @@ func Test_Kinds_Authorization(t *testing.T) {
 			projector := &fakeProjector{}
 			vectorizer := &fakeVectorizer{}
 			vectorRepo := &fakeVectorRepo{}
+			vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+				Return(&search.Result{ClassName: "Foo"}, nil)
+			vectorRepo.On("ActionByID", mock.Anything, mock.Anything, mock.Anything).
+				Return(&search.Result{ClassName: "Foo"}, nil)
 			manager := NewManager(locks, schemaManager, network,
 				cfg, logger, authorizer, vectorizer, vectorRepo, extender, projector)
 
@@ -218,21 +250,49 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			methodName:       "AddActions",
 			additionalArgs:   []interface{}{[]*models.Action{}, []*string{}},
 			expectedVerb:     "create",
-			expectedResource: "batch/actions",
+			expectedResource: "actions",
 		},
 
 		testCase{
 			methodName:       "AddThings",
 			additionalArgs:   []interface{}{[]*models.Thing{}, []*string{}},
 			expectedVerb:     "create",
-			expectedResource: "batch/things",
+			expectedResource: "things",
 		},
 
 		testCase{
 			methodName:       "AddReferences",
 			additionalArgs:   []interface{}{[]*models.BatchReference{}},
 			expectedVerb:     "update",
-			expectedResource: "batch/*",
+			expectedResource: "things",
+		},
+
+		testCase{
+			methodName:       "DeleteThings",
+			additionalArgs:   []interface{}{&models.BatchDelete{}},
+			expectedVerb:     "delete",
+			expectedResource: "things/*",
+		},
+
+		testCase{
+			methodName:       "DeleteActions",
+			additionalArgs:   []interface{}{&models.BatchDelete{}},
+			expectedVerb:     "delete",
+			expectedResource: "actions/*",
+		},
+
+		testCase{
+			methodName:       "MergeThings",
+			additionalArgs:   []interface{}{&models.BatchMerge{}},
+			expectedVerb:     "update",
+			expectedResource: "things/*",
+		},
+
+		testCase{
+			methodName:       "MergeActions",
+			additionalArgs:   []interface{}{&models.BatchMerge{}},
+			expectedVerb:     "update",
+			expectedResource: "actions/*",
 		},
 	}
 
@@ -272,6 +332,127 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Kinds_AuthorizationPerClass(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{{Class: "Article"}, {Class: "Journal"}},
+			},
+			Actions: &models.Schema{
+				Classes: []*models.Class{{Class: "Publish"}},
+			},
+		},
+	}
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return([]search.Result{}, nil)
+	vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return([]search.Result{}, nil)
+	vectorRepo.On("BatchPutThings", mock.Anything).Return(nil)
+	vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+	vectorizer := &fakeVectorizer{}
+	vectorizer.On("Thing", mock.Anything).Return([]float32{1, 2, 3}, nil)
+
+	newManagers := func(authorizer authorizer) (*Manager, *BatchManager) {
+		return NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+				&config.WeaviateConfig{}, logger, authorizer, vectorizer,
+				vectorRepo, &fakeExtender{}, &fakeProjector{}),
+			NewBatchManager(vectorRepo, vectorizer, &fakeLocks{},
+				schemaManager, &fakeNetwork{}, &config.WeaviateConfig{}, logger,
+				authorizer)
+	}
+
+	t.Run("listing things authorizes every thing class", func(t *testing.T) {
+		authorizer := &authRecorder{}
+		manager, _ := newManagers(authorizer)
+
+		_, err := manager.GetThings(context.Background(), principal, nil, nil,
+			nil, nil, nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "list", "things/Article"},
+			{principal, "list", "things/Journal"},
+		}, authorizer.calls)
+	})
+
+	t.Run("listing actions authorizes every action class", func(t *testing.T) {
+		authorizer := &authRecorder{}
+		manager, _ := newManagers(authorizer)
+
+		_, err := manager.GetActions(context.Background(), principal, nil, nil,
+			nil, nil, nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "list", "actions/Publish"},
+		}, authorizer.calls)
+	})
+
+	t.Run("listing is denied if a single class is denied", func(t *testing.T) {
+		authorizer := &authRecorder{deny: "things/Journal"}
+		manager, _ := newManagers(authorizer)
+
+		_, err := manager.GetThings(context.Background(), principal, nil, nil,
+			nil, nil, nil, traverser.UnderscoreProperties{})
+		assert.Equal(t, errors.New("just a test fake"), err)
+	})
+
+	t.Run("a batch import authorizes every distinct class once", func(t *testing.T) {
+		authorizer := &authRecorder{}
+		_, batchManager := newManagers(authorizer)
+
+		_, err := batchManager.AddThings(context.Background(), principal,
+			[]*models.Thing{{Class: "Article"}, {Class: "Journal"}, {Class: "Article"}},
+			nil)
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "create", "things/Article"},
+			{principal, "create", "things/Journal"},
+		}, authorizer.calls)
+	})
+
+	t.Run("a batch of references authorizes their source classes", func(t *testing.T) {
+		authorizer := &authRecorder{}
+		_, batchManager := newManagers(authorizer)
+
+		_, err := batchManager.AddReferences(context.Background(), principal,
+			[]*models.BatchReference{{
+				From: "weaviate://localhost/things/Article/" +
+					"5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc/cites",
+				To: "weaviate://localhost/things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cd",
+			}})
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "update", "things/Article"},
+		}, authorizer.calls)
+	})
+
+	t.Run("a batch delete authorizes every object of the matched class", func(t *testing.T) {
+		authorizer := &authRecorder{deny: "things/Article/*"}
+		_, batchManager := newManagers(authorizer)
+
+		_, err := batchManager.DeleteThings(context.Background(), principal,
+			&models.BatchDelete{Match: &models.BatchDeleteMatch{Class: "Article"}})
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "delete", "things/Article/*"},
+		}, authorizer.calls)
+	})
+
+	t.Run("a batch merge authorizes every object of the matched class", func(t *testing.T) {
+		authorizer := &authRecorder{deny: "actions/Publish/*"}
+		_, batchManager := newManagers(authorizer)
+
+		_, err := batchManager.MergeActions(context.Background(), principal,
+			&models.BatchMerge{Match: &models.BatchMergeMatch{Class: "Publish"}})
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "update", "actions/Publish/*"},
+		}, authorizer.calls)
+	})
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -287,6 +468,21 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// authRecorder allows everything except for the denied resource
+type authRecorder struct {
+	calls []authorizeCall
+	deny  string
+}
+
+func (a *authRecorder) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	if resource == a.deny {
+		return errors.New("just a test fake")
+	}
+
+	return nil
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
@@ -25,12 +25,19 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 )
 
 // AddActions Class Instances in batch to the connected DB
 func (b *BatchManager) AddActions(ctx context.Context, principal *models.Principal,
 	classes []*models.Action, fields []*string) (BatchActions, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/actions")
+	resources := make([]string, len(classes))
+	for i, action := range classes {
+		resources[i] = kindResource(kind.Action, actionClassName(action), "")
+	}
+
+	err := authorizeResources(b.authorizer, principal, "create", resources,
+		kindResource(kind.Action, "", ""))
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +137,10 @@ func (b *BatchManager) validateAction(ctx context.Context, principal *models.Pri
 	err = validation.New(s, b.exists, b.network, b.config).Action(ctx, action)
 	ec.add(err)
 
-	vector, source, err := b.vectorizer.Action(ctx, action)
+	vector, source, err := obtainVector(s, kind.Action, action.Class, concept.Vector,
+		func() ([]float32, []vectorizer.InputElement, error) {
+			return b.vectorizer.Action(ctx, action)
+		}, b.vectorizerDims)
 	ec.add(err)
 
 	if action.Meta == nil {
@@ -174,7 +184,13 @@ func actionsChanToSlice(c chan BatchAction) BatchActions {
 // AddThings Class Instances in batch to the connected DB
 func (b *BatchManager) AddThings(ctx context.Context, principal *models.Principal,
 	classes []*models.Thing, fields []*string) (BatchThings, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/things")
+	resources := make([]string, len(classes))
+	for i, thing := range classes {
+		resources[i] = kindResource(kind.Thing, thingClassName(thing), "")
+	}
+
+	err := authorizeResources(b.authorizer, principal, "create", resources,
+		kindResource(kind.Thing, "", ""))
 	if err != nil {
 		return nil, err
 	}
@@ -275,7 +291,10 @@ func (b *BatchManager) validateThing(ctx context.Context, principal *models.Prin
 	err = validation.New(s, b.exists, b.network, b.config).Thing(ctx, thing)
 	ec.add(err)
 
-	vector, source, err := b.vectorizer.Thing(ctx, thing)
+	vector, source, err := obtainVector(s, kind.Thing, thing.Class, concept.Vector,
+		func() ([]float32, []vectorizer.InputElement, error) {
+			return b.vectorizer.Thing(ctx, thing)
+		}, b.vectorizerDims)
 	ec.add(err)
 
 	if thing.Meta == nil {
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// BatchDeleteResponse contains the validated request of a batch delete
+// alongside its result
+type BatchDeleteResponse struct {
+	Match  *models.BatchDeleteMatch
+	Output string
+	Params BatchDeleteParams
+	Result BatchDeleteResult
+}
+
+// DeleteThings deletes all things of a class which match the where filter of
+// the request. With dryRun set, the matching things are only listed.
+func (b *BatchManager) DeleteThings(ctx context.Context, principal *models.Principal,
+	params *models.BatchDelete) (*BatchDeleteResponse, error) {
+	err := b.authorizer.Authorize(principal, "delete",
+		allObjectsResource(kind.Thing, batchDeleteClassName(params)))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.deleteObjects(ctx, principal, kind.Thing, params)
+}
+
+// DeleteActions deletes all actions of a class which match the where filter
+// of the request. With dryRun set, the matching actions are only listed.
+func (b *BatchManager) DeleteActions(ctx context.Context, principal *models.Principal,
+	params *models.BatchDelete) (*BatchDeleteResponse, error) {
+	err := b.authorizer.Authorize(principal, "delete",
+		allObjectsResource(kind.Action, batchDeleteClassName(params)))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.deleteObjects(ctx, principal, kind.Action, params)
+}
+
+func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
+	k kind.Kind, params *models.BatchDelete) (*BatchDeleteResponse, error) {
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, NewErrInternal("could not get schema: %v", err)
+	}
+
+	deleteParams, output, err := validateBatchDelete(s, k, params)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid param 'body': %v", err)
+	}
+
+	res, err := b.vectorRepo.BatchDeleteObjects(ctx, deleteParams)
+	if err != nil {
+		return nil, NewErrInternal("batch delete: %v", err)
+	}
+
+	return &BatchDeleteResponse{
+		Match:  params.Match,
+		Output: output,
+		Params: deleteParams,
+		Result: res,
+	}, nil
+}
+
+func validateBatchDelete(s schema.Schema, k kind.Kind,
+	params *models.BatchDelete) (BatchDeleteParams, string, error) {
+	if params == nil || params.Match == nil {
+		return BatchDeleteParams{}, "", fmt.Errorf("field 'match' cannot be empty")
+	}
+
+	className, filter, err := validateBatchMatch(s, k, params.Match.Class, params.Match.Where)
+	if err != nil {
+		return BatchDeleteParams{}, "", err
+	}
+
+	output, err := validateBatchOutput(params.Output)
+	if err != nil {
+		return BatchDeleteParams{}, "", err
+	}
+
+	return BatchDeleteParams{
+		Kind:      k,
+		ClassName: className,
+		Filters:   filter,
+		DryRun:    params.DryRun != nil && *params.DryRun,
+	}, output, nil
+}
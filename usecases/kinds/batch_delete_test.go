@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_DeleteThings(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *BatchManager
+	)
+
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{
+							Name:     "name",
+							DataType: []string{"string"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		config := &config.WeaviateConfig{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		vectorizer := &fakeVectorizer{}
+		manager = NewBatchManager(vectorRepo, vectorizer, locks,
+			schemaManager, nil, config, logger, authorizer)
+	}
+
+	ctx := context.Background()
+	where := func(path string) *models.WhereFilter {
+		value := "bar"
+		return &models.WhereFilter{
+			Operator:    models.WhereFilterOperatorEqual,
+			Path:        []string{path},
+			ValueString: &value,
+		}
+	}
+	ptBool := func(in bool) *bool { return &in }
+	ptString := func(in string) *string { return &in }
+
+	invalidInputs := []struct {
+		name          string
+		params        *models.BatchDelete
+		expectedError string
+	}{
+		{
+			name:          "without a match",
+			params:        &models.BatchDelete{},
+			expectedError: "invalid param 'body': field 'match' cannot be empty",
+		},
+		{
+			name: "without a class",
+			params: &models.BatchDelete{
+				Match: &models.BatchDeleteMatch{Where: where("name")},
+			},
+			expectedError: "invalid param 'body': field 'match.class' cannot be empty",
+		},
+		{
+			name: "with a class which does not exist",
+			params: &models.BatchDelete{
+				Match: &models.BatchDeleteMatch{Class: "Bar", Where: where("name")},
+			},
+			expectedError: "invalid param 'body': field 'match.class': no thing class \"Bar\" in the schema",
+		},
+		{
+			name: "without a where filter",
+			params: &models.BatchDelete{
+				Match: &models.BatchDeleteMatch{Class: "Foo"},
+			},
+			expectedError: "invalid param 'body': field 'match.where' cannot be empty",
+		},
+		{
+			name: "with a filter on a property which does not exist",
+			params: &models.BatchDelete{
+				Match: &models.BatchDeleteMatch{Class: "Foo", Where: where("nope")},
+			},
+			expectedError: "invalid param 'body': field 'match.where': " +
+				"no such prop with name 'nope' found in class 'Foo' in the schema. " +
+				"Check your schema files for which properties in this class are available",
+		},
+		{
+			name: "with an invalid output",
+			params: &models.BatchDelete{
+				Match:  &models.BatchDeleteMatch{Class: "Foo", Where: where("name")},
+				Output: ptString("everything"),
+			},
+			expectedError: "invalid param 'body': field 'output': must be one of " +
+				"\"minimal\", \"verbose\", got \"everything\"",
+		},
+	}
+
+	for _, test := range invalidInputs {
+		t.Run(test.name, func(t *testing.T) {
+			reset()
+
+			_, err := manager.DeleteThings(ctx, nil, test.params)
+
+			require.NotNil(t, err)
+			assert.IsType(t, ErrInvalidUserInput{}, err)
+			assert.Equal(t, test.expectedError, err.Error())
+			vectorRepo.AssertNotCalled(t, "BatchDeleteObjects", mock.Anything)
+		})
+	}
+
+	t.Run("with a valid dry run", func(t *testing.T) {
+		reset()
+		result := BatchDeleteResult{
+			Matches: 1,
+			Objects: BatchDeleteObjects{
+				{UUID: strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")},
+			},
+		}
+		vectorRepo.On("BatchDeleteObjects", mock.Anything).Return(result, nil).Once()
+
+		res, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			DryRun: ptBool(true),
+			Match:  &models.BatchDeleteMatch{Class: "Foo", Where: where("name")},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, result, res.Result)
+		assert.Equal(t, BatchOutputMinimal, res.Output)
+
+		params := vectorRepo.Calls[0].Arguments[0].(BatchDeleteParams)
+		assert.Equal(t, kind.Thing, params.Kind)
+		assert.Equal(t, "Foo", params.ClassName.String())
+		assert.True(t, params.DryRun)
+		require.NotNil(t, params.Filters)
+		assert.Equal(t, "name", params.Filters.Root.On.Property.String())
+	})
+
+	t.Run("when the repo errors", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchDeleteObjects", mock.Anything).
+			Return(BatchDeleteResult{}, errors.New("oops")).Once()
+
+		_, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Match:  &models.BatchDeleteMatch{Class: "Foo", Where: where("name")},
+			Output: ptString(BatchOutputVerbose),
+		})
+
+		assert.Equal(t, NewErrInternal("batch delete: oops"), err)
+	})
+}
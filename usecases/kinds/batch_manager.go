@@ -14,6 +14,10 @@ package kinds
 import (
 	"context"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus"
 )
@@ -29,6 +33,8 @@ type BatchManager struct {
 	authorizer    authorizer
 	vectorRepo    BatchVectorRepo
 	vectorizer    Vectorizer
+
+	vectorizerDims *vectorizerDimensions
 }
 
 type BatchVectorRepo interface {
@@ -40,6 +46,9 @@ type batchRepoNew interface {
 	BatchPutThings(ctx context.Context, things BatchThings) (BatchThings, error)
 	BatchPutActions(ctx context.Context, actions BatchActions) (BatchActions, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences) (BatchReferences, error)
+	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams) (BatchDeleteResult, error)
+	FindObjectIDs(ctx context.Context, k kind.Kind, className schema.ClassName,
+		filters *filters.LocalFilter) ([]strfmt.UUID, error)
 }
 
 // NewBatchManager creates a new manager
@@ -54,5 +63,7 @@ func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer Vectorizer, locks lo
 		vectorRepo:    vectorRepo,
 		vectorizer:    vectorizer,
 		authorizer:    authorizer,
+
+		vectorizerDims: newVectorizerDimensions(),
 	}
 }
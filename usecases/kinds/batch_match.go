@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+const (
+	// BatchOutputMinimal only lists the objects which could not be altered in
+	// the response of a batch delete or merge
+	BatchOutputMinimal = "minimal"
+	// BatchOutputVerbose lists every matched object in the response of a batch
+	// delete or merge
+	BatchOutputVerbose = "verbose"
+)
+
+// validateBatchMatch validates the class and where filter which select the
+// objects of a batch delete or merge
+func validateBatchMatch(s schema.Schema, k kind.Kind, class string,
+	where *models.WhereFilter) (schema.ClassName, *filters.LocalFilter, error) {
+	if class == "" {
+		return "", nil, fmt.Errorf("field 'match.class' cannot be empty")
+	}
+
+	className := schema.ClassName(class)
+	if s.GetClass(k, className) == nil {
+		return "", nil, fmt.Errorf("field 'match.class': no %s class %q in the schema",
+			k.Name(), className)
+	}
+
+	// altering all objects of a class without a filter is almost certainly a
+	// mistake
+	if where == nil {
+		return "", nil, fmt.Errorf("field 'match.where' cannot be empty")
+	}
+
+	filter, err := filterext.Parse(where)
+	if err != nil {
+		return "", nil, fmt.Errorf("field 'match.where': %v", err)
+	}
+
+	if err := validateBatchMatchClause(s, k, className, filter.Root); err != nil {
+		return "", nil, fmt.Errorf("field 'match.where': %v", err)
+	}
+
+	return className, filter, nil
+}
+
+// validateBatchMatchClause makes sure that every clause of the filter points
+// to a property of the class, so that a typo leads to a helpful error rather
+// than matching nothing
+func validateBatchMatchClause(s schema.Schema, k kind.Kind,
+	className schema.ClassName, clause *filters.Clause) error {
+	for i := range clause.Operands {
+		if err := validateBatchMatchClause(s, k, className, &clause.Operands[i]); err != nil {
+			return err
+		}
+	}
+
+	if clause.On == nil {
+		return nil
+	}
+
+	if _, err := s.GetProperty(k, className, clause.On.Property); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateBatchOutput(output *string) (string, error) {
+	if output == nil {
+		return BatchOutputMinimal, nil
+	}
+
+	if *output != BatchOutputMinimal && *output != BatchOutputVerbose {
+		return "", fmt.Errorf("field 'output': must be one of %q, %q, got %q",
+			BatchOutputMinimal, BatchOutputVerbose, *output)
+	}
+
+	return *output, nil
+}
@@ -0,0 +1,335 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+// batchMergeChunkSize is the amount of objects which are merged concurrently,
+// progress is reported after each chunk
+const batchMergeChunkSize = 100
+
+// BatchMergeResponse contains the validated request of a batch merge
+// alongside its result
+type BatchMergeResponse struct {
+	Match  *models.BatchMergeMatch
+	Output string
+	DryRun bool
+	Result BatchMergeResult
+}
+
+type batchMergeParams struct {
+	kind      kind.Kind
+	className schema.ClassName
+	schema    map[string]interface{}
+	dryRun    bool
+	output    string
+
+	// revectorize is set if the merge alters a property which is part of the
+	// vector, otherwise the previous vector of each object is kept
+	revectorize bool
+}
+
+// MergeThings merges the properties of the request into all things of a
+// class which match the where filter. With dryRun set, the matching things
+// are only listed.
+func (b *BatchManager) MergeThings(ctx context.Context, principal *models.Principal,
+	params *models.BatchMerge) (*BatchMergeResponse, error) {
+	err := b.authorizer.Authorize(principal, "update",
+		allObjectsResource(kind.Thing, batchMergeClassName(params)))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.mergeObjects(ctx, principal, kind.Thing, params)
+}
+
+// MergeActions merges the properties of the request into all actions of a
+// class which match the where filter. With dryRun set, the matching actions
+// are only listed.
+func (b *BatchManager) MergeActions(ctx context.Context, principal *models.Principal,
+	params *models.BatchMerge) (*BatchMergeResponse, error) {
+	err := b.authorizer.Authorize(principal, "update",
+		allObjectsResource(kind.Action, batchMergeClassName(params)))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.mergeObjects(ctx, principal, kind.Action, params)
+}
+
+func (b *BatchManager) mergeObjects(ctx context.Context, principal *models.Principal,
+	k kind.Kind, params *models.BatchMerge) (*BatchMergeResponse, error) {
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, NewErrInternal("could not get schema: %v", err)
+	}
+
+	mergeParams, filter, err := b.validateBatchMerge(ctx, s, k, params)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid param 'body': %v", err)
+	}
+
+	ids, err := b.vectorRepo.FindObjectIDs(ctx, k, mergeParams.className, filter)
+	if err != nil {
+		return nil, NewErrInternal("batch merge: find matches: %v", err)
+	}
+
+	objects := make(BatchMergeObjects, len(ids))
+	for i, id := range ids {
+		objects[i] = BatchMergeObject{UUID: id}
+	}
+
+	if !mergeParams.dryRun {
+		b.mergeObjectsInChunks(ctx, s, mergeParams, objects)
+	}
+
+	return &BatchMergeResponse{
+		Match:  params.Match,
+		Output: mergeParams.output,
+		DryRun: mergeParams.dryRun,
+		Result: BatchMergeResult{
+			Matches: int64(len(ids)),
+			Objects: objects,
+		},
+	}, nil
+}
+
+func (b *BatchManager) validateBatchMerge(ctx context.Context, s schema.Schema,
+	k kind.Kind, params *models.BatchMerge) (batchMergeParams, *filters.LocalFilter, error) {
+	if params == nil || params.Match == nil {
+		return batchMergeParams{}, nil, fmt.Errorf("field 'match' cannot be empty")
+	}
+
+	className, filter, err := validateBatchMatch(s, k, params.Match.Class, params.Match.Where)
+	if err != nil {
+		return batchMergeParams{}, nil, err
+	}
+
+	props, ok := params.Schema.(map[string]interface{})
+	if !ok || len(props) == 0 {
+		return batchMergeParams{}, nil, fmt.Errorf("field 'schema' cannot be empty")
+	}
+
+	// the same properties are merged into every object, so they only need to
+	// be validated once
+	validator := validation.New(s, b.exists, b.network, b.config)
+	switch k {
+	case kind.Thing:
+		err = validator.Thing(ctx, &models.Thing{Class: className.String(), Schema: props})
+	case kind.Action:
+		err = validator.Action(ctx, &models.Action{Class: className.String(), Schema: props})
+	}
+	if err != nil {
+		return batchMergeParams{}, nil, fmt.Errorf("field 'schema': %v", err)
+	}
+
+	output, err := validateBatchOutput(params.Output)
+	if err != nil {
+		return batchMergeParams{}, nil, err
+	}
+
+	return batchMergeParams{
+		kind:        k,
+		className:   className,
+		schema:      props,
+		dryRun:      params.DryRun != nil && *params.DryRun,
+		output:      output,
+		revectorize: mergeAltersVector(s.GetClass(k, className), props),
+	}, filter, nil
+}
+
+// mergeAltersVector mirrors which properties the vectorizer considers: only
+// indexed properties with string values are part of the vector
+func mergeAltersVector(class *models.Class, props map[string]interface{}) bool {
+	if schemaUC.Vectorizer(class) == schemaUC.VectorizerNone {
+		// the vector was provided by the user, it can't be recomputed
+		return false
+	}
+
+	for _, prop := range class.Properties {
+		value, ok := props[prop.Name]
+		if !ok {
+			continue
+		}
+
+		if prop.Index != nil && !*prop.Index {
+			continue
+		}
+
+		if _, ok := value.(string); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *BatchManager) mergeObjectsInChunks(ctx context.Context, s schema.Schema,
+	params batchMergeParams, objects BatchMergeObjects) {
+	failed := 0
+	for start := 0; start < len(objects); start += batchMergeChunkSize {
+		end := start + batchMergeChunkSize
+		if end > len(objects) {
+			end = len(objects)
+		}
+
+		if err := ctx.Err(); err != nil {
+			// the request was aborted, none of the remaining objects will be
+			// merged
+			for i := start; i < len(objects); i++ {
+				objects[i].Err = err
+			}
+			failed += len(objects) - start
+			break
+		}
+
+		wg := &sync.WaitGroup{}
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				objects[i].Err = b.mergeObject(ctx, params, objects[i].UUID)
+			}(i)
+		}
+		wg.Wait()
+
+		for i := start; i < end; i++ {
+			if objects[i].Err != nil {
+				failed++
+			}
+		}
+
+		b.logger.
+			WithField("action", "batch_merge").
+			WithField("kind", params.kind).
+			WithField("class", params.className).
+			WithField("processed", end).
+			WithField("total", len(objects)).
+			WithField("failed", failed).
+			Info("batch merge progress")
+	}
+}
+
+func (b *BatchManager) mergeObject(ctx context.Context, params batchMergeParams,
+	id strfmt.UUID) error {
+	previous, err := b.objectByID(ctx, params.kind, id)
+	if err != nil {
+		return fmt.Errorf("get previous object: %v", err)
+	}
+
+	if previous == nil {
+		return fmt.Errorf("%s object with id '%s' does not exist anymore", params.kind.Name(), id)
+	}
+
+	primitive, refs := splitPrimitiveAndRefs(params.schema, params.className.String(),
+		id, params.kind)
+
+	// the merge must always carry a vector, as the repos would otherwise
+	// overwrite the previous one
+	vector := previous.Vector
+	var underscore models.UnderscoreProperties
+	if previous.UnderscoreProperties != nil {
+		underscore = *previous.UnderscoreProperties
+	}
+
+	if params.revectorize {
+		v, source, err := b.vectorizeMerged(ctx, params, previous, primitive)
+		if err != nil {
+			return fmt.Errorf("vectorize merged: %v", err)
+		}
+
+		vector = v
+		underscore.Interpretation = &models.Interpretation{
+			Source: sourceFromInputElements(source),
+		}
+	}
+
+	err = b.vectorRepo.Merge(ctx, MergeDocument{
+		Kind:                 params.kind,
+		Class:                params.className.String(),
+		ID:                   id,
+		PrimitiveSchema:      primitive,
+		References:           refs,
+		Vector:               vector,
+		UpdateTime:           unixNow(),
+		UnderscoreProperties: underscore,
+	})
+	if err != nil {
+		return fmt.Errorf("repo: %v", err)
+	}
+
+	return nil
+}
+
+func (b *BatchManager) objectByID(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (*search.Result, error) {
+	underscore := traverser.UnderscoreProperties{Interpretation: true}
+	switch k {
+	case kind.Thing:
+		return b.vectorRepo.ThingByID(ctx, id, nil, underscore)
+	case kind.Action:
+		return b.vectorRepo.ActionByID(ctx, id, nil, underscore)
+	default:
+		return nil, fmt.Errorf("impossible kind: %v", k)
+	}
+}
+
+func (b *BatchManager) vectorizeMerged(ctx context.Context, params batchMergeParams,
+	previous *search.Result, primitive map[string]interface{}) ([]float32, []vectorizer.InputElement, error) {
+	// build a new map rather than altering the previous schema, so that
+	// concurrent merges never share state
+	merged := map[string]interface{}{}
+	if old, ok := previous.Schema.(map[string]interface{}); ok {
+		for key, value := range old {
+			merged[key] = value
+		}
+	}
+	for key, value := range primitive {
+		merged[key] = value
+	}
+
+	switch params.kind {
+	case kind.Thing:
+		return b.vectorizer.Thing(ctx, &models.Thing{Class: params.className.String(), Schema: merged})
+	case kind.Action:
+		return b.vectorizer.Action(ctx, &models.Action{Class: params.className.String(), Schema: merged})
+	default:
+		return nil, nil, fmt.Errorf("impossible kind: %v", params.kind)
+	}
+}
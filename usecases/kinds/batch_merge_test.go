@@ -0,0 +1,263 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_MergeActions(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		vectorizer *fakeVectorizer
+		manager    *BatchManager
+	)
+
+	notIndexed := false
+	testSchema := schema.Schema{
+		Actions: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{
+							Name:     "title",
+							DataType: []string{"string"},
+						},
+						{
+							Name:     "source",
+							DataType: []string{"string"},
+							Index:    &notIndexed,
+						},
+						{
+							Name:     "archived",
+							DataType: []string{"boolean"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		config := &config.WeaviateConfig{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: testSchema,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		vectorizer = &fakeVectorizer{}
+		manager = NewBatchManager(vectorRepo, vectorizer, locks,
+			schemaManager, nil, config, logger, authorizer)
+	}
+
+	ctx := context.Background()
+	value := "nyt"
+	where := &models.WhereFilter{
+		Operator:    models.WhereFilterOperatorEqual,
+		Path:        []string{"source"},
+		ValueString: &value,
+	}
+	ptBool := func(in bool) *bool { return &in }
+	ptString := func(in string) *string { return &in }
+
+	ids := []strfmt.UUID{
+		"3c1d2b4a-6f7e-4a8b-9c0d-1e2f3a4b5c01",
+		"3c1d2b4a-6f7e-4a8b-9c0d-1e2f3a4b5c02",
+	}
+	previous := func(id strfmt.UUID) *search.Result {
+		return &search.Result{
+			ID:        id,
+			Kind:      kind.Action,
+			ClassName: "Article",
+			Schema: map[string]interface{}{
+				"title":  "some title",
+				"source": "nyt",
+			},
+			Vector: []float32{1, 2, 3},
+		}
+	}
+
+	invalidInputs := []struct {
+		name          string
+		params        *models.BatchMerge
+		expectedError string
+	}{
+		{
+			name:          "without a match",
+			params:        &models.BatchMerge{},
+			expectedError: "invalid param 'body': field 'match' cannot be empty",
+		},
+		{
+			name: "without a where filter",
+			params: &models.BatchMerge{
+				Match:  &models.BatchMergeMatch{Class: "Article"},
+				Schema: map[string]interface{}{"archived": true},
+			},
+			expectedError: "invalid param 'body': field 'match.where' cannot be empty",
+		},
+		{
+			name: "without any properties",
+			params: &models.BatchMerge{
+				Match: &models.BatchMergeMatch{Class: "Article", Where: where},
+			},
+			expectedError: "invalid param 'body': field 'schema' cannot be empty",
+		},
+		{
+			name: "with a property of the wrong type",
+			params: &models.BatchMerge{
+				Match:  &models.BatchMergeMatch{Class: "Article", Where: where},
+				Schema: map[string]interface{}{"archived": "yes"},
+			},
+			expectedError: "invalid param 'body': field 'schema': invalid boolean property " +
+				"'archived' on class 'Article': not a bool, but string",
+		},
+		{
+			name: "with an invalid output",
+			params: &models.BatchMerge{
+				Match:  &models.BatchMergeMatch{Class: "Article", Where: where},
+				Schema: map[string]interface{}{"archived": true},
+				Output: ptString("everything"),
+			},
+			expectedError: "invalid param 'body': field 'output': must be one of " +
+				"\"minimal\", \"verbose\", got \"everything\"",
+		},
+	}
+
+	for _, test := range invalidInputs {
+		t.Run(test.name, func(t *testing.T) {
+			reset()
+
+			_, err := manager.MergeActions(ctx, nil, test.params)
+
+			require.NotNil(t, err)
+			assert.IsType(t, ErrInvalidUserInput{}, err)
+			assert.Equal(t, test.expectedError, err.Error())
+			vectorRepo.AssertNotCalled(t, "FindObjectIDs", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("with a dry run", func(t *testing.T) {
+		reset()
+		vectorRepo.On("FindObjectIDs", kind.Action, schema.ClassName("Article"), mock.Anything).
+			Return(ids, nil).Once()
+
+		res, err := manager.MergeActions(ctx, nil, &models.BatchMerge{
+			DryRun: ptBool(true),
+			Match:  &models.BatchMergeMatch{Class: "Article", Where: where},
+			Schema: map[string]interface{}{"archived": true},
+		})
+
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, BatchMergeResult{
+			Matches: 2,
+			Objects: BatchMergeObjects{{UUID: ids[0]}, {UUID: ids[1]}},
+		}, res.Result)
+		vectorRepo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("with a property which is not part of the vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("FindObjectIDs", kind.Action, schema.ClassName("Article"), mock.Anything).
+			Return(ids, nil).Once()
+		for _, id := range ids {
+			vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).
+				Return(previous(id), nil).Once()
+		}
+		vectorRepo.On("Merge", mock.Anything).Return(nil).Twice()
+
+		res, err := manager.MergeActions(ctx, nil, &models.BatchMerge{
+			Match:  &models.BatchMergeMatch{Class: "Article", Where: where},
+			Schema: map[string]interface{}{"archived": true},
+			Output: ptString(BatchOutputVerbose),
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, BatchOutputVerbose, res.Output)
+		assert.Equal(t, BatchMergeObjects{{UUID: ids[0]}, {UUID: ids[1]}}, res.Result.Objects)
+		vectorizer.AssertNotCalled(t, "Action", mock.Anything)
+
+		for _, call := range vectorRepo.Calls {
+			if call.Method != "Merge" {
+				continue
+			}
+
+			merge := call.Arguments[0].(MergeDocument)
+			assert.Equal(t, map[string]interface{}{"archived": true}, merge.PrimitiveSchema)
+			assert.Equal(t, []float32{1, 2, 3}, merge.Vector, "previous vector is kept")
+		}
+	})
+
+	t.Run("with a property which is part of the vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("FindObjectIDs", kind.Action, schema.ClassName("Article"), mock.Anything).
+			Return(ids[:1], nil).Once()
+		vectorRepo.On("ActionByID", ids[0], mock.Anything, mock.Anything).
+			Return(previous(ids[0]), nil).Once()
+		vectorRepo.On("Merge", mock.Anything).Return(nil).Once()
+		vectorizer.On("Action", &models.Action{
+			Class: "Article",
+			Schema: map[string]interface{}{
+				"title":  "new title",
+				"source": "nyt",
+			},
+		}).Return([]float32{4, 5, 6}, nil).Once()
+
+		_, err := manager.MergeActions(ctx, nil, &models.BatchMerge{
+			Match:  &models.BatchMergeMatch{Class: "Article", Where: where},
+			Schema: map[string]interface{}{"title": "new title"},
+		})
+
+		require.Nil(t, err)
+		vectorizer.AssertExpectations(t)
+		merge := vectorRepo.Calls[2].Arguments[0].(MergeDocument)
+		assert.Equal(t, []float32{4, 5, 6}, merge.Vector)
+	})
+
+	t.Run("with errors for single objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("FindObjectIDs", kind.Action, schema.ClassName("Article"), mock.Anything).
+			Return(ids, nil).Once()
+		vectorRepo.On("ActionByID", ids[0], mock.Anything, mock.Anything).
+			Return(previous(ids[0]), nil).Once()
+		vectorRepo.On("ActionByID", ids[1], mock.Anything, mock.Anything).
+			Return((*search.Result)(nil), nil).Once()
+		vectorRepo.On("Merge", mock.Anything).Return(errors.New("oops")).Once()
+
+		res, err := manager.MergeActions(ctx, nil, &models.BatchMerge{
+			Match:  &models.BatchMergeMatch{Class: "Article", Where: where},
+			Schema: map[string]interface{}{"archived": true},
+		})
+
+		require.Nil(t, err)
+		require.Len(t, res.Result.Objects, 2)
+		assert.Equal(t, "repo: oops", res.Result.Objects[0].Err.Error())
+		assert.Equal(t, "action object with id '"+ids[1].String()+"' does not exist anymore",
+			res.Result.Objects[1].Err.Error())
+	})
+}
@@ -19,12 +19,31 @@ import (
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // AddReferences Class Instances in batch to the connected DB
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
 	refs []*models.BatchReference) (BatchReferences, error) {
-	err := b.authorizer.Authorize(principal, "update", "batch/*")
+	// a reference is added to its source object. Sources which cannot be
+	// parsed are not imported and therefore need no permission.
+	var resources []string
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+
+		source, err := crossref.ParseSource(string(ref.From))
+		if err != nil {
+			continue
+		}
+
+		resources = append(resources,
+			kindResource(source.Kind, source.Class.String(), ""))
+	}
+
+	err := authorizeResources(b.authorizer, principal, "update", resources,
+		kindResource(kind.Thing, "", ""), kindResource(kind.Action, "", ""))
 	if err != nil {
 		return nil, err
 	}
@@ -13,8 +13,11 @@ package kinds
 
 import (
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // BatchAction is a helper type that groups all the info about one action in a
@@ -82,6 +85,50 @@ type BatchReference struct {
 // type using the .Response() method
 type BatchReferences []BatchReference
 
+// BatchDeleteParams describes which objects a batch delete applies to, i.e.
+// all objects of a single class which match the filters. If DryRun is set,
+// the matching objects are only listed, but not deleted.
+type BatchDeleteParams struct {
+	Kind      kind.Kind
+	ClassName schema.ClassName
+	Filters   *filters.LocalFilter
+	DryRun    bool
+}
+
+// BatchDeleteResult contains every object matched by a batch delete. Objects
+// which could not be deleted carry an error.
+type BatchDeleteResult struct {
+	Matches int64
+	Objects BatchDeleteObjects
+}
+
+// BatchDeleteObject is the outcome of a batch delete for a single object
+type BatchDeleteObject struct {
+	UUID strfmt.UUID
+	Err  error
+}
+
+// BatchDeleteObjects groups many BatchDeleteObject items together, ordered by
+// their id
+type BatchDeleteObjects []BatchDeleteObject
+
+// BatchMergeResult contains every object matched by a batch merge. Objects
+// which could not be merged carry an error.
+type BatchMergeResult struct {
+	Matches int64
+	Objects BatchMergeObjects
+}
+
+// BatchMergeObject is the outcome of a batch merge for a single object
+type BatchMergeObject struct {
+	UUID strfmt.UUID
+	Err  error
+}
+
+// BatchMergeObjects groups many BatchMergeObject items together, ordered by
+// their id
+type BatchMergeObjects []BatchMergeObject
+
 // // Response uses the information contained in every Reference (from, to, error)
 // // to form the expected response for the Batching request.
 // func (b BatchReferences) Response() []*models.BatchReferenceResponse {
@@ -13,16 +13,16 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 // DeleteAction Class Instance from the conncected DB
 func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal, id strfmt.UUID) error {
-	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "delete", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return err
 	}
@@ -53,7 +53,7 @@ func (m *Manager) deleteActionFromRepo(ctx context.Context, id strfmt.UUID) erro
 
 // DeleteThing Class Instance from the conncected DB
 func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal, id strfmt.UUID) error {
-	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "delete", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return err
 	}
@@ -57,6 +57,10 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 	return f.GetSchemaResponse, nil
 }
 
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.GetSchemaResponse
+}
+
 type fakeLocks struct{}
 
 func (f *fakeLocks) LockConnector() (func() error, error) {
@@ -137,15 +141,17 @@ func (f *fakeVectorRepo) ActionByID(ctx context.Context,
 	return args.Get(0).(*search.Result), args.Error(1)
 }
 
-func (f *fakeVectorRepo) ThingSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, underscores traverser.UnderscoreProperties) (search.Results, error) {
-	args := f.Called(limit, filters, underscores)
+func (f *fakeVectorRepo) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter,
+	underscores traverser.UnderscoreProperties) (search.Results, error) {
+	args := f.Called(pagination, sort, filters, underscores)
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
-func (f *fakeVectorRepo) ActionSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, underscores traverser.UnderscoreProperties) (search.Results, error) {
-	args := f.Called(limit, filters, underscores)
+func (f *fakeVectorRepo) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter,
+	underscores traverser.UnderscoreProperties) (search.Results, error) {
+	args := f.Called(pagination, sort, filters, underscores)
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
@@ -176,6 +182,17 @@ func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchRefe
 	return batch, args.Error(0)
 }
 
+func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDeleteParams) (BatchDeleteResult, error) {
+	args := f.Called(params)
+	return args.Get(0).(BatchDeleteResult), args.Error(1)
+}
+
+func (f *fakeVectorRepo) FindObjectIDs(ctx context.Context, k kind.Kind, className schema.ClassName,
+	filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	args := f.Called(k, className, filters)
+	return args.Get(0).([]strfmt.UUID), args.Error(1)
+}
+
 func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument) error {
 	args := f.Called(merge)
 	return args.Error(0)
@@ -17,7 +17,9 @@ import (
 	"math"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/projector"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
@@ -26,7 +28,7 @@ import (
 // GetThing Class from the connected DB
 func (m *Manager) GetThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, underscore traverser.UnderscoreProperties) (*models.Thing, error) {
-	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "get", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return nil, err
 	}
@@ -51,25 +53,36 @@ func (m *Manager) GetThing(ctx context.Context, principal *models.Principal,
 
 // GetThings Class from the connected DB
 func (m *Manager) GetThings(ctx context.Context, principal *models.Principal,
-	limit *int64, underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
-	err := m.authorizer.Authorize(principal, "list", "things")
+	limit *int64, offset *int64, after *strfmt.UUID, sort *string, order *string,
+	underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
+	err := m.authorizeAllClasses(principal, "list", kind.Thing)
 	if err != nil {
 		return nil, err
 	}
 
+	pagination, err := m.pagination(limit, offset, after)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid pagination: %v", err)
+	}
+
+	sortParams, err := m.sort(sort, order, pagination)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid sort: %v", err)
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
-	return m.getThingsFromRepo(ctx, limit, underscore)
+	return m.getThingsFromRepo(ctx, pagination, sortParams, underscore)
 }
 
 // GetAction Class from connected DB
 func (m *Manager) GetAction(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, underscore traverser.UnderscoreProperties) (*models.Action, error) {
-	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "get", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return nil, err
 	}
@@ -94,19 +107,30 @@ func (m *Manager) GetAction(ctx context.Context, principal *models.Principal,
 
 // GetActions Class from connected DB
 func (m *Manager) GetActions(ctx context.Context, principal *models.Principal,
-	limit *int64, underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
-	err := m.authorizer.Authorize(principal, "list", "actions")
+	limit *int64, offset *int64, after *strfmt.UUID, sort *string, order *string,
+	underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
+	err := m.authorizeAllClasses(principal, "list", kind.Action)
 	if err != nil {
 		return nil, err
 	}
 
+	pagination, err := m.pagination(limit, offset, after)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid pagination: %v", err)
+	}
+
+	sortParams, err := m.sort(sort, order, pagination)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid sort: %v", err)
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
-	return m.getActionsFromRepo(ctx, limit, underscore)
+	return m.getActionsFromRepo(ctx, pagination, sortParams, underscore)
 }
 
 func (m *Manager) getThingFromRepo(ctx context.Context, id strfmt.UUID,
@@ -130,11 +154,9 @@ func (m *Manager) getThingFromRepo(ctx context.Context, id strfmt.UUID,
 	return res, nil
 }
 
-func (m *Manager) getThingsFromRepo(ctx context.Context, limit *int64,
-	underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
-	smartLimit := m.localLimitOrGlobalLimit(limit)
-
-	res, err := m.vectorRepo.ThingSearch(ctx, smartLimit, nil, underscore)
+func (m *Manager) getThingsFromRepo(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
+	res, err := m.vectorRepo.ThingSearch(ctx, pagination, sort, nil, underscore)
 	if err != nil {
 		return nil, NewErrInternal("list things: %v", err)
 	}
@@ -177,11 +199,9 @@ func (m *Manager) getActionFromRepo(ctx context.Context, id strfmt.UUID,
 	return res, nil
 }
 
-func (m *Manager) getActionsFromRepo(ctx context.Context, limit *int64,
-	underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
-	smartLimit := m.localLimitOrGlobalLimit(limit)
-
-	res, err := m.vectorRepo.ActionSearch(ctx, smartLimit, nil, underscore)
+func (m *Manager) getActionsFromRepo(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
+	res, err := m.vectorRepo.ActionSearch(ctx, pagination, sort, nil, underscore)
 	if err != nil {
 		return nil, NewErrInternal("list actions: %v", err)
 	}
@@ -203,6 +223,50 @@ func (m *Manager) getActionsFromRepo(ctx context.Context, limit *int64,
 	return res.Actions(), nil
 }
 
+func (m *Manager) pagination(limit *int64, offset *int64,
+	after *strfmt.UUID) (*filters.Pagination, error) {
+	p := &filters.Pagination{
+		Limit: m.localLimitOrGlobalLimit(limit),
+	}
+
+	if offset != nil {
+		p.Offset = int(*offset)
+	}
+
+	if after != nil {
+		p.After = *after
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (m *Manager) sort(sort *string, order *string,
+	pagination *filters.Pagination) ([]filters.Sort, error) {
+	var props, orders string
+	if sort != nil {
+		props = *sort
+	}
+	if order != nil {
+		orders = *order
+	}
+
+	out, err := filters.SortFromStrings(props, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) > 0 && pagination.After != "" {
+		return nil, fmt.Errorf("parameter 'after' cannot be combined with 'sort', " +
+			"as a cursor requires the results to be ordered by id")
+	}
+
+	return out, nil
+}
+
 func (m *Manager) localLimitOrGlobalLimit(paramMaxResults *int64) int {
 	maxResults := m.config.Config.QueryDefaults.Limit
 	// Get the max results from params, if exists
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
@@ -68,7 +69,7 @@ func Test_GetAction(t *testing.T) {
 		reset()
 		id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
 
-		vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
+		vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Twice()
 
 		_, err := manager.GetAction(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{})
 		assert.Equal(t, NewErrNotFound("no action with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
@@ -83,7 +84,7 @@ func Test_GetAction(t *testing.T) {
 			ClassName: "ActionClass",
 			Schema:    map[string]interface{}{"foo": "bar"},
 		}
-		vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
+		vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(result, nil).Twice()
 
 		expected := &models.Action{
 			ID:            id,
@@ -108,7 +109,7 @@ func Test_GetAction(t *testing.T) {
 				Schema:    map[string]interface{}{"foo": "bar"},
 			},
 		}
-		vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything).Return(results, nil).Once()
+		vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(results, nil).Once()
 
 		expected := []*models.Action{
 			&models.Action{
@@ -119,7 +120,7 @@ func Test_GetAction(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetActions(context.Background(), &models.Principal{}, nil, traverser.UnderscoreProperties{})
+		res, err := manager.GetActions(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, traverser.UnderscoreProperties{})
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -135,7 +136,7 @@ func Test_GetAction(t *testing.T) {
 					ClassName: "ActionClass",
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
-				vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(result, nil).Twice()
 				_, err := manager.GetAction(context.Background(), &models.Principal{}, id,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
@@ -152,7 +153,7 @@ func Test_GetAction(t *testing.T) {
 					ClassName: "ActionClass",
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
-				vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(result, nil).Twice()
 				extender.single = &search.Result{
 					ID:        id,
 					ClassName: "ActionClass",
@@ -207,7 +208,7 @@ func Test_GetAction(t *testing.T) {
 						Schema:    map[string]interface{}{"foo": "bar"},
 					},
 				}
-				vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
 				extender.multi = []search.Result{
 					search.Result{
 						ID:        id,
@@ -245,7 +246,7 @@ func Test_GetAction(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10), nil, nil, nil, nil,
 					traverser.UnderscoreProperties{
 						NearestNeighbors: true,
 					})
@@ -264,7 +265,7 @@ func Test_GetAction(t *testing.T) {
 						Schema:    map[string]interface{}{"foo": "bar"},
 					},
 				}
-				vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ActionSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
 				projectorFake.multi = []search.Result{
 					search.Result{
 						ID:        id,
@@ -292,7 +293,7 @@ func Test_GetAction(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10), nil, nil, nil, nil,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
 					})
@@ -342,7 +343,7 @@ func Test_GetThing(t *testing.T) {
 		reset()
 		id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
 
-		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Twice()
 
 		_, err := manager.GetThing(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{})
 		assert.Equal(t, NewErrNotFound("no thing with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
@@ -357,7 +358,7 @@ func Test_GetThing(t *testing.T) {
 			ClassName: "ThingClass",
 			Schema:    map[string]interface{}{"foo": "bar"},
 		}
-		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(result, nil).Twice()
 
 		expected := &models.Thing{
 			ID:            id,
@@ -382,7 +383,7 @@ func Test_GetThing(t *testing.T) {
 				Schema:    map[string]interface{}{"foo": "bar"},
 			},
 		}
-		vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything).Return(results, nil).Once()
+		vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(results, nil).Once()
 
 		expected := []*models.Thing{
 			&models.Thing{
@@ -393,11 +394,71 @@ func Test_GetThing(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetThings(context.Background(), &models.Principal{}, nil, traverser.UnderscoreProperties{})
+		res, err := manager.GetThings(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, traverser.UnderscoreProperties{})
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("list things with an offset", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingSearch", mock.MatchedBy(func(p *filters.Pagination) bool {
+			return p.Offset == 20 && p.After == ""
+		}), mock.Anything, mock.Anything, mock.Anything).Return([]search.Result{}, nil).Once()
+
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+			ptInt64(20), nil, nil, nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("list things after a cursor", func(t *testing.T) {
+		reset()
+		after := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+		vectorRepo.On("ThingSearch", mock.MatchedBy(func(p *filters.Pagination) bool {
+			return p.Offset == 0 && p.After == after
+		}), mock.Anything, mock.Anything, mock.Anything).Return([]search.Result{}, nil).Once()
+
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+			nil, &after, nil, nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("list things with both an offset and a cursor", func(t *testing.T) {
+		reset()
+		after := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+			ptInt64(20), &after, nil, nil, traverser.UnderscoreProperties{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("list things sorted by multiple properties", func(t *testing.T) {
+		reset()
+		expectedSort := []filters.Sort{
+			{Path: []string{"price"}, Order: filters.SortOrderDesc},
+			{Path: []string{"name"}, Order: filters.SortOrderAsc},
+		}
+		vectorRepo.On("ThingSearch", mock.Anything, expectedSort, mock.Anything,
+			mock.Anything).Return([]search.Result{}, nil).Once()
+
+		sort, order := "price,name", "desc"
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+			nil, nil, &sort, &order, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("list things sorted after a cursor", func(t *testing.T) {
+		reset()
+		after := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+		sort := "price"
+
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+			nil, &after, &sort, nil, traverser.UnderscoreProperties{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
 	t.Run("underscore props", func(t *testing.T) {
 		t.Run("on get single requests", func(t *testing.T) {
 			t.Run("feature projection", func(t *testing.T) {
@@ -409,7 +470,7 @@ func Test_GetThing(t *testing.T) {
 					ClassName: "ThingClass",
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
-				vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(result, nil).Twice()
 				_, err := manager.GetThing(context.Background(), &models.Principal{}, id,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
@@ -426,7 +487,7 @@ func Test_GetThing(t *testing.T) {
 					ClassName: "ThingClass",
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
-				vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(result, nil).Twice()
 				extender.single = &search.Result{
 					ID:        id,
 					ClassName: "ThingClass",
@@ -481,7 +542,7 @@ func Test_GetThing(t *testing.T) {
 						Schema:    map[string]interface{}{"foo": "bar"},
 					},
 				}
-				vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
 				extender.multi = []search.Result{
 					search.Result{
 						ID:        id,
@@ -519,7 +580,7 @@ func Test_GetThing(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10), nil, nil, nil, nil,
 					traverser.UnderscoreProperties{
 						NearestNeighbors: true,
 					})
@@ -538,7 +599,7 @@ func Test_GetThing(t *testing.T) {
 						Schema:    map[string]interface{}{"foo": "bar"},
 					},
 				}
-				vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
+				vectorRepo.On("ThingSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
 				projectorFake.multi = []search.Result{
 					search.Result{
 						ID:        id,
@@ -566,7 +627,7 @@ func Test_GetThing(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10), nil, nil, nil, nil,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
 					})
@@ -48,6 +48,8 @@ type Manager struct {
 	timeSource    timeSource
 	nnExtender    nnExtender
 	projector     featureProjector
+
+	vectorizerDims *vectorizerDimensions
 }
 
 type nnExtender interface {
@@ -93,9 +95,11 @@ type VectorRepo interface {
 	ActionByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties,
 		underscore traverser.UnderscoreProperties) (*search.Result, error)
 
-	ThingSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
+	ThingSearch(ctx context.Context, pagination *filters.Pagination, sort []filters.Sort,
+		filters *filters.LocalFilter,
 		underscore traverser.UnderscoreProperties) (search.Results, error)
-	ActionSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
+	ActionSearch(ctx context.Context, pagination *filters.Pagination, sort []filters.Sort,
+		filters *filters.LocalFilter,
 		underscore traverser.UnderscoreProperties) (search.Results, error)
 
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
@@ -122,6 +126,8 @@ func NewManager(locks locks, schemaManager schemaManager,
 		nnExtender:    nnExtender,
 		timeSource:    defaultTimeSource{},
 		projector:     projector,
+
+		vectorizerDims: newVectorizerDimensions(),
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 )
 
 type MergeDocument struct {
@@ -37,7 +38,7 @@ type MergeDocument struct {
 
 func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, updated *models.Action) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return err
 	}
@@ -46,10 +47,11 @@ func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
 	}
-	primitive, refs := m.splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
+	primitive, refs := splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
 		updated.Class, id, kind.Action)
 
-	vector, source, err := m.mergeActionSchemasAndVectorize(ctx, previous.ClassName, previous.Schema, primitive)
+	vector, source, err := m.mergeActionSchemasAndVectorize(ctx, principal, previous, primitive,
+		updated.Vector)
 	if err != nil {
 		return NewErrInternal("vectorize merged: %v", err)
 	}
@@ -77,6 +79,10 @@ func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 
 func (m *Manager) retrievePreviousAndValidateMergeAction(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, updated *models.Action) (*search.Result, error) {
+	if updated == nil {
+		return nil, fmt.Errorf("no action provided")
+	}
+
 	if updated.Class == "" {
 		return nil, fmt.Errorf("class is a required (and immutable) field")
 	}
@@ -104,8 +110,10 @@ func (m *Manager) retrievePreviousAndValidateMergeAction(ctx context.Context, pr
 	return action, nil
 }
 
-func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{}) ([]float32, []*models.InterpretationSource, error) {
+func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, principal *models.Principal,
+	previous *search.Result, new map[string]interface{},
+	userVector models.C11yVector) ([]float32, []*models.InterpretationSource, error) {
+	className, old := previous.ClassName, previous.Schema
 	var merged map[string]interface{}
 	if old == nil {
 		merged = new
@@ -122,7 +130,20 @@ func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, className
 		merged = oldMap
 	}
 
-	v, source, err := m.vectorizer.Action(ctx, &models.Action{Class: className, Schema: merged})
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(userVector) == 0 && hasNoVectorizer(s, kind.Action, className) {
+		// the merge does not change the vector, so the previous one is kept
+		userVector = previous.Vector
+	}
+
+	v, source, err := obtainVector(s, kind.Action, className, userVector,
+		func() ([]float32, []vectorizer.InputElement, error) {
+			return m.vectorizer.Action(ctx, &models.Action{Class: className, Schema: merged})
+		}, m.vectorizerDims)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,7 +153,7 @@ func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, className
 
 func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, updated *models.Thing) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return err
 	}
@@ -141,10 +162,11 @@ func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
 	}
-	primitive, refs := m.splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
+	primitive, refs := splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
 		updated.Class, id, kind.Thing)
 
-	vector, source, err := m.mergeThingSchemasAndVectorize(ctx, previous.ClassName, previous.Schema, primitive)
+	vector, source, err := m.mergeThingSchemasAndVectorize(ctx, principal, previous, primitive,
+		updated.Vector)
 	if err != nil {
 		return NewErrInternal("vectorize merged: %v", err)
 	}
@@ -172,6 +194,10 @@ func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 
 func (m *Manager) retrievePreviousAndValidateMergeThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, updated *models.Thing) (*search.Result, error) {
+	if updated == nil {
+		return nil, fmt.Errorf("no thing provided")
+	}
+
 	if updated.Class == "" {
 		return nil, fmt.Errorf("class is a required (and immutable) field")
 	}
@@ -199,8 +225,10 @@ func (m *Manager) retrievePreviousAndValidateMergeThing(ctx context.Context, pri
 	return thing, nil
 }
 
-func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{}) ([]float32, []*models.InterpretationSource, error) {
+func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, principal *models.Principal,
+	previous *search.Result, new map[string]interface{},
+	userVector models.C11yVector) ([]float32, []*models.InterpretationSource, error) {
+	className, old := previous.ClassName, previous.Schema
 	var merged map[string]interface{}
 	if old == nil {
 		merged = new
@@ -217,7 +245,20 @@ func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, className s
 		merged = oldMap
 	}
 
-	v, source, err := m.vectorizer.Thing(ctx, &models.Thing{Class: className, Schema: merged})
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(userVector) == 0 && hasNoVectorizer(s, kind.Thing, className) {
+		// the merge does not change the vector, so the previous one is kept
+		userVector = previous.Vector
+	}
+
+	v, source, err := obtainVector(s, kind.Thing, className, userVector,
+		func() ([]float32, []vectorizer.InputElement, error) {
+			return m.vectorizer.Thing(ctx, &models.Thing{Class: className, Schema: merged})
+		}, m.vectorizerDims)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -225,7 +266,7 @@ func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, className s
 	return v, sourceFromInputElements(source), nil
 }
 
-func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
+func splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
 	sourceID strfmt.UUID, sourceKind kind.Kind) (map[string]interface{}, BatchReferences) {
 	primitive := map[string]interface{}{}
 	var outRefs BatchReferences
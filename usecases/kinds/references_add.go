@@ -13,7 +13,6 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -28,7 +27,7 @@ import (
 // include this particular network ref class.
 func (m *Manager) AddActionReference(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return err
 	}
@@ -82,7 +81,7 @@ func (m *Manager) addActionReferenceToConnectorAndSchema(ctx context.Context, pr
 // include this particular network ref class.
 func (m *Manager) AddThingReference(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,6 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -24,7 +23,7 @@ import (
 // DeleteActionReference from connected DB
 func (m *Manager) DeleteActionReference(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return err
 	}
@@ -72,7 +71,7 @@ func (m *Manager) deleteActionReferenceFromConnector(ctx context.Context, princi
 // DeleteThingReference from connected DB
 func (m *Manager) DeleteThingReference(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return err
 	}
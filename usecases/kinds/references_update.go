@@ -13,7 +13,6 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -28,7 +27,7 @@ import (
 // include this particular network ref class.
 func (m *Manager) UpdateActionReferences(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return err
 	}
@@ -87,7 +86,7 @@ func (m *Manager) updateActionReferenceToConnectorAndSchema(ctx context.Context,
 // include this particular network ref class.
 func (m *Manager) UpdateThingReferences(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,6 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -26,11 +25,15 @@ import (
 // include this particular network ref class.
 func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal, id strfmt.UUID,
 	class *models.Action) (*models.Action, error) {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Action, id))
 	if err != nil {
 		return nil, err
 	}
 
+	if class == nil {
+		return nil, NewErrInvalidUserInput("invalid update: no action provided")
+	}
+
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -51,6 +54,13 @@ func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principa
 		return nil, err
 	}
 
+	// the update was authorized for the class of the stored action, so it must
+	// not move the action into a different class
+	if class.Class != originalAction.ClassName {
+		return nil, NewErrInvalidUserInput("invalid update: field 'class' is immutable, "+
+			"but got '%s' for previous class '%s'", class.Class, originalAction.ClassName)
+	}
+
 	m.logger.
 		WithField("action", "kinds_update_requested").
 		WithField("kind", kind.Action).
@@ -71,7 +81,7 @@ func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principa
 
 	class.LastUpdateTimeUnix = m.timeSource.Now()
 
-	err = m.vectorizeAndPutAction(ctx, class)
+	err = m.vectorizeAndPutAction(ctx, principal, class)
 	if err != nil {
 		return nil, NewErrInternal("update action: %v", err)
 	}
@@ -84,11 +94,15 @@ func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principa
 // include this particular network ref class.
 func (m *Manager) UpdateThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, class *models.Thing) (*models.Thing, error) {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "update", m.existingKindResource(ctx, kind.Thing, id))
 	if err != nil {
 		return nil, err
 	}
 
+	if class == nil {
+		return nil, NewErrInvalidUserInput("invalid update: no thing provided")
+	}
+
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -109,6 +123,13 @@ func (m *Manager) updateThingToConnectorAndSchema(ctx context.Context, principal
 		return nil, err
 	}
 
+	// the update was authorized for the class of the stored thing, so it must
+	// not move the thing into a different class
+	if class.Class != originalThing.ClassName {
+		return nil, NewErrInvalidUserInput("invalid update: field 'class' is immutable, "+
+			"but got '%s' for previous class '%s'", class.Class, originalThing.ClassName)
+	}
+
 	m.logger.
 		WithField("action", "kinds_update_requested").
 		WithField("kind", kind.Thing).
@@ -129,7 +150,7 @@ func (m *Manager) updateThingToConnectorAndSchema(ctx context.Context, principal
 
 	class.LastUpdateTimeUnix = m.timeSource.Now()
 
-	err = m.vectorizeAndPutThing(ctx, class)
+	err = m.vectorizeAndPutThing(ctx, principal, class)
 	if err != nil {
 		return nil, NewErrInternal("update thing: %v", err)
 	}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Update_ClassIsImmutable(t *testing.T) {
+	var (
+		manager    *Manager
+		vectorRepo *fakeVectorRepo
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "MyThing",
+		}, nil)
+		vectorRepo.On("ActionByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "MyAction",
+		}, nil)
+		schemaManager := &fakeSchemaManager{}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		vectorizer := &fakeVectorizer{}
+		manager = NewManager(locks, schemaManager, network, cfg, logger, authorizer, vectorizer, vectorRepo, extender, projector)
+	}
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	t.Run("moving a thing into a different class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.UpdateThing(context.Background(), nil, id, &models.Thing{
+			ID:    id,
+			Class: "SomeOtherClass",
+		})
+
+		assert.Equal(t, NewErrInvalidUserInput("invalid update: field 'class' is immutable, "+
+			"but got 'SomeOtherClass' for previous class 'MyThing'"), err)
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("moving an action into a different class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.UpdateAction(context.Background(), nil, id, &models.Action{
+			ID:    id,
+			Class: "SomeOtherClass",
+		})
+
+		assert.Equal(t, NewErrInvalidUserInput("invalid update: field 'class' is immutable, "+
+			"but got 'SomeOtherClass' for previous class 'MyAction'"), err)
+		vectorRepo.AssertNotCalled(t, "PutAction", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without a body", func(t *testing.T) {
+		reset()
+
+		_, err := manager.UpdateThing(context.Background(), nil, id, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid update: no thing provided"), err)
+
+		_, err = manager.UpdateAction(context.Background(), nil, id, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid update: no action provided"), err)
+
+		err = manager.MergeThing(context.Background(), nil, id, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid merge: no thing provided"), err)
+
+		err = manager.MergeAction(context.Background(), nil, id, nil)
+		assert.Equal(t, NewErrInvalidUserInput("invalid merge: no action provided"), err)
+	})
+}
@@ -15,17 +15,23 @@ import (
 	"context"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // ValidateThing without adding it to the database. Can be used in UIs for
 // async validation before submitting
 func (m *Manager) ValidateThing(ctx context.Context, principal *models.Principal,
 	class *models.Thing) error {
-	err := m.authorizer.Authorize(principal, "validate", "things")
+	err := m.authorizer.Authorize(principal, "validate",
+		kindResource(kind.Thing, thingClassName(class), ""))
 	if err != nil {
 		return err
 	}
 
+	if class == nil {
+		return NewErrInvalidUserInput("invalid thing: no thing provided")
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return NewErrInternal("could not acquire lock: %v", err)
@@ -44,11 +50,16 @@ func (m *Manager) ValidateThing(ctx context.Context, principal *models.Principal
 // async validation before submitting
 func (m *Manager) ValidateAction(ctx context.Context, principal *models.Principal,
 	class *models.Action) error {
-	err := m.authorizer.Authorize(principal, "validate", "actions")
+	err := m.authorizer.Authorize(principal, "validate",
+		kindResource(kind.Action, actionClassName(class), ""))
 	if err != nil {
 		return err
 	}
 
+	if class == nil {
+		return NewErrInvalidUserInput("invalid action: no action provided")
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return NewErrInternal("could not acquire lock: %v", err)
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+type vectorizeFn func() ([]float32, []vectorizer.InputElement, error)
+
+// obtainVector determines the vector of an object. A vector provided by the
+// user always takes precedence, the vectorizer is only used if there is none.
+// Classes without a vectorizer never contact the contextionary, so for those
+// a user-provided vector is required. In all cases the vector must match the
+// dimensions the class declares. If a class with a vectorizer does not
+// declare them, a user-provided vector must match the dimensions of the
+// vectors the vectorizer has produced for the class so far.
+func obtainVector(s schema.Schema, k kind.Kind, className string,
+	userVector models.C11yVector, vectorize vectorizeFn,
+	dims *vectorizerDimensions) ([]float32, []vectorizer.InputElement, error) {
+	class := s.GetClass(k, schema.ClassName(className))
+	if class == nil {
+		// unknown classes are rejected by the validation, before the vector is
+		// ever obtained, so this can only mean the defaults apply
+		class = &models.Class{Class: className}
+	}
+
+	if err := validateVectorDimensions(class, userVector); err != nil {
+		return nil, nil, err
+	}
+
+	if len(userVector) > 0 {
+		if err := validateUserVectorWithVectorizer(class, userVector,
+			dims); err != nil {
+			return nil, nil, err
+		}
+
+		return userVector, nil, nil
+	}
+
+	if schemaUC.Vectorizer(class) == schemaUC.VectorizerNone {
+		return nil, nil, fmt.Errorf("class %q has no vectorizer, a vector must be "+
+			"provided for every object", className)
+	}
+
+	vector, source, err := vectorize()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := validateVectorDimensions(class, vector); err != nil {
+		return nil, nil, err
+	}
+
+	dims.set(class.Class, len(vector))
+	return vector, source, nil
+}
+
+func hasNoVectorizer(s schema.Schema, k kind.Kind, className string) bool {
+	class := s.GetClass(k, schema.ClassName(className))
+	return class != nil && schemaUC.Vectorizer(class) == schemaUC.VectorizerNone
+}
+
+func validateVectorDimensions(class *models.Class, vector []float32) error {
+	dims := schemaUC.VectorDimensions(class)
+	if dims == 0 || len(vector) == 0 {
+		return nil
+	}
+
+	if len(vector) != dims {
+		return fmt.Errorf("vector has %d dimensions, but class %q requires %d",
+			len(vector), class.Class, dims)
+	}
+
+	return nil
+}
+
+// validateUserVectorWithVectorizer makes sure that a user-provided vector
+// has the same dimensions as the vectors of the vectorizer. A vector of any
+// other length would break every distance calculation against it, and with
+// it the searches of the whole class. As long as the vectorizer has not
+// produced a vector for the class, there is nothing to compare against and
+// the user-provided vector is accepted.
+func validateUserVectorWithVectorizer(class *models.Class, userVector []float32,
+	dims *vectorizerDimensions) error {
+	if schemaUC.VectorDimensions(class) != 0 ||
+		schemaUC.Vectorizer(class) == schemaUC.VectorizerNone {
+		// the dimensions were already validated against the class
+		return nil
+	}
+
+	vectorizerDims, ok := dims.get(class.Class)
+	if !ok {
+		return nil
+	}
+
+	if len(userVector) != vectorizerDims {
+		return fmt.Errorf("vector has %d dimensions, but the vectorizer of class %q "+
+			"produces %d", len(userVector), class.Class, vectorizerDims)
+	}
+
+	return nil
+}
+
+// vectorizerDimensions remembers the dimensions of the vectors the
+// vectorizer produced per class, so that user-provided vectors can be
+// validated without contacting the vectorizer for each of them.
+type vectorizerDimensions struct {
+	sync.RWMutex
+	byClass map[string]int
+}
+
+func newVectorizerDimensions() *vectorizerDimensions {
+	return &vectorizerDimensions{byClass: map[string]int{}}
+}
+
+func (d *vectorizerDimensions) get(className string) (int, bool) {
+	d.RLock()
+	defer d.RUnlock()
+
+	dims, ok := d.byClass[className]
+	return dims, ok
+}
+
+func (d *vectorizerDimensions) set(className string, dims int) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.byClass[className] = dims
+}
+
+func (m *Manager) obtainThingVector(ctx context.Context, principal *models.Principal,
+	thing *models.Thing) ([]float32, []vectorizer.InputElement, error) {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return obtainVector(s, kind.Thing, thing.Class, thing.Vector,
+		func() ([]float32, []vectorizer.InputElement, error) {
+			return m.vectorizer.Thing(ctx, thing)
+		}, m.vectorizerDims)
+}
+
+func (m *Manager) obtainActionVector(ctx context.Context, principal *models.Principal,
+	action *models.Action) ([]float32, []vectorizer.InputElement, error) {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return obtainVector(s, kind.Action, action.Class, action.Vector,
+		func() ([]float32, []vectorizer.InputElement, error) {
+			return m.vectorizer.Action(ctx, action)
+		}, m.vectorizerDims)
+}
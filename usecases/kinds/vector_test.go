@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Add_Thing_WithUserProvidedVector(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		vectorizer *fakeVectorizer
+		manager    *Manager
+	)
+
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Vectorized",
+				},
+				{
+					Class: "VectorizedWithDimensions",
+					VectorIndexConfig: &models.VectorIndexConfig{
+						Dimensions: 2,
+					},
+				},
+				{
+					Class:      "NotVectorized",
+					Vectorizer: "none",
+					VectorIndexConfig: &models.VectorIndexConfig{
+						Dimensions: 4,
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		vectorizer = &fakeVectorizer{}
+		manager = NewManager(locks, schemaManager, network, cfg, logger,
+			authorizer, vectorizer, vectorRepo, extender, projector)
+	}
+
+	ctx := context.Background()
+
+	t.Run("vectorized class without a user-provided vector", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil).Once()
+		vectorRepo.On("PutThing", mock.Anything, []float32{0, 1, 2}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "Vectorized"})
+		require.Nil(t, err)
+		vectorizer.AssertExpectations(t)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("vectorized class with a user-provided vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, []float32{3, 4, 5}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Vectorized",
+			Vector: models.C11yVector{3, 4, 5},
+		})
+		require.Nil(t, err)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("vectorized class with a user-provided vector after the vectorizer was used", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil).Once()
+		vectorRepo.On("PutThing", mock.Anything, []float32{0, 1, 2}).Return(nil).Once()
+		vectorRepo.On("PutThing", mock.Anything, []float32{3, 4, 5}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "Vectorized"})
+		require.Nil(t, err)
+		_, err = manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Vectorized",
+			Vector: models.C11yVector{3, 4, 5},
+		})
+		require.Nil(t, err)
+		// the dimensions are compared with the vector of the first object, the
+		// vectorizer is not called again
+		vectorizer.AssertExpectations(t)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("vectorized class with a user-provided vector of the wrong length", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil).Once()
+		vectorRepo.On("PutThing", mock.Anything, []float32{0, 1, 2}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "Vectorized"})
+		require.Nil(t, err)
+		_, err = manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Vectorized",
+			Vector: models.C11yVector{3, 4},
+		})
+		assert.NotNil(t, err)
+		vectorizer.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, []float32{3, 4})
+	})
+
+	t.Run("vectorized class with a user-provided vector and a failing vectorizer", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32(nil), errors.New("no word in the c11y")).Maybe()
+		vectorRepo.On("PutThing", mock.Anything, []float32{3, 4, 5}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Vectorized",
+			Vector: models.C11yVector{3, 4, 5},
+		})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("vectorized class with declared dimensions and a user-provided vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, []float32{3, 4}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "VectorizedWithDimensions",
+			Vector: models.C11yVector{3, 4},
+		})
+		require.Nil(t, err)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("class without vectorizer and a matching vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, []float32{1, 2, 3, 4}).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "NotVectorized",
+			Vector: models.C11yVector{1, 2, 3, 4},
+		})
+		require.Nil(t, err)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("class without vectorizer and no vector", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "NotVectorized"})
+		assert.NotNil(t, err)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("class without vectorizer and a vector of the wrong length", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "NotVectorized",
+			Vector: models.C11yVector{1, 2, 3},
+		})
+		assert.NotNil(t, err)
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
+	})
+}
+
+func Test_BatchManager_AddThings_WithUserProvidedVectors(t *testing.T) {
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "NotVectorized",
+					Vectorizer: "none",
+					VectorIndexConfig: &models.VectorIndexConfig{
+						Dimensions: 2,
+					},
+				},
+			},
+		},
+	}
+
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema,
+	}
+	logger, _ := test.NewNullLogger()
+	vectorizer := &fakeVectorizer{}
+	manager := NewBatchManager(vectorRepo, vectorizer, &fakeLocks{},
+		schemaManager, nil, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+
+	things := []*models.Thing{
+		&models.Thing{Class: "NotVectorized", Vector: models.C11yVector{1, 2}},
+		&models.Thing{Class: "NotVectorized", Vector: models.C11yVector{1, 2, 3}},
+		&models.Thing{Class: "NotVectorized"},
+	}
+
+	res, err := manager.AddThings(context.Background(), nil, things, []*string{})
+	require.Nil(t, err)
+	require.Len(t, res, 3)
+
+	assert.Nil(t, res[0].Err)
+	assert.Equal(t, []float32{1, 2}, res[0].Vector)
+	assert.NotNil(t, res[1].Err, "wrong dimensions")
+	assert.NotNil(t, res[2].Err, "no vector")
+	vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package monitoring contains the collectors which are exposed for scraping
+// on the /metrics endpoint
+package monitoring
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusMetrics holds all collectors, they are registered on their own
+// registry, so that several instances can exist side by side. A nil
+// *PrometheusMetrics is valid and means monitoring is turned off, every
+// component which records metrics has to check for it.
+type PrometheusMetrics struct {
+	Registry *prometheus.Registry
+
+	// API layers
+	RESTRequestDuration    *prometheus.HistogramVec
+	GraphQLRequestDuration *prometheus.HistogramVec
+	ContextionaryDuration  *prometheus.HistogramVec
+
+	// Database
+	BatchSize     *prometheus.HistogramVec
+	BatchDuration *prometheus.HistogramVec
+	ObjectCount   *prometheus.GaugeVec
+
+	// Vector index
+	VectorIndexDuration   *prometheus.HistogramVec
+	VectorIndexTombstones *prometheus.GaugeVec
+	VectorCacheHits       *prometheus.CounterVec
+	VectorCacheMisses     *prometheus.CounterVec
+	CommitLogSize         *prometheus.GaugeVec
+}
+
+// NewPrometheusMetrics creates all collectors and registers them alongside
+// the standard Go runtime and process collectors
+func NewPrometheusMetrics() *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		Registry: prometheus.NewRegistry(),
+
+		RESTRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "rest_request_duration_seconds",
+			Help:    "Duration of REST requests by operation and status code",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation", "status"}),
+		GraphQLRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "graphql_request_duration_seconds",
+			Help:    "Duration of GraphQL operations, such as Get, Aggregate or Explore",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation"}),
+		ContextionaryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "contextionary_request_duration_seconds",
+			Help:    "Duration of calls to the contextionary by gRPC method",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+
+		BatchSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "batch_size_objects",
+			Help:    "Number of objects per batch imported into a shard",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{"class_name", "shard_name"}),
+		BatchDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "batch_duration_seconds",
+			Help:    "Duration of batch imports into a shard",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"class_name", "shard_name"}),
+		ObjectCount: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "object_count",
+			Help: "Number of objects stored in a shard",
+		}, []string{"class_name", "shard_name"}),
+
+		VectorIndexDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_duration_seconds",
+			Help:    "Duration of vector index operations, such as insert or search",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, []string{"operation", "class_name", "shard_name"}),
+		VectorIndexTombstones: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_tombstones",
+			Help: "Number of deleted nodes in a vector index awaiting cleanup",
+		}, []string{"class_name", "shard_name"}),
+		VectorCacheHits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_cache_hits_total",
+			Help: "Number of vectors served from the vector cache",
+		}, []string{"class_name", "shard_name"}),
+		VectorCacheMisses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_cache_misses_total",
+			Help: "Number of vectors which had to be read from disk",
+		}, []string{"class_name", "shard_name"}),
+		CommitLogSize: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_commit_log_size_bytes",
+			Help: "Size of all commit log files of a vector index",
+		}, []string{"class_name", "shard_name"}),
+	}
+
+	m.Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.RESTRequestDuration,
+		m.GraphQLRequestDuration,
+		m.ContextionaryDuration,
+		m.BatchSize,
+		m.BatchDuration,
+		m.ObjectCount,
+		m.VectorIndexDuration,
+		m.VectorIndexTombstones,
+		m.VectorCacheHits,
+		m.VectorCacheMisses,
+		m.CommitLogSize,
+	)
+
+	return m
+}
+
+// DeleteShard removes all series of the shard, so that a shard which was
+// shut down, dropped or renamed is no longer reported with its last values.
+// It is a no-op if monitoring is turned off.
+func (m *PrometheusMetrics) DeleteShard(className, shardName string) {
+	if m == nil {
+		return
+	}
+
+	m.BatchSize.DeleteLabelValues(className, shardName)
+	m.BatchDuration.DeleteLabelValues(className, shardName)
+	m.ObjectCount.DeleteLabelValues(className, shardName)
+
+	// the operations recorded by the vector index
+	for _, operation := range []string{"insert", "delete", "search"} {
+		m.VectorIndexDuration.DeleteLabelValues(operation, className, shardName)
+	}
+	m.VectorIndexTombstones.DeleteLabelValues(className, shardName)
+	m.VectorCacheHits.DeleteLabelValues(className, shardName)
+	m.VectorCacheMisses.DeleteLabelValues(className, shardName)
+	m.CommitLogSize.DeleteLabelValues(className, shardName)
+}
@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteShard(t *testing.T) {
+	m := NewPrometheusMetrics()
+	for _, shard := range []string{"shard1", "shard2"} {
+		m.ObjectCount.WithLabelValues("Car", shard).Set(7)
+		m.VectorIndexTombstones.WithLabelValues("Car", shard).Set(1)
+		for _, operation := range []string{"insert", "delete", "search"} {
+			m.VectorIndexDuration.WithLabelValues(operation, "Car", shard).Observe(1)
+		}
+	}
+
+	m.DeleteShard("Car", "shard1")
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.ObjectCount))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.VectorIndexTombstones))
+	assert.Equal(t, 3, testutil.CollectAndCount(m.VectorIndexDuration))
+	assert.Equal(t, float64(7), testutil.ToFloat64(m.ObjectCount.WithLabelValues("Car", "shard2")))
+}
+
+func TestDeleteShard_Disabled(t *testing.T) {
+	var m *PrometheusMetrics
+	m.DeleteShard("Car", "shard1")
+}
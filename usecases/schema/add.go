@@ -24,7 +24,8 @@ import (
 // AddAction Class to the schema
 func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
 	class *models.Class) error {
-	err := m.authorizer.Authorize(principal, "create", "schema/actions")
+	err := m.authorizer.Authorize(principal, "create",
+		classResource(kind.Action, upperCaseClassName(className(class))))
 	if err != nil {
 		return err
 	}
@@ -35,7 +36,8 @@ func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
 // AddThing Class to the schema
 func (m *Manager) AddThing(ctx context.Context, principal *models.Principal,
 	class *models.Class) error {
-	err := m.authorizer.Authorize(principal, "create", "schema/things")
+	err := m.authorizer.Authorize(principal, "create",
+		classResource(kind.Thing, upperCaseClassName(className(class))))
 	if err != nil {
 		return err
 	}
@@ -45,6 +47,10 @@ func (m *Manager) AddThing(ctx context.Context, principal *models.Principal,
 
 func (m *Manager) addClass(ctx context.Context, principal *models.Principal,
 	class *models.Class, k kind.Kind) error {
+	if class == nil {
+		return fmt.Errorf("no class provided")
+	}
+
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
 		return err
@@ -77,7 +83,23 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	err = m.validateClassNameAndKeywords(ctx, knd, class.Class, class.Keywords, VectorizeClassName(class))
+	err = validateVectorizer(class)
+	if err != nil {
+		return err
+	}
+
+	err = m.validateClassNameAndKeywords(ctx, knd, class.Class, class.Keywords,
+		VectorizeClassName(class), Vectorizer(class))
+	if err != nil {
+		return err
+	}
+
+	err = validateShardCount(class.ShardCount)
+	if err != nil {
+		return err
+	}
+
+	err = validateVectorIndexConfig(class.VectorIndexConfig)
 	if err != nil {
 		return err
 	}
@@ -86,7 +108,7 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 	foundNames := map[string]bool{}
 	for _, property := range class.Properties {
 		err = m.validatePropertyNameAndKeywords(ctx, class.Class, property.Name, property.Keywords,
-			property.VectorizePropertyName)
+			property.VectorizePropertyName, Vectorizer(class))
 		if err != nil {
 			return err
 		}
@@ -108,6 +130,11 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 			return fmt.Errorf("property '%s': invalid dataType: %v", property.Name, err)
 		}
 
+		err = validateTextAnalyzer(property)
+		if err != nil {
+			return err
+		}
+
 		m.handleDeprecatedFielsInProperty(property)
 	}
 
@@ -23,7 +23,7 @@ import (
 // AddActionProperty to an existing Action
 func (m *Manager) AddActionProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Action, class))
 	if err != nil {
 		return err
 	}
@@ -34,7 +34,7 @@ func (m *Manager) AddActionProperty(ctx context.Context, principal *models.Princ
 // AddThingProperty to an existing Thing
 func (m *Manager) AddThingProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Thing, class))
 	if err != nil {
 		return err
 	}
@@ -91,7 +91,7 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 	}
 
 	err = m.validatePropertyNameAndKeywords(ctx, class.Class, property.Name, property.Keywords,
-		property.VectorizePropertyName)
+		property.VectorizePropertyName, Vectorizer(class))
 	if err != nil {
 		return err
 	}
@@ -111,6 +111,10 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 		return fmt.Errorf("Data type of property '%s' is invalid; %v", property.Name, err)
 	}
 
+	if err = validateTextAnalyzer(property); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// classResource builds the resource an authorizer decides on for the schema
+// of a single class, such as "schema/things/Article", so that permissions can
+// be granted per class. The class is omitted if it is empty.
+func classResource(k kind.Kind, className string) string {
+	segments := []string{"schema", k.Name() + "s"}
+	if className != "" {
+		segments = append(segments, className)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// authorizeAllClasses authorizes the verb on the schema of every class, as
+// reading the whole schema reveals all of them. Without any classes, the
+// schema as a whole is authorized.
+func (m *Manager) authorizeAllClasses(principal *models.Principal,
+	verb string) error {
+	authorized := false
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		s := m.state.SchemaFor(k)
+		if s == nil {
+			continue
+		}
+
+		for _, class := range s.Classes {
+			err := m.authorizer.Authorize(principal, verb, classResource(k, class.Class))
+			if err != nil {
+				return err
+			}
+			authorized = true
+		}
+	}
+
+	if authorized {
+		return nil
+	}
+
+	return m.authorizer.Authorize(principal, verb, "schema")
+}
+
+// className returns the name of a class provided in a request body, a
+// missing body is rejected once the request is authorized
+func className(class *models.Class) string {
+	if class == nil {
+		return ""
+	}
+
+	return class.Class
+}
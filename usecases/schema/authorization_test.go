@@ -40,91 +40,97 @@ func Test_Schema_Authorization(t *testing.T) {
 		testCase{
 			methodName:       "GetSchema",
 			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedResource: "schema",
 		},
 
 		testCase{
 			methodName:       "AddThing",
-			additionalArgs:   []interface{}{&models.Class{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "foo"}},
+			expectedVerb:     "create",
+			expectedResource: "schema/things/Foo",
+		},
+		testCase{
+			methodName:       "AddThing",
+			additionalArgs:   []interface{}{(*models.Class)(nil)},
 			expectedVerb:     "create",
 			expectedResource: "schema/things",
 		},
 		testCase{
 			methodName:       "AddAction",
-			additionalArgs:   []interface{}{&models.Class{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "foo"}},
 			expectedVerb:     "create",
-			expectedResource: "schema/actions",
+			expectedResource: "schema/actions/Foo",
 		},
 
 		testCase{
 			methodName:       "UpdateThing",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/things",
+			expectedResource: "schema/things/somename",
 		},
 		testCase{
 			methodName:       "UpdateAction",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/actions",
+			expectedResource: "schema/actions/somename",
 		},
 
 		testCase{
 			methodName:       "DeleteThing",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/things",
+			expectedResource: "schema/things/somename",
 		},
 		testCase{
 			methodName:       "DeleteAction",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/actions",
+			expectedResource: "schema/actions/somename",
 		},
 
 		testCase{
 			methodName:       "AddThingProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/things",
+			expectedResource: "schema/things/somename",
 		},
 		testCase{
 			methodName:       "AddActionProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/actions",
+			expectedResource: "schema/actions/somename",
 		},
 
 		testCase{
 			methodName:       "UpdateThingProperty",
 			additionalArgs:   []interface{}{"somename", "someprop", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/things",
+			expectedResource: "schema/things/somename",
 		},
 		testCase{
 			methodName:       "UpdateActionProperty",
 			additionalArgs:   []interface{}{"somename", "someprop", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/actions",
+			expectedResource: "schema/actions/somename",
 		},
 		testCase{
 			methodName:       "UpdatePropertyAddDataType",
 			additionalArgs:   []interface{}{kind.Thing, "somename", "someprop", "datatype"},
 			expectedVerb:     "update",
-			expectedResource: "schema/things",
+			expectedResource: "schema/things/somename",
 		},
 
 		testCase{
 			methodName:       "DeleteThingProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/things",
+			expectedResource: "schema/things/somename",
 		},
 		testCase{
 			methodName:       "DeleteActionProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/actions",
+			expectedResource: "schema/actions/somename",
 		},
 	}
 
@@ -177,6 +183,28 @@ func Test_Schema_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Schema_AuthorizationPerClass(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	manager, err := NewManager(&NilMigrator{}, newFakeRepo(), newFakeLocks(),
+		nil, logger, &fakeC11y{}, &fakeAuthorizer{}, &fakeStopwordDetector{})
+	require.Nil(t, err)
+
+	ctx := context.Background()
+	require.Nil(t, manager.AddThing(ctx, nil, &models.Class{Class: "Article"}))
+	require.Nil(t, manager.AddAction(ctx, nil, &models.Class{Class: "Publish"}))
+
+	recorder := &authRecorder{}
+	manager.authorizer = recorder
+
+	_, err = manager.GetSchema(nil)
+	require.Nil(t, err)
+
+	assert.ElementsMatch(t, []authorizeCall{
+		{nil, "list", "schema/things/Article"},
+		{nil, "list", "schema/actions/Publish"},
+	}, recorder.calls, "reading the schema reveals every class")
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -192,6 +220,15 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+type authRecorder struct {
+	calls []authorizeCall
+}
+
+func (a *authRecorder) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	return nil
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
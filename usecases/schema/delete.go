@@ -21,7 +21,7 @@ import (
 
 // DeleteAction Class to the schema
 func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.authorizer.Authorize(principal, "delete", "schema/actions")
+	err := m.authorizer.Authorize(principal, "delete", classResource(kind.Action, class))
 	if err != nil {
 		return err
 	}
@@ -31,7 +31,7 @@ func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal,
 
 // DeleteThing Class to the schema
 func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.authorizer.Authorize(principal, "delete", "schema/things")
+	err := m.authorizer.Authorize(principal, "delete", classResource(kind.Thing, class))
 	if err != nil {
 		return err
 	}
@@ -16,12 +16,13 @@ import (
 	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // DeleteActionProperty to an existing Action
 func (m *Manager) DeleteActionProperty(ctx context.Context, principal *models.Principal,
 	class string, property string) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Action, class))
 	if err != nil {
 		return err
 	}
@@ -34,7 +35,7 @@ func (m *Manager) DeleteActionProperty(ctx context.Context, principal *models.Pr
 // DeleteThingProperty to an existing Thing
 func (m *Manager) DeleteThingProperty(ctx context.Context, principal *models.Principal,
 	class string, property string) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Thing, class))
 	if err != nil {
 		return err
 	}
@@ -18,7 +18,7 @@ import (
 
 // GetSchema retrieves a locally cached copy of the schema
 func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error) {
-	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	err := m.authorizeAllClasses(principal, "list")
 	if err != nil {
 		return schema.Schema{}, err
 	}
@@ -184,3 +184,14 @@ func VectorizeClassName(class *models.Class) bool {
 
 	return *class.VectorizeClassName
 }
+
+// ShardCount is the only safe way to access this property, as it could
+// otherwise be unset. It is also the single place a default is set
+func ShardCount(class *models.Class) int {
+	const defaultValue = 1
+	if class.ShardCount == 0 {
+		return defaultValue
+	}
+
+	return int(class.ShardCount)
+}
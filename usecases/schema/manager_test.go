@@ -42,6 +42,10 @@ func (n *NilMigrator) UpdateClass(ctx context.Context, kind kind.Kind, className
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, kind kind.Kind, className string, updated *models.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
 	return nil
 }
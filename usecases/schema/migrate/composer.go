@@ -62,6 +62,18 @@ func (c *Composer) UpdateClass(ctx context.Context, kind kind.Kind,
 	return ec.Compose()
 }
 
+// UpdateVectorIndexConfig calls all internal UpdateVectorIndexConfig methods
+// and composes the errors
+func (c *Composer) UpdateVectorIndexConfig(ctx context.Context, kind kind.Kind,
+	class string, updated *models.VectorIndexConfig) error {
+	ec := newErrorComposer()
+	for _, m := range c.migrators {
+		ec.Add(m.UpdateVectorIndexConfig(ctx, kind, class, updated))
+	}
+
+	return ec.Compose()
+}
+
 // AddProperty calls all internal AddProperty methods and composes the errors
 func (c *Composer) AddProperty(ctx context.Context, kind kind.Kind,
 	class string, prop *models.Property) error {
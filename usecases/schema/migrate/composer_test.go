@@ -152,6 +152,52 @@ func Test_Composer(t *testing.T) {
 		})
 	})
 
+	t.Run("updating the vector index config of a class", func(t *testing.T) {
+		ctx := context.Background()
+		kind := kind.Thing
+		class := "Foo"
+		updated := &models.VectorIndexConfig{Ef: 200}
+
+		t.Run("no errors", func(t *testing.T) {
+			m1.On("UpdateVectorIndexConfig", ctx, kind, class, updated).
+				Return(nil).Once()
+			m2.On("UpdateVectorIndexConfig", ctx, kind, class, updated).
+				Return(nil).Once()
+
+			err := composer.UpdateVectorIndexConfig(ctx, kind, class, updated)
+
+			assert.Nil(t, err)
+			m1.AssertExpectations(t)
+			m2.AssertExpectations(t)
+		})
+
+		t.Run("one of the two errors", func(t *testing.T) {
+			m1.On("UpdateVectorIndexConfig", ctx, kind, class, updated).
+				Return(errors.New("m1 errord")).Once()
+			m2.On("UpdateVectorIndexConfig", ctx, kind, class, updated).
+				Return(nil).Once()
+
+			err := composer.UpdateVectorIndexConfig(ctx, kind, class, updated)
+
+			assert.Equal(t, errors.New("migrator composer: m1 errord"), err)
+			m1.AssertExpectations(t)
+			m2.AssertExpectations(t)
+		})
+
+		t.Run("both error", func(t *testing.T) {
+			m1.On("UpdateVectorIndexConfig", ctx, kind, class, updated).
+				Return(errors.New("m1 errord")).Once()
+			m2.On("UpdateVectorIndexConfig", ctx, kind, class, updated).
+				Return(errors.New("m2 errord")).Once()
+
+			err := composer.UpdateVectorIndexConfig(ctx, kind, class, updated)
+
+			assert.Equal(t, errors.New("migrator composer: m1 errord, m2 errord"), err)
+			m1.AssertExpectations(t)
+			m2.AssertExpectations(t)
+		})
+	})
+
 	t.Run("adding a property", func(t *testing.T) {
 		ctx := context.Background()
 		class := "Foo"
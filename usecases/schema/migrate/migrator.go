@@ -27,6 +27,8 @@ type Migrator interface {
 	DropClass(ctx context.Context, kind kind.Kind, className string) error
 	UpdateClass(ctx context.Context, kind kind.Kind, className string,
 		newClassName *string, newKeywords *models.Keywords) error
+	UpdateVectorIndexConfig(ctx context.Context, kind kind.Kind, className string,
+		updated *models.VectorIndexConfig) error
 
 	AddProperty(ctx context.Context, kind kind.Kind, className string,
 		prop *models.Property) error
@@ -38,6 +38,11 @@ func (m *mockMigrator) UpdateClass(ctx context.Context, kind kind.Kind, classNam
 	return args.Error(0)
 }
 
+func (m *mockMigrator) UpdateVectorIndexConfig(ctx context.Context, kind kind.Kind, className string, updated *models.VectorIndexConfig) error {
+	args := m.Called(ctx, kind, className, updated)
+	return args.Error(0)
+}
+
 func (m *mockMigrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
 	args := m.Called(ctx, kind, className, prop)
 	return args.Error(0)
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// Tokenizers which can be set in a property's text analyzer config
+const (
+	TokenizerWord       = "word"
+	TokenizerWhitespace = "whitespace"
+	TokenizerField      = "field"
+	TokenizerNgram      = "ngram"
+)
+
+// Languages of the stopword presets and stemmers which can be set in a
+// property's text analyzer config
+const (
+	LanguageNone    = "none"
+	LanguageEnglish = "english"
+	LanguageGerman  = "german"
+)
+
+// isStringProp tells apart the two data types a text analyzer can be set on,
+// they differ in their defaults
+func isStringProp(prop *models.Property) bool {
+	return len(prop.DataType) == 1 &&
+		schema.DataType(prop.DataType[0]) == schema.DataTypeString
+}
+
+// TextTokenizer is the only safe way to access this property, as the config
+// could otherwise be nil. It is also the single place a default is set
+func TextTokenizer(prop *models.Property) string {
+	defaultValue := TokenizerWord
+	if isStringProp(prop) {
+		defaultValue = TokenizerWhitespace
+	}
+
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.Tokenizer == "" {
+		return defaultValue
+	}
+
+	return prop.TextAnalyzer.Tokenizer
+}
+
+// TextLowercase is the only safe way to access this property, as the config
+// could otherwise be nil. It is also the single place a default is set
+func TextLowercase(prop *models.Property) bool {
+	defaultValue := !isStringProp(prop)
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.Lowercase == nil {
+		return defaultValue
+	}
+
+	return *prop.TextAnalyzer.Lowercase
+}
+
+// TextStopwords is the only safe way to access this property, as the config
+// could otherwise be nil. It is also the single place a default is set
+func TextStopwords(prop *models.Property) string {
+	const defaultValue = LanguageNone
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.Stopwords == "" {
+		return defaultValue
+	}
+
+	return prop.TextAnalyzer.Stopwords
+}
+
+// TextAdditionalStopwords is the only safe way to access this property, as
+// the config could otherwise be nil
+func TextAdditionalStopwords(prop *models.Property) []string {
+	if prop.TextAnalyzer == nil {
+		return nil
+	}
+
+	return prop.TextAnalyzer.AdditionalStopwords
+}
+
+// TextStemmer is the only safe way to access this property, as the config
+// could otherwise be nil. It is also the single place a default is set
+func TextStemmer(prop *models.Property) string {
+	const defaultValue = LanguageNone
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.Stemmer == "" {
+		return defaultValue
+	}
+
+	return prop.TextAnalyzer.Stemmer
+}
+
+// TextNgramMinLength is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func TextNgramMinLength(prop *models.Property) int {
+	const defaultValue = 3
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.NgramMinLength == 0 {
+		return defaultValue
+	}
+
+	return int(prop.TextAnalyzer.NgramMinLength)
+}
+
+// TextNgramMaxLength is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func TextNgramMaxLength(prop *models.Property) int {
+	const defaultValue = 3
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.NgramMaxLength == 0 {
+		return defaultValue
+	}
+
+	return int(prop.TextAnalyzer.NgramMaxLength)
+}
+
+func validateTextAnalyzer(prop *models.Property) error {
+	cfg := prop.TextAnalyzer
+	if cfg == nil {
+		return nil
+	}
+
+	if len(prop.DataType) != 1 ||
+		(schema.DataType(prop.DataType[0]) != schema.DataTypeText &&
+			schema.DataType(prop.DataType[0]) != schema.DataTypeString) {
+		return fmt.Errorf("property %q: textAnalyzer can only be set on "+
+			"properties of type %q or %q", prop.Name, schema.DataTypeText,
+			schema.DataTypeString)
+	}
+
+	switch cfg.Tokenizer {
+	case "", TokenizerWord, TokenizerWhitespace, TokenizerField, TokenizerNgram:
+	default:
+		return fmt.Errorf("property %q: textAnalyzer: unrecognized tokenizer %q, "+
+			"must be one of %q, %q, %q or %q", prop.Name, cfg.Tokenizer,
+			TokenizerWord, TokenizerWhitespace, TokenizerField, TokenizerNgram)
+	}
+
+	if err := validateLanguage(cfg.Stopwords); err != nil {
+		return fmt.Errorf("property %q: textAnalyzer: stopwords: %v", prop.Name, err)
+	}
+
+	if err := validateLanguage(cfg.Stemmer); err != nil {
+		return fmt.Errorf("property %q: textAnalyzer: stemmer: %v", prop.Name, err)
+	}
+
+	for _, word := range cfg.AdditionalStopwords {
+		if word == "" {
+			return fmt.Errorf("property %q: textAnalyzer: additionalStopwords "+
+				"must not contain empty words", prop.Name)
+		}
+	}
+
+	if cfg.NgramMinLength < 0 || cfg.NgramMaxLength < 0 {
+		return fmt.Errorf("property %q: textAnalyzer: ngramMinLength and "+
+			"ngramMaxLength must be positive integers", prop.Name)
+	}
+
+	if (cfg.NgramMinLength != 0 || cfg.NgramMaxLength != 0) &&
+		TextTokenizer(prop) != TokenizerNgram {
+		return fmt.Errorf("property %q: textAnalyzer: ngramMinLength and "+
+			"ngramMaxLength can only be set with the %q tokenizer", prop.Name,
+			TokenizerNgram)
+	}
+
+	if TextNgramMinLength(prop) > TextNgramMaxLength(prop) {
+		return fmt.Errorf("property %q: textAnalyzer: ngramMinLength (%d) must "+
+			"not be larger than ngramMaxLength (%d)", prop.Name,
+			TextNgramMinLength(prop), TextNgramMaxLength(prop))
+	}
+
+	return nil
+}
+
+func validateLanguage(language string) error {
+	switch language {
+	case "", LanguageNone, LanguageEnglish, LanguageGerman:
+		return nil
+	default:
+		return fmt.Errorf("unrecognized language %q, must be one of %q, %q or %q",
+			language, LanguageNone, LanguageEnglish, LanguageGerman)
+	}
+}
@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -22,7 +23,7 @@ import (
 // UpdateAction which exists
 func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal,
 	name string, class *models.Class) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Action, name))
 	if err != nil {
 		return err
 	}
@@ -33,7 +34,7 @@ func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal,
 // UpdateThing which exists
 func (m *Manager) UpdateThing(ctx context.Context, principal *models.Principal,
 	name string, class *models.Class) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Thing, name))
 	if err != nil {
 		return err
 	}
@@ -41,7 +42,8 @@ func (m *Manager) UpdateThing(ctx context.Context, principal *models.Principal,
 	return m.updateClass(ctx, name, class, kind.Thing)
 }
 
-// TODO: gh-832: Implement full capabilities, not just keywords/naming
+// TODO: gh-832: Implement full capabilities, not just keywords/naming and
+// the mutable vector index settings
 func (m *Manager) updateClass(ctx context.Context, className string,
 	class *models.Class, k kind.Kind) error {
 	unlock, err := m.locks.LockSchema()
@@ -64,6 +66,9 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 		newKeywords = &class.Keywords
 	}
 
+	vectorIndexConfigUpdate := class.VectorIndexConfig
+	vectorizerUpdate := class.Vectorizer
+
 	semanticSchema := m.state.SchemaFor(k)
 
 	class, err = schema.GetClassByName(semanticSchema, className)
@@ -89,13 +94,24 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 
 	// Validate name / keywords in contextionary
 	if err = m.validateClassNameAndKeywords(ctx, k, classNameAfterUpdate, keywordsAfterUpdate,
-		VectorizeClassName(class)); err != nil {
+		VectorizeClassName(class), Vectorizer(class)); err != nil {
+		return err
+	}
+
+	if vectorizerUpdate != "" && vectorizerUpdate != Vectorizer(class) {
+		return fmt.Errorf("vectorizer cannot be changed once the class has been created")
+	}
+
+	vectorIndexConfigAfterUpdate, vectorIndexConfigChanged, err :=
+		updatedVectorIndexConfig(class, vectorIndexConfigUpdate)
+	if err != nil {
 		return err
 	}
 
 	// Validated! Now apply the changes.
 	class.Class = classNameAfterUpdate
 	class.Keywords = keywordsAfterUpdate
+	class.VectorIndexConfig = vectorIndexConfigAfterUpdate
 
 	err = m.saveSchema(ctx)
 
@@ -103,5 +119,17 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 		return nil
 	}
 
-	return m.migrator.UpdateClass(ctx, k, className, newName, newKeywords)
+	if newName != nil || newKeywords != nil {
+		err = m.migrator.UpdateClass(ctx, k, className, newName, newKeywords)
+		if err != nil {
+			return err
+		}
+	}
+
+	if vectorIndexConfigChanged {
+		return m.migrator.UpdateVectorIndexConfig(ctx, k, classNameAfterUpdate,
+			vectorIndexConfigAfterUpdate)
+	}
+
+	return nil
 }
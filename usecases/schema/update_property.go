@@ -13,7 +13,6 @@ package schema
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -23,7 +22,7 @@ import (
 // UpdateActionProperty of an existing Action Property
 func (m *Manager) UpdateActionProperty(ctx context.Context, principal *models.Principal,
 	class string, name string, property *models.Property) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Action, class))
 	if err != nil {
 		return err
 	}
@@ -34,7 +33,7 @@ func (m *Manager) UpdateActionProperty(ctx context.Context, principal *models.Pr
 // UpdateThingProperty of an existing Thing Property
 func (m *Manager) UpdateThingProperty(ctx context.Context, principal *models.Principal,
 	class string, name string, property *models.Property) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err := m.authorizer.Authorize(principal, "update", classResource(kind.Thing, class))
 	if err != nil {
 		return err
 	}
@@ -92,7 +91,7 @@ func (m *Manager) updateClassProperty(ctx context.Context, className string, nam
 
 	// Validate name / keywords in contextionary
 	err = m.validatePropertyNameAndKeywords(ctx, className, propNameAfterUpdate, keywordsAfterUpdate,
-		prop.VectorizePropertyName)
+		prop.VectorizePropertyName, Vectorizer(class))
 	if err != nil {
 		return err
 	}
@@ -112,7 +111,7 @@ func (m *Manager) updateClassProperty(ctx context.Context, className string, nam
 // UpdatePropertyAddDataType adds another data type to a property. Warning: It does not lock on its own, assumes that it is called from when a schema lock is already held!
 func (m *Manager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
 	kind kind.Kind, className string, propName string, newDataType string) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("schema/%ss", kind.Name()))
+	err := m.authorizer.Authorize(principal, "update", classResource(kind, className))
 	if err != nil {
 		return err
 	}
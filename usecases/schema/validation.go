@@ -41,12 +41,18 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 
 // Check that the format of the name is correct
 // Check that the name is acceptable according to the contextionary
-func (m *Manager) validateClassNameAndKeywords(ctx context.Context, knd kind.Kind, className string, keywords models.Keywords, vectorizeClass bool) error {
+func (m *Manager) validateClassNameAndKeywords(ctx context.Context, knd kind.Kind, className string, keywords models.Keywords, vectorizeClass bool, vectorizer string) error {
 	_, err := schema.ValidateClassName(className)
 	if err != nil {
 		return err
 	}
 
+	if vectorizer == VectorizerNone {
+		// the contextionary is never contacted for classes without a
+		// vectorizer, so there is nothing else to validate
+		return nil
+	}
+
 	// keywords
 	stopWordsFound := 0
 	for _, keyword := range keywords {
@@ -128,6 +134,23 @@ func validatePropertyNameUniqueness(propertyName string, class *models.Class) er
 	return nil
 }
 
+// maxShardCount limits the number of shards per class, as every shard has
+// its own bolt file, vector index and commit log routines
+const maxShardCount = 64
+
+func validateShardCount(count int64) error {
+	if count < 0 {
+		return fmt.Errorf("shardCount must be a positive number, but got %d", count)
+	}
+
+	if count > maxShardCount {
+		return fmt.Errorf("shardCount must not be larger than %d, but got %d",
+			maxShardCount, count)
+	}
+
+	return nil
+}
+
 func validateWeight(keyword *models.KeywordsItems0) error {
 	if 0 <= keyword.Weight && keyword.Weight <= 1 {
 		return nil
@@ -138,12 +161,18 @@ func validateWeight(keyword *models.KeywordsItems0) error {
 
 // Check that the format of the name is correct
 // Check that the name is acceptable according to the contextionary
-func (m *Manager) validatePropertyNameAndKeywords(ctx context.Context, className string, propertyName string, keywords models.Keywords, vectorizeProperty bool) error {
+func (m *Manager) validatePropertyNameAndKeywords(ctx context.Context, className string, propertyName string, keywords models.Keywords, vectorizeProperty bool, vectorizer string) error {
 	_, err := schema.ValidatePropertyName(propertyName)
 	if err != nil {
 		return err
 	}
 
+	if vectorizer == VectorizerNone {
+		// the contextionary is never contacted for classes without a
+		// vectorizer, so there is nothing else to validate
+		return nil
+	}
+
 	stopWordsFound := 0
 	for _, keyword := range keywords {
 		word := strings.ToLower(keyword.Keyword)
@@ -252,6 +281,12 @@ func (m *Manager) validateNetworkCrossRefs(dataTypes []string) error {
 // able to build a vector. In this case we should fail early and deny
 // validation.
 func (m *Manager) validatePropertyIndexState(ctx context.Context, class *models.Class) error {
+	if Vectorizer(class) == VectorizerNone {
+		// vectors are provided by the user, so the index state of the
+		// properties is irrelevant for vector-building
+		return nil
+	}
+
 	if VectorizeClassName(class) {
 		// if the user chooses to vectorize the classname, vector-building will
 		// always be possible, no need to investigate further
@@ -987,7 +987,369 @@ func Test_AllUsablePropsNoindexed(t *testing.T) {
 	})
 }
 
+func Test_Validation_ShardCount(t *testing.T) {
+	type testCase struct {
+		name       string
+		shardCount int64
+		valid      bool
+	}
+
+	tests := []testCase{
+		{name: "not set", shardCount: 0, valid: true},
+		{name: "single shard", shardCount: 1, valid: true},
+		{name: "multiple shards", shardCount: 8, valid: true},
+		{name: "negative", shardCount: -1, valid: false},
+		{name: "the maximum", shardCount: 64, valid: true},
+		{name: "too many", shardCount: 65, valid: false},
+		{name: "way too many", shardCount: 1000000, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:      "Car",
+				ShardCount: test.shardCount,
+			}
+
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, class)
+			assert.Equal(t, test.valid, err == nil)
+		})
+	}
+}
+
+func Test_Validation_VectorIndexConfig(t *testing.T) {
+	type testCase struct {
+		name   string
+		config *models.VectorIndexConfig
+		valid  bool
+	}
+
+	tests := []testCase{
+		{name: "not set", config: nil, valid: true},
+		{name: "empty", config: &models.VectorIndexConfig{}, valid: true},
+		{name: "cosine", config: &models.VectorIndexConfig{Distance: "cosine"}, valid: true},
+		{name: "dot", config: &models.VectorIndexConfig{Distance: "dot"}, valid: true},
+		{name: "l2-squared", config: &models.VectorIndexConfig{Distance: "l2-squared"}, valid: true},
+		{name: "manhattan", config: &models.VectorIndexConfig{Distance: "manhattan"}, valid: true},
+		{name: "hamming", config: &models.VectorIndexConfig{Distance: "hamming"}, valid: true},
+		{name: "unknown distance", config: &models.VectorIndexConfig{Distance: "jaccard"}, valid: false},
+		{name: "hnsw", config: &models.VectorIndexConfig{Type: "hnsw"}, valid: true},
+		{name: "flat", config: &models.VectorIndexConfig{Type: "flat", Distance: "dot"}, valid: true},
+		{name: "unknown type", config: &models.VectorIndexConfig{Type: "ivf"}, valid: false},
+		{name: "flat with pq", config: &models.VectorIndexConfig{
+			Type: "flat", Pq: &models.ProductQuantizationConfig{Enabled: true},
+		}, valid: false},
+		{name: "hnsw settings", config: &models.VectorIndexConfig{
+			MaxConnections: 32, EfConstruction: 256, Ef: 100,
+			VectorCacheMaxObjects: 1000, CleanupIntervalSeconds: 60,
+		}, valid: true},
+		{name: "dynamic ef", config: &models.VectorIndexConfig{Ef: -1}, valid: true},
+		{name: "negative ef", config: &models.VectorIndexConfig{Ef: -2}, valid: false},
+		{name: "negative maxConnections", config: &models.VectorIndexConfig{MaxConnections: -1}, valid: false},
+		{name: "negative efConstruction", config: &models.VectorIndexConfig{EfConstruction: -1}, valid: false},
+		{name: "negative cache size", config: &models.VectorIndexConfig{VectorCacheMaxObjects: -1}, valid: false},
+		{name: "negative cleanup interval", config: &models.VectorIndexConfig{CleanupIntervalSeconds: -1}, valid: false},
+		{name: "pq settings", config: &models.VectorIndexConfig{
+			Pq: &models.ProductQuantizationConfig{
+				Enabled: true, Segments: 64, Centroids: 128, TrainingLimit: 10000, RescoreLimit: 100,
+			},
+		}, valid: true},
+		{name: "pq segments which divide the dimensions", config: &models.VectorIndexConfig{
+			Dimensions: 300, Pq: &models.ProductQuantizationConfig{Segments: 75},
+		}, valid: true},
+		{name: "pq segments which do not divide the dimensions", config: &models.VectorIndexConfig{
+			Dimensions: 300, Pq: &models.ProductQuantizationConfig{Segments: 64},
+		}, valid: false},
+		{name: "too many pq centroids", config: &models.VectorIndexConfig{
+			Pq: &models.ProductQuantizationConfig{Centroids: 257},
+		}, valid: false},
+		{name: "negative pq training limit", config: &models.VectorIndexConfig{
+			Pq: &models.ProductQuantizationConfig{TrainingLimit: -1},
+		}, valid: false},
+		{name: "negative pq rescore limit", config: &models.VectorIndexConfig{
+			Pq: &models.ProductQuantizationConfig{RescoreLimit: -1},
+		}, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:             "Car",
+				VectorIndexConfig: test.config,
+			}
+
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, class)
+			assert.Equal(t, test.valid, err == nil)
+		})
+	}
+}
+
+func Test_Validation_Vectorizer(t *testing.T) {
+	type testCase struct {
+		name  string
+		class *models.Class
+		valid bool
+	}
+
+	tests := []testCase{
+		{
+			name:  "default vectorizer",
+			class: &models.Class{Class: "Car"},
+			valid: true,
+		},
+		{
+			name:  "explicit contextionary",
+			class: &models.Class{Class: "Car", Vectorizer: "text2vec-contextionary"},
+			valid: true,
+		},
+		{
+			name:  "contextionary with a c11y-invalid class name",
+			class: &models.Class{Class: "Carrot"},
+			valid: false,
+		},
+		{
+			name: "no vectorizer with a c11y-invalid class and property name",
+			class: &models.Class{
+				Class:             "Carrot",
+				Vectorizer:        "none",
+				VectorIndexConfig: &models.VectorIndexConfig{Dimensions: 128},
+				Properties: []*models.Property{
+					{Name: "carrotWeight", DataType: []string{"number"}},
+				},
+			},
+			valid: true,
+		},
+		{
+			name:  "no vectorizer without dimensions",
+			class: &models.Class{Class: "Car", Vectorizer: "none"},
+			valid: false,
+		},
+		{
+			name:  "unknown vectorizer",
+			class: &models.Class{Class: "Car", Vectorizer: "img2vec"},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, test.class)
+			assert.Equal(t, test.valid, err == nil, "unexpected error: %v", err)
+		})
+	}
+}
+
+func Test_UpdateVectorIndexConfig(t *testing.T) {
+	type testCase struct {
+		name           string
+		initial        *models.VectorIndexConfig
+		update         *models.VectorIndexConfig
+		expectedErr    bool
+		expectedConfig *models.VectorIndexConfig
+	}
+
+	tests := []testCase{
+		{
+			name:           "not part of the update",
+			initial:        &models.VectorIndexConfig{Ef: 100},
+			update:         nil,
+			expectedConfig: &models.VectorIndexConfig{Ef: 100},
+		},
+		{
+			name:           "changing ef and the cache size",
+			initial:        &models.VectorIndexConfig{Distance: "dot", Ef: 100},
+			update:         &models.VectorIndexConfig{Ef: 200, VectorCacheMaxObjects: 10},
+			expectedConfig: &models.VectorIndexConfig{Distance: "dot", Ef: 200, VectorCacheMaxObjects: 10},
+		},
+		{
+			name:           "changing ef without an initial config",
+			initial:        nil,
+			update:         &models.VectorIndexConfig{Ef: 200},
+			expectedConfig: &models.VectorIndexConfig{Ef: 200},
+		},
+		{
+			name:           "resending the immutable settings unchanged",
+			initial:        &models.VectorIndexConfig{MaxConnections: 32},
+			update:         &models.VectorIndexConfig{MaxConnections: 32, EfConstruction: 128, Ef: 50},
+			expectedConfig: &models.VectorIndexConfig{MaxConnections: 32, Ef: 50},
+		},
+		{
+			name:        "changing the distance",
+			update:      &models.VectorIndexConfig{Distance: "dot"},
+			expectedErr: true,
+		},
+		{
+			name:        "changing maxConnections",
+			update:      &models.VectorIndexConfig{MaxConnections: 10},
+			expectedErr: true,
+		},
+		{
+			name:        "changing efConstruction",
+			update:      &models.VectorIndexConfig{EfConstruction: 10},
+			expectedErr: true,
+		},
+		{
+			name:        "changing the cleanup interval",
+			update:      &models.VectorIndexConfig{CleanupIntervalSeconds: 10},
+			expectedErr: true,
+		},
+		{
+			name:        "changing the type",
+			update:      &models.VectorIndexConfig{Type: "flat"},
+			expectedErr: true,
+		},
+		{
+			name:        "enabling pq on a flat index",
+			initial:     &models.VectorIndexConfig{Type: "flat"},
+			update:      &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Enabled: true}},
+			expectedErr: true,
+		},
+		{
+			name:        "enabling async indexing",
+			update:      &models.VectorIndexConfig{AsyncIndexing: true},
+			expectedErr: true,
+		},
+		{
+			name:           "omitting async indexing",
+			initial:        &models.VectorIndexConfig{AsyncIndexing: true},
+			update:         &models.VectorIndexConfig{Ef: 50},
+			expectedConfig: &models.VectorIndexConfig{AsyncIndexing: true, Ef: 50},
+		},
+		{
+			name:        "an invalid ef",
+			update:      &models.VectorIndexConfig{Ef: -5},
+			expectedErr: true,
+		},
+		{
+			name:    "enabling pq",
+			initial: &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Segments: 32}},
+			update: &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{
+				Enabled: true, RescoreLimit: 50,
+			}},
+			expectedConfig: &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{
+				Enabled: true, Segments: 32, RescoreLimit: 50,
+			}},
+		},
+		{
+			name:           "disabling pq",
+			initial:        &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Enabled: true}},
+			update:         &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Enabled: false}},
+			expectedConfig: &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Enabled: false}},
+		},
+		{
+			name:        "changing the pq segments",
+			initial:     &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Segments: 32}},
+			update:      &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Segments: 16}},
+			expectedErr: true,
+		},
+		{
+			name:        "changing the pq centroids",
+			update:      &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{Centroids: 16}},
+			expectedErr: true,
+		},
+		{
+			name:        "changing the pq training limit",
+			update:      &models.VectorIndexConfig{Pq: &models.ProductQuantizationConfig{TrainingLimit: 5}},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, &models.Class{
+				Class:             "Car",
+				VectorIndexConfig: test.initial,
+			})
+			require.Nil(t, err)
+
+			err = m.UpdateThing(context.Background(), nil, "Car", &models.Class{
+				Class:             "Car",
+				VectorIndexConfig: test.update,
+			})
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			schema := m.GetSchemaSkipAuth()
+			class := schema.FindClassByName("Car")
+			require.NotNil(t, class)
+			assert.Equal(t, test.expectedConfig, class.VectorIndexConfig)
+		})
+	}
+}
+
 func ptFalse() *bool {
 	f := false
 	return &f
 }
+
+func Test_Validation_TextAnalyzer(t *testing.T) {
+	type testCase struct {
+		name     string
+		dataType string
+		config   *models.TextAnalyzerConfig
+		valid    bool
+	}
+
+	tests := []testCase{
+		{name: "not set", dataType: "text", config: nil, valid: true},
+		{name: "empty", dataType: "text", config: &models.TextAnalyzerConfig{}, valid: true},
+		{name: "word tokenizer", dataType: "text",
+			config: &models.TextAnalyzerConfig{Tokenizer: "word"}, valid: true},
+		{name: "whitespace tokenizer on a string prop", dataType: "string",
+			config: &models.TextAnalyzerConfig{Tokenizer: "whitespace"}, valid: true},
+		{name: "field tokenizer", dataType: "string",
+			config: &models.TextAnalyzerConfig{Tokenizer: "field"}, valid: true},
+		{name: "unknown tokenizer", dataType: "text",
+			config: &models.TextAnalyzerConfig{Tokenizer: "sentence"}, valid: false},
+		{name: "text analyzer on an int prop", dataType: "int",
+			config: &models.TextAnalyzerConfig{Tokenizer: "word"}, valid: false},
+		{name: "german stopwords and stemmer", dataType: "text",
+			config: &models.TextAnalyzerConfig{Stopwords: "german", Stemmer: "german"}, valid: true},
+		{name: "unknown stopwords language", dataType: "text",
+			config: &models.TextAnalyzerConfig{Stopwords: "klingon"}, valid: false},
+		{name: "unknown stemmer language", dataType: "text",
+			config: &models.TextAnalyzerConfig{Stemmer: "klingon"}, valid: false},
+		{name: "additional stopwords", dataType: "text",
+			config: &models.TextAnalyzerConfig{AdditionalStopwords: []string{"foo"}}, valid: true},
+		{name: "empty additional stopword", dataType: "text",
+			config: &models.TextAnalyzerConfig{AdditionalStopwords: []string{""}}, valid: false},
+		{name: "ngram tokenizer", dataType: "text", config: &models.TextAnalyzerConfig{
+			Tokenizer: "ngram", NgramMinLength: 2, NgramMaxLength: 4,
+		}, valid: true},
+		{name: "ngram lengths without ngram tokenizer", dataType: "text", config: &models.TextAnalyzerConfig{
+			NgramMinLength: 2, NgramMaxLength: 4,
+		}, valid: false},
+		{name: "ngram min length above max length", dataType: "text", config: &models.TextAnalyzerConfig{
+			Tokenizer: "ngram", NgramMinLength: 5, NgramMaxLength: 4,
+		}, valid: false},
+		{name: "negative ngram length", dataType: "text", config: &models.TextAnalyzerConfig{
+			Tokenizer: "ngram", NgramMinLength: -1,
+		}, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Class: "Car",
+				Properties: []*models.Property{
+					{
+						Name:         "description",
+						DataType:     []string{test.dataType},
+						TextAnalyzer: test.config,
+					},
+				},
+			}
+
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, class)
+			assert.Equal(t, test.valid, err == nil)
+		})
+	}
+}
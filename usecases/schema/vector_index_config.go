@@ -0,0 +1,385 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Distance metrics which can be set in a class' vector index config
+const (
+	DistanceCosine    = "cosine"
+	DistanceDot       = "dot"
+	DistanceL2Squared = "l2-squared"
+	DistanceManhattan = "manhattan"
+	DistanceHamming   = "hamming"
+)
+
+// Vector index types which can be set in a class' vector index config
+const (
+	VectorIndexTypeHNSW = "hnsw"
+	VectorIndexTypeFlat = "flat"
+)
+
+// VectorIndexType is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func VectorIndexType(class *models.Class) string {
+	const defaultValue = VectorIndexTypeHNSW
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Type == "" {
+		return defaultValue
+	}
+
+	return class.VectorIndexConfig.Type
+}
+
+// VectorDistance is the only safe way to access this property, as the config
+// could otherwise be nil. It is also the single place a default is set
+func VectorDistance(class *models.Class) string {
+	const defaultValue = DistanceCosine
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Distance == "" {
+		return defaultValue
+	}
+
+	return class.VectorIndexConfig.Distance
+}
+
+// VectorMaxConnections is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func VectorMaxConnections(class *models.Class) int {
+	const defaultValue = 60
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.MaxConnections == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.MaxConnections)
+}
+
+// VectorEFConstruction is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func VectorEFConstruction(class *models.Class) int {
+	const defaultValue = 128
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.EfConstruction == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.EfConstruction)
+}
+
+// VectorEF is the only safe way to access this property, as the config could
+// otherwise be nil. A value of -1 (the default) indicates that the vector
+// index should derive the ef from the limit of each query
+func VectorEF(class *models.Class) int {
+	const defaultValue = -1
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Ef == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.Ef)
+}
+
+// VectorCacheMaxObjects is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func VectorCacheMaxObjects(class *models.Class) int {
+	const defaultValue = 50000
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.VectorCacheMaxObjects == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.VectorCacheMaxObjects)
+}
+
+// VectorCleanupIntervalSeconds is the only safe way to access this property,
+// as the config could otherwise be nil. It is also the single place a
+// default is set
+func VectorCleanupIntervalSeconds(class *models.Class) int {
+	const defaultValue = 300
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.CleanupIntervalSeconds == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.CleanupIntervalSeconds)
+}
+
+// VectorPQEnabled is the only safe way to access this property, as the
+// config could otherwise be nil. Compression is disabled by default
+func VectorPQEnabled(class *models.Class) bool {
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Pq == nil {
+		return false
+	}
+
+	return class.VectorIndexConfig.Pq.Enabled
+}
+
+// VectorPQSegments is the only safe way to access this property, as the
+// config could otherwise be nil. A value of 0 (the default) indicates that
+// the vector index should derive the segments from the dimensions
+func VectorPQSegments(class *models.Class) int {
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Pq == nil {
+		return 0
+	}
+
+	return int(class.VectorIndexConfig.Pq.Segments)
+}
+
+// VectorPQCentroids is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func VectorPQCentroids(class *models.Class) int {
+	const defaultValue = 256
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Pq == nil ||
+		class.VectorIndexConfig.Pq.Centroids == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.Pq.Centroids)
+}
+
+// VectorPQTrainingLimit is the only safe way to access this property, as the
+// config could otherwise be nil. It is also the single place a default is set
+func VectorPQTrainingLimit(class *models.Class) int {
+	const defaultValue = 100000
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Pq == nil ||
+		class.VectorIndexConfig.Pq.TrainingLimit == 0 {
+		return defaultValue
+	}
+
+	return int(class.VectorIndexConfig.Pq.TrainingLimit)
+}
+
+// VectorPQRescoreLimit is the only safe way to access this property, as the
+// config could otherwise be nil. A value of 0 (the default) disables
+// rescoring
+func VectorPQRescoreLimit(class *models.Class) int {
+	if class.VectorIndexConfig == nil || class.VectorIndexConfig.Pq == nil {
+		return 0
+	}
+
+	return int(class.VectorIndexConfig.Pq.RescoreLimit)
+}
+
+// VectorAsyncIndexing is the only safe way to access this property, as the
+// config could otherwise be nil. Vectors are indexed synchronously by default
+func VectorAsyncIndexing(class *models.Class) bool {
+	if class.VectorIndexConfig == nil {
+		return false
+	}
+
+	return class.VectorIndexConfig.AsyncIndexing
+}
+
+func validateVectorIndexConfig(cfg *models.VectorIndexConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	switch cfg.Type {
+	case "", VectorIndexTypeHNSW, VectorIndexTypeFlat:
+	default:
+		return fmt.Errorf("vectorIndexConfig: unrecognized type %q, must be one of "+
+			"%q or %q", cfg.Type, VectorIndexTypeHNSW, VectorIndexTypeFlat)
+	}
+
+	if cfg.Type == VectorIndexTypeFlat && cfg.Pq != nil && cfg.Pq.Enabled {
+		return errFlatPQ
+	}
+
+	switch cfg.Distance {
+	case "", DistanceCosine, DistanceDot, DistanceL2Squared, DistanceManhattan,
+		DistanceHamming:
+	default:
+		return fmt.Errorf("vectorIndexConfig: unrecognized distance %q, must be one of "+
+			"%q, %q, %q, %q or %q", cfg.Distance, DistanceCosine, DistanceDot,
+			DistanceL2Squared, DistanceManhattan, DistanceHamming)
+	}
+
+	if cfg.Dimensions < 0 {
+		return fmt.Errorf("vectorIndexConfig: dimensions must be a positive "+
+			"integer, got %d", cfg.Dimensions)
+	}
+
+	if cfg.MaxConnections < 0 {
+		return fmt.Errorf("vectorIndexConfig: maxConnections must be a positive "+
+			"integer, got %d", cfg.MaxConnections)
+	}
+
+	if cfg.EfConstruction < 0 {
+		return fmt.Errorf("vectorIndexConfig: efConstruction must be a positive "+
+			"integer, got %d", cfg.EfConstruction)
+	}
+
+	if cfg.Ef < -1 {
+		return fmt.Errorf("vectorIndexConfig: ef must be a positive integer or -1 "+
+			"to derive it from the query limit, got %d", cfg.Ef)
+	}
+
+	if cfg.VectorCacheMaxObjects < 0 {
+		return fmt.Errorf("vectorIndexConfig: vectorCacheMaxObjects must be a "+
+			"positive integer, got %d", cfg.VectorCacheMaxObjects)
+	}
+
+	if cfg.CleanupIntervalSeconds < 0 {
+		return fmt.Errorf("vectorIndexConfig: cleanupIntervalSeconds must be a "+
+			"positive integer, got %d", cfg.CleanupIntervalSeconds)
+	}
+
+	return validatePQConfig(cfg)
+}
+
+func validatePQConfig(cfg *models.VectorIndexConfig) error {
+	pq := cfg.Pq
+	if pq == nil {
+		return nil
+	}
+
+	if pq.Segments < 0 {
+		return fmt.Errorf("vectorIndexConfig: pq.segments must be a positive "+
+			"integer, got %d", pq.Segments)
+	}
+
+	if pq.Segments > 0 && cfg.Dimensions > 0 && cfg.Dimensions%pq.Segments != 0 {
+		return fmt.Errorf("vectorIndexConfig: pq.segments (%d) must divide the "+
+			"dimensions (%d)", pq.Segments, cfg.Dimensions)
+	}
+
+	if pq.Centroids < 0 || pq.Centroids > 256 {
+		return fmt.Errorf("vectorIndexConfig: pq.centroids must be between 1 "+
+			"and 256, got %d", pq.Centroids)
+	}
+
+	if pq.TrainingLimit < 0 {
+		return fmt.Errorf("vectorIndexConfig: pq.trainingLimit must be a "+
+			"positive integer, got %d", pq.TrainingLimit)
+	}
+
+	if pq.RescoreLimit < 0 {
+		return fmt.Errorf("vectorIndexConfig: pq.rescoreLimit must be a "+
+			"positive integer, got %d", pq.RescoreLimit)
+	}
+
+	return nil
+}
+
+// updatedVectorIndexConfig merges the vector index config of an update
+// request into the config of an existing class. Only ef,
+// vectorCacheMaxObjects, pq.enabled and pq.rescoreLimit can be changed on a
+// live class, any attempt to change another setting is an error. Settings
+// which are not present in the update remain untouched. As there is no way to
+// tell an unset boolean from false, pq.enabled and pq.rescoreLimit are always
+// taken from the update if it contains a pq config. For the same reason an
+// update can only be rejected for enabling asyncIndexing, not for omitting
+// it. The returned bool indicates whether anything changed.
+func updatedVectorIndexConfig(class *models.Class,
+	update *models.VectorIndexConfig) (*models.VectorIndexConfig, bool, error) {
+	if update == nil {
+		return class.VectorIndexConfig, false, nil
+	}
+
+	if err := validateVectorIndexConfig(update); err != nil {
+		return nil, false, err
+	}
+
+	if update.Type != "" && update.Type != VectorIndexType(class) {
+		return nil, false, immutableVectorIndexSettingErr("type")
+	}
+
+	if update.Pq != nil && update.Pq.Enabled &&
+		VectorIndexType(class) == VectorIndexTypeFlat {
+		return nil, false, errFlatPQ
+	}
+
+	if update.Distance != "" && update.Distance != VectorDistance(class) {
+		return nil, false, immutableVectorIndexSettingErr("distance")
+	}
+
+	if update.Dimensions != 0 &&
+		int(update.Dimensions) != VectorDimensions(class) {
+		return nil, false, immutableVectorIndexSettingErr("dimensions")
+	}
+
+	if update.MaxConnections != 0 &&
+		int(update.MaxConnections) != VectorMaxConnections(class) {
+		return nil, false, immutableVectorIndexSettingErr("maxConnections")
+	}
+
+	if update.EfConstruction != 0 &&
+		int(update.EfConstruction) != VectorEFConstruction(class) {
+		return nil, false, immutableVectorIndexSettingErr("efConstruction")
+	}
+
+	if update.CleanupIntervalSeconds != 0 &&
+		int(update.CleanupIntervalSeconds) != VectorCleanupIntervalSeconds(class) {
+		return nil, false, immutableVectorIndexSettingErr("cleanupIntervalSeconds")
+	}
+
+	if update.AsyncIndexing && !VectorAsyncIndexing(class) {
+		return nil, false, immutableVectorIndexSettingErr("asyncIndexing")
+	}
+
+	if update.Pq != nil {
+		if update.Pq.Segments != 0 &&
+			int(update.Pq.Segments) != VectorPQSegments(class) {
+			return nil, false, immutableVectorIndexSettingErr("pq.segments")
+		}
+
+		if update.Pq.Centroids != 0 &&
+			int(update.Pq.Centroids) != VectorPQCentroids(class) {
+			return nil, false, immutableVectorIndexSettingErr("pq.centroids")
+		}
+
+		if update.Pq.TrainingLimit != 0 &&
+			int(update.Pq.TrainingLimit) != VectorPQTrainingLimit(class) {
+			return nil, false, immutableVectorIndexSettingErr("pq.trainingLimit")
+		}
+	}
+
+	out := &models.VectorIndexConfig{}
+	if class.VectorIndexConfig != nil {
+		*out = *class.VectorIndexConfig
+	}
+
+	changed := false
+	if update.Ef != 0 && int(update.Ef) != VectorEF(class) {
+		out.Ef = update.Ef
+		changed = true
+	}
+
+	if update.VectorCacheMaxObjects != 0 &&
+		int(update.VectorCacheMaxObjects) != VectorCacheMaxObjects(class) {
+		out.VectorCacheMaxObjects = update.VectorCacheMaxObjects
+		changed = true
+	}
+
+	if update.Pq != nil &&
+		(update.Pq.Enabled != VectorPQEnabled(class) ||
+			int(update.Pq.RescoreLimit) != VectorPQRescoreLimit(class)) {
+		pq := &models.ProductQuantizationConfig{}
+		if out.Pq != nil {
+			*pq = *out.Pq
+		}
+		pq.Enabled = update.Pq.Enabled
+		pq.RescoreLimit = update.Pq.RescoreLimit
+		out.Pq = pq
+		changed = true
+	}
+
+	return out, changed, nil
+}
+
+var errFlatPQ = fmt.Errorf("vectorIndexConfig: pq is not supported by vector "+
+	"index type %q", VectorIndexTypeFlat)
+
+func immutableVectorIndexSettingErr(name string) error {
+	return fmt.Errorf("vectorIndexConfig: %s cannot be changed once the class "+
+		"has been created, only ef, vectorCacheMaxObjects, pq.enabled and "+
+		"pq.rescoreLimit can be updated", name)
+}
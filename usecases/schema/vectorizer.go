@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Vectorizers which can be set on a class
+const (
+	VectorizerContextionary = "text2vec-contextionary"
+	VectorizerNone          = "none"
+)
+
+// Vectorizer is the only safe way to access this property, as it could
+// otherwise be unset. It is also the single place a default is set
+func Vectorizer(class *models.Class) string {
+	const defaultValue = VectorizerContextionary
+	if class.Vectorizer == "" {
+		return defaultValue
+	}
+
+	return class.Vectorizer
+}
+
+// VectorDimensions returns the number of dimensions every vector of the class
+// must have, or 0 if the class does not restrict the dimensions
+func VectorDimensions(class *models.Class) int {
+	if class.VectorIndexConfig == nil {
+		return 0
+	}
+
+	return int(class.VectorIndexConfig.Dimensions)
+}
+
+func validateVectorizer(class *models.Class) error {
+	switch class.Vectorizer {
+	case "", VectorizerContextionary:
+		return nil
+	case VectorizerNone:
+		if VectorDimensions(class) == 0 {
+			return fmt.Errorf("vectorizer %q requires vectorIndexConfig.dimensions to be set, "+
+				"so that the vectors provided on import can be validated", VectorizerNone)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unrecognized vectorizer %q, must be one of %q or %q",
+			class.Vectorizer, VectorizerContextionary, VectorizerNone)
+	}
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package shards
+
+import (
+	"context"
+	"errors"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// fakeAuthorizer records the last request and denies it if err is set
+type fakeAuthorizer struct {
+	verb     string
+	resource string
+	err      error
+}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	f.verb = verb
+	f.resource = resource
+	return f.err
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+// fakeDB returns the configured shards by kind and class name and the
+// configured vector index stats by shard name
+type fakeDB struct {
+	shards           map[kind.Kind]map[schema.ClassName][]Status
+	vectorIndexStats map[string]*VectorIndexStats
+
+	// checkConnectivity records the last vector index stats request
+	checkConnectivity bool
+}
+
+func (f *fakeDB) ShardsStatus(ctx context.Context, kind kind.Kind,
+	className schema.ClassName) ([]Status, error) {
+	shards, ok := f.shards[kind][className]
+	if !ok {
+		return nil, errors.New("no such index")
+	}
+
+	return shards, nil
+}
+
+func (f *fakeDB) VectorIndexStats(ctx context.Context, kind kind.Kind,
+	className schema.ClassName, shardName string,
+	checkConnectivity bool) (*VectorIndexStats, error) {
+	if _, ok := f.shards[kind][className]; !ok {
+		return nil, errors.New("no such index")
+	}
+
+	f.checkConnectivity = checkConnectivity
+	return f.vectorIndexStats[shardName], nil
+}
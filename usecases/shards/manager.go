@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package shards reports the status of the shards of the classes of
+// standalone mode, such as the length of their vector indexing queues and
+// the state of their vector indexes.
+package shards
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// Status of a single shard as reported by the DB
+type Status struct {
+	Name string
+
+	// VectorQueueLength is the number of vectors which are queued for
+	// asynchronous indexing, always 0 if the class indexes synchronously
+	VectorQueueLength int
+
+	// VectorQueueFailures is the number of queued vectors whose last insert
+	// into the vector index failed, they are retried with a backoff
+	VectorQueueFailures int
+}
+
+// VectorIndexStats of a single shard as reported by the DB. Apart from the
+// type, the number of nodes and the vector cache, all fields are specific to
+// hnsw and left empty for a flat index.
+type VectorIndexStats struct {
+	Type  string
+	Nodes int
+
+	Tombstones            int
+	MaxLayer              int
+	EntryPoint            *int // nil if the index is empty
+	VectorCacheCount      int
+	VectorCacheMaxObjects int
+	CommitLogs            []CommitLogFile
+	Layers                []LayerStats // ordered from layer 0 to the max layer
+
+	// Connectivity is nil unless it was requested
+	Connectivity *Connectivity
+}
+
+type CommitLogFile struct {
+	Name string
+	Size int64
+}
+
+type LayerStats struct {
+	Nodes         int
+	AverageDegree float64
+}
+
+type Connectivity struct {
+	Reachable   int
+	Unreachable []int // doc ids, ordered ascending
+}
+
+// DB reports the status of the shards of a class, see db.ShardsStatus and
+// db.VectorIndexStats. VectorIndexStats returns nil if there is no shard with
+// this name.
+type DB interface {
+	ShardsStatus(ctx context.Context, kind kind.Kind,
+		className schema.ClassName) ([]Status, error)
+	VectorIndexStats(ctx context.Context, kind kind.Kind,
+		className schema.ClassName, shardName string,
+		checkConnectivity bool) (*VectorIndexStats, error)
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type Manager struct {
+	db           DB
+	schemaGetter schemaGetter
+	authorizer   authorizer
+}
+
+func NewManager(db DB, sg schemaGetter, authorizer authorizer) *Manager {
+	return &Manager{
+		db:           db,
+		schemaGetter: sg,
+		authorizer:   authorizer,
+	}
+}
+
+// GetThingShards returns the status of every shard of the thing class
+func (m *Manager) GetThingShards(ctx context.Context, principal *models.Principal,
+	className string) (*models.ClassShardsStatus, error) {
+	err := m.authorizer.Authorize(principal, "get", classResource(kind.Thing, className))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getShards(ctx, kind.Thing, className)
+}
+
+// GetActionShards returns the status of every shard of the action class
+func (m *Manager) GetActionShards(ctx context.Context, principal *models.Principal,
+	className string) (*models.ClassShardsStatus, error) {
+	err := m.authorizer.Authorize(principal, "get", classResource(kind.Action, className))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getShards(ctx, kind.Action, className)
+}
+
+// classResource builds the resource of the schema of a single class, such as
+// "schema/things/Car". The status of its shards is part of the class, so it
+// requires the same permission as reading the schema of the class.
+func classResource(k kind.Kind, className string) string {
+	segments := []string{"schema", k.Name() + "s"}
+	if className != "" {
+		segments = append(segments, className)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func (m *Manager) getShards(ctx context.Context, k kind.Kind,
+	className string) (*models.ClassShardsStatus, error) {
+	s := m.schemaGetter.GetSchemaSkipAuth()
+	if s.GetClass(k, schema.ClassName(className)) == nil {
+		return nil, NewErrNotFound("%s class %q does not exist", k.Name(), className)
+	}
+
+	shards, err := m.db.ShardsStatus(ctx, k, schema.ClassName(className))
+	if err != nil {
+		return nil, fmt.Errorf("get shards status of %s class %q: %v",
+			k.Name(), className, err)
+	}
+
+	out := &models.ClassShardsStatus{
+		Class:  className,
+		Shards: make([]*models.ShardStatus, len(shards)),
+	}
+	for i, shard := range shards {
+		out.Shards[i] = &models.ShardStatus{
+			Name:                shard.Name,
+			VectorQueueLength:   int64(shard.VectorQueueLength),
+			VectorQueueFailures: int64(shard.VectorQueueFailures),
+		}
+	}
+
+	return out, nil
+}
+
+// maxUnreachableDocIDs limits the size of the response of a connectivity
+// check of a badly broken graph
+const maxUnreachableDocIDs = 100
+
+// GetThingShardVectorIndex returns diagnostics of the vector index of a
+// single shard of the thing class
+func (m *Manager) GetThingShardVectorIndex(ctx context.Context,
+	principal *models.Principal, className, shardName string,
+	checkConnectivity bool) (*models.VectorIndexStats, error) {
+	err := m.authorizer.Authorize(principal, "get", classResource(kind.Thing, className))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getShardVectorIndex(ctx, kind.Thing, className, shardName,
+		checkConnectivity)
+}
+
+// GetActionShardVectorIndex returns diagnostics of the vector index of a
+// single shard of the action class
+func (m *Manager) GetActionShardVectorIndex(ctx context.Context,
+	principal *models.Principal, className, shardName string,
+	checkConnectivity bool) (*models.VectorIndexStats, error) {
+	err := m.authorizer.Authorize(principal, "get", classResource(kind.Action, className))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getShardVectorIndex(ctx, kind.Action, className, shardName,
+		checkConnectivity)
+}
+
+func (m *Manager) getShardVectorIndex(ctx context.Context, k kind.Kind,
+	className, shardName string,
+	checkConnectivity bool) (*models.VectorIndexStats, error) {
+	s := m.schemaGetter.GetSchemaSkipAuth()
+	if s.GetClass(k, schema.ClassName(className)) == nil {
+		return nil, NewErrNotFound("%s class %q does not exist", k.Name(), className)
+	}
+
+	stats, err := m.db.VectorIndexStats(ctx, k, schema.ClassName(className),
+		shardName, checkConnectivity)
+	if err != nil {
+		return nil, fmt.Errorf("get vector index of shard %q of %s class %q: %v",
+			shardName, k.Name(), className, err)
+	}
+
+	if stats == nil {
+		return nil, NewErrNotFound("%s class %q has no shard %q", k.Name(),
+			className, shardName)
+	}
+
+	out := &models.VectorIndexStats{
+		Class:                 className,
+		Shard:                 shardName,
+		Type:                  stats.Type,
+		Nodes:                 int64(stats.Nodes),
+		Tombstones:            int64(stats.Tombstones),
+		MaxLayer:              int64(stats.MaxLayer),
+		VectorCacheCount:      int64(stats.VectorCacheCount),
+		VectorCacheMaxObjects: int64(stats.VectorCacheMaxObjects),
+		CommitLogs:            make([]*models.VectorIndexCommitLog, len(stats.CommitLogs)),
+		Layers:                make([]*models.VectorIndexLayerStats, len(stats.Layers)),
+	}
+
+	if stats.EntryPoint != nil {
+		entryPoint := int64(*stats.EntryPoint)
+		out.EntryPoint = &entryPoint
+	}
+
+	for i, commitLog := range stats.CommitLogs {
+		out.CommitLogs[i] = &models.VectorIndexCommitLog{
+			Name:      commitLog.Name,
+			SizeBytes: commitLog.Size,
+		}
+	}
+
+	for i, layer := range stats.Layers {
+		out.Layers[i] = &models.VectorIndexLayerStats{
+			Level:         int64(i),
+			Nodes:         int64(layer.Nodes),
+			AverageDegree: layer.AverageDegree,
+		}
+	}
+
+	if stats.Connectivity != nil {
+		unreachable := stats.Connectivity.Unreachable
+		if len(unreachable) > maxUnreachableDocIDs {
+			unreachable = unreachable[:maxUnreachableDocIDs]
+		}
+
+		out.Connectivity = &models.VectorIndexConnectivity{
+			Reachable:         int64(stats.Connectivity.Reachable),
+			Unreachable:       int64(len(stats.Connectivity.Unreachable)),
+			UnreachableDocIds: make([]int64, len(unreachable)),
+		}
+		for i, docID := range unreachable {
+			out.Connectivity.UnreachableDocIds[i] = int64(docID)
+		}
+	}
+
+	return out, nil
+}
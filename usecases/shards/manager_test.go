@@ -0,0 +1,258 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package shards
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetShards(t *testing.T) {
+	ctx := context.Background()
+	sg := &fakeSchemaGetter{schema: schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{{Class: "Car"}},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{{Class: "Drive"}},
+		},
+	}}
+	db := &fakeDB{shards: map[kind.Kind]map[schema.ClassName][]Status{
+		kind.Thing: {
+			"Car": {
+				{Name: "shard0", VectorQueueLength: 17, VectorQueueFailures: 2},
+				{Name: "shard1"},
+			},
+		},
+		kind.Action: {
+			"Drive": {{Name: "shard0", VectorQueueLength: 3}},
+		},
+	}}
+
+	t.Run("a thing class", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		m := NewManager(db, sg, authorizer)
+
+		res, err := m.GetThingShards(ctx, nil, "Car")
+		require.Nil(t, err)
+		assert.Equal(t, &models.ClassShardsStatus{
+			Class: "Car",
+			Shards: []*models.ShardStatus{
+				{Name: "shard0", VectorQueueLength: 17, VectorQueueFailures: 2},
+				{Name: "shard1", VectorQueueLength: 0},
+			},
+		}, res)
+		assert.Equal(t, "get", authorizer.verb)
+		assert.Equal(t, "schema/things/Car", authorizer.resource)
+	})
+
+	t.Run("an action class", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		m := NewManager(db, sg, authorizer)
+
+		res, err := m.GetActionShards(ctx, nil, "Drive")
+		require.Nil(t, err)
+		assert.Equal(t, &models.ClassShardsStatus{
+			Class:  "Drive",
+			Shards: []*models.ShardStatus{{Name: "shard0", VectorQueueLength: 3}},
+		}, res)
+		assert.Equal(t, "get", authorizer.verb)
+		assert.Equal(t, "schema/actions/Drive", authorizer.resource)
+	})
+
+	t.Run("a class which does not exist", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{})
+
+		_, err := m.GetThingShards(ctx, nil, "Plane")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("a class of the other kind", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{})
+
+		_, err := m.GetActionShards(ctx, nil, "Car")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("an unauthorized request", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{
+			err: errors.NewForbidden(&models.Principal{}, "get", "schema/things/Car"),
+		})
+
+		_, err := m.GetThingShards(ctx, nil, "Car")
+		assert.IsType(t, errors.Forbidden{}, err)
+	})
+}
+
+func TestGetShardVectorIndex(t *testing.T) {
+	ctx := context.Background()
+	sg := &fakeSchemaGetter{schema: schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{{Class: "Car"}},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{{Class: "Drive"}},
+		},
+	}}
+
+	entryPoint := 7
+	unreachable := make([]int, 150)
+	for i := range unreachable {
+		unreachable[i] = 1000 + i
+	}
+	db := &fakeDB{
+		shards: map[kind.Kind]map[schema.ClassName][]Status{
+			kind.Thing:  {"Car": {{Name: "hnsw"}, {Name: "flat"}}},
+			kind.Action: {"Drive": {{Name: "hnsw"}}},
+		},
+		vectorIndexStats: map[string]*VectorIndexStats{
+			"hnsw": {
+				Type:                  "hnsw",
+				Nodes:                 1200,
+				Tombstones:            3,
+				MaxLayer:              1,
+				EntryPoint:            &entryPoint,
+				VectorCacheCount:      1100,
+				VectorCacheMaxObjects: 2000,
+				CommitLogs: []CommitLogFile{
+					{Name: "1600000000", Size: 4096},
+					{Name: "1600000100", Size: 128},
+				},
+				Layers: []LayerStats{
+					{Nodes: 1200, AverageDegree: 31.5},
+					{Nodes: 40, AverageDegree: 12},
+				},
+			},
+			"flat": {
+				Type:                  "flat",
+				Nodes:                 20,
+				VectorCacheCount:      15,
+				VectorCacheMaxObjects: 50000,
+			},
+		},
+	}
+
+	expectedHNSW := func(class string) *models.VectorIndexStats {
+		entryPoint := int64(7)
+		return &models.VectorIndexStats{
+			Class:                 class,
+			Shard:                 "hnsw",
+			Type:                  "hnsw",
+			Nodes:                 1200,
+			Tombstones:            3,
+			MaxLayer:              1,
+			EntryPoint:            &entryPoint,
+			VectorCacheCount:      1100,
+			VectorCacheMaxObjects: 2000,
+			CommitLogs: []*models.VectorIndexCommitLog{
+				{Name: "1600000000", SizeBytes: 4096},
+				{Name: "1600000100", SizeBytes: 128},
+			},
+			Layers: []*models.VectorIndexLayerStats{
+				{Level: 0, Nodes: 1200, AverageDegree: 31.5},
+				{Level: 1, Nodes: 40, AverageDegree: 12},
+			},
+		}
+	}
+
+	t.Run("an hnsw index of a thing class", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		m := NewManager(db, sg, authorizer)
+
+		res, err := m.GetThingShardVectorIndex(ctx, nil, "Car", "hnsw", false)
+		require.Nil(t, err)
+		assert.Equal(t, expectedHNSW("Car"), res)
+		assert.False(t, db.checkConnectivity)
+		assert.Equal(t, "get", authorizer.verb)
+		assert.Equal(t, "schema/things/Car", authorizer.resource)
+	})
+
+	t.Run("an hnsw index of an action class", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		m := NewManager(db, sg, authorizer)
+
+		res, err := m.GetActionShardVectorIndex(ctx, nil, "Drive", "hnsw", true)
+		require.Nil(t, err)
+		assert.Equal(t, expectedHNSW("Drive"), res)
+		assert.True(t, db.checkConnectivity)
+		assert.Equal(t, "get", authorizer.verb)
+		assert.Equal(t, "schema/actions/Drive", authorizer.resource)
+	})
+
+	t.Run("a flat index", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{})
+
+		res, err := m.GetThingShardVectorIndex(ctx, nil, "Car", "flat", false)
+		require.Nil(t, err)
+		assert.Equal(t, &models.VectorIndexStats{
+			Class:                 "Car",
+			Shard:                 "flat",
+			Type:                  "flat",
+			Nodes:                 20,
+			VectorCacheCount:      15,
+			VectorCacheMaxObjects: 50000,
+			CommitLogs:            []*models.VectorIndexCommitLog{},
+			Layers:                []*models.VectorIndexLayerStats{},
+		}, res)
+	})
+
+	t.Run("the unreachable doc ids are limited", func(t *testing.T) {
+		db.vectorIndexStats["broken"] = &VectorIndexStats{
+			Type:  "hnsw",
+			Nodes: 1200,
+			Connectivity: &Connectivity{
+				Reachable:   1050,
+				Unreachable: unreachable,
+			},
+		}
+		m := NewManager(db, sg, &fakeAuthorizer{})
+
+		res, err := m.GetThingShardVectorIndex(ctx, nil, "Car", "broken", true)
+		require.Nil(t, err)
+		require.NotNil(t, res.Connectivity)
+		assert.Equal(t, int64(1050), res.Connectivity.Reachable)
+		assert.Equal(t, int64(150), res.Connectivity.Unreachable)
+		require.Len(t, res.Connectivity.UnreachableDocIds, 100)
+		assert.Equal(t, int64(1000), res.Connectivity.UnreachableDocIds[0])
+		assert.Equal(t, int64(1099), res.Connectivity.UnreachableDocIds[99])
+	})
+
+	t.Run("a shard which does not exist", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{})
+
+		_, err := m.GetThingShardVectorIndex(ctx, nil, "Car", "shard5", false)
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("a class of the other kind", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{})
+
+		_, err := m.GetActionShardVectorIndex(ctx, nil, "Car", "hnsw", false)
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("an unauthorized request", func(t *testing.T) {
+		m := NewManager(db, sg, &fakeAuthorizer{
+			err: errors.NewForbidden(&models.Principal{}, "get", "schema/things/Car"),
+		})
+
+		_, err := m.GetThingShardVectorIndex(ctx, nil, "Car", "hnsw", false)
+		assert.IsType(t, errors.Forbidden{}, err)
+	})
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// classResource builds the resource an authorizer decides on for the objects
+// of a class, such as "things/Article". A traversal returns the same objects
+// as the REST API, so it requires the same permissions. The class is omitted
+// if it is empty.
+func classResource(k kind.Kind, className string) string {
+	segments := []string{k.Name() + "s"}
+	if className != "" {
+		segments = append(segments, className)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// authorizeGet authorizes the queried class as well as every class which is
+// resolved through cross-references
+func (t *Traverser) authorizeGet(principal *models.Principal,
+	params GetParams) error {
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	resources := append([]string{classResource(params.Kind, params.ClassName)},
+		referencedClassResources(s, params.Properties)...)
+
+	return t.authorizeResources(principal, "get", resources)
+}
+
+// authorizeNearObject authorizes the class of the object whose vector is
+// searched with. It can be of any class, not only the one which is queried.
+// A missing object is not an authorization error, it is reported by the
+// explorer.
+func (t *Traverser) authorizeNearObject(ctx context.Context,
+	principal *models.Principal, params GetParams) error {
+	nearObject := params.NearObject
+	if nearObject == nil && params.Explore != nil {
+		nearObject = params.Explore.NearObject
+	}
+
+	if nearObject == nil {
+		return nil
+	}
+
+	res, err := objectByID(ctx, t.vectorSearcher, strfmt.UUID(nearObject.ID))
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	return t.authorizer.Authorize(principal, "get",
+		classResource(res.Kind, res.ClassName))
+}
+
+// referencedClassResources recursively collects the classes of all selected
+// references. Classes which are not part of the schema cannot be resolved and
+// are therefore skipped.
+func referencedClassResources(s schema.Schema,
+	props SelectProperties) []string {
+	var resources []string
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			k, ok := s.GetKindOfClass(schema.ClassName(ref.ClassName))
+			if ok {
+				resources = append(resources, classResource(k, ref.ClassName))
+			}
+
+			resources = append(resources,
+				referencedClassResources(s, ref.RefProperties)...)
+		}
+	}
+
+	return resources
+}
+
+// authorizeAllClasses authorizes the verb on every class of both kinds, as a
+// search which is not limited to a class can return objects of all of them.
+// Without any classes, both kinds as a whole are authorized.
+func (t *Traverser) authorizeAllClasses(principal *models.Principal,
+	verb string) error {
+	s := t.schemaGetter.GetSchemaSkipAuth()
+
+	var resources []string
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		semanticSchema := s.SemanticSchemaFor(k)
+		if semanticSchema == nil {
+			continue
+		}
+
+		for _, class := range semanticSchema.Classes {
+			resources = append(resources, classResource(k, class.Class))
+		}
+	}
+
+	if len(resources) == 0 {
+		resources = []string{classResource(kind.Thing, ""),
+			classResource(kind.Action, "")}
+	}
+
+	return t.authorizeResources(principal, verb, resources)
+}
+
+// authorizeResources authorizes the verb on every distinct resource
+func (t *Traverser) authorizeResources(principal *models.Principal,
+	verb string, resources []string) error {
+	authorized := map[string]struct{}{}
+	for _, resource := range resources {
+		if _, ok := authorized[resource]; ok {
+			continue
+		}
+
+		if err := t.authorizer.Authorize(principal, verb, resource); err != nil {
+			return err
+		}
+		authorized[resource] = struct{}{}
+	}
+
+	return nil
+}
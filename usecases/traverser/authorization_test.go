@@ -18,7 +18,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
@@ -39,23 +43,23 @@ func Test_Traverser_Authorization(t *testing.T) {
 	tests := []testCase{
 		testCase{
 			methodName:       "GetClass",
-			additionalArgs:   []interface{}{GetParams{}},
+			additionalArgs:   []interface{}{GetParams{Kind: kind.Thing, ClassName: "Article"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "things/Article",
 		},
 
 		testCase{
 			methodName:       "Aggregate",
-			additionalArgs:   []interface{}{&AggregateParams{}},
+			additionalArgs:   []interface{}{&AggregateParams{Kind: kind.Action, ClassName: "Publish"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "actions/Publish",
 		},
 
 		testCase{
 			methodName:       "Explore",
 			additionalArgs:   []interface{}{ExploreParams{}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "things",
 		},
 	}
 
@@ -82,7 +86,7 @@ func Test_Traverser_Authorization(t *testing.T) {
 			schemaGetter := &fakeSchemaGetter{}
 
 			manager := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-				vectorizer, vectorRepo, explorer, schemaGetter)
+				vectorizer, vectorRepo, explorer, schemaGetter, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -96,6 +100,111 @@ func Test_Traverser_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Traverser_AuthorizationPerClass(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{{Class: "Article"}, {Class: "Author"}},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{{Class: "Publish"}},
+		},
+	}}
+
+	newTraverser := func(authorizer authorizer) *Traverser {
+		return NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			authorizer, &fakeVectorizer{}, &fakeVectorRepo{}, &fakeExplorer{},
+			schemaGetter, nil)
+	}
+
+	t.Run("a get query authorizes the class of its nearObject", func(t *testing.T) {
+		id := strfmt.UUID("7a4b8b1f-7bd0-4d1c-9e7a-4b0a3f2f0f1a")
+		repo := &fakeVectorRepo{}
+		repo.On("ThingByID", id).Return((*search.Result)(nil), nil)
+		repo.On("ActionByID", id).Return(&search.Result{
+			Kind:      kind.Action,
+			ClassName: "Publish",
+		}, nil)
+		authorizer := &authRecorder{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			authorizer, &fakeVectorizer{}, repo, &fakeExplorer{}, schemaGetter, nil)
+
+		_, err := traverser.GetClass(context.Background(), principal, GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "Article",
+			NearObject: &NearObjectParams{ID: id.String()},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "things/Article"},
+			{principal, "get", "actions/Publish"},
+		}, authorizer.calls)
+	})
+
+	t.Run("a denied nearObject class aborts the get query", func(t *testing.T) {
+		id := strfmt.UUID("7a4b8b1f-7bd0-4d1c-9e7a-4b0a3f2f0f1a")
+		repo := &fakeVectorRepo{}
+		repo.On("ThingByID", id).Return(&search.Result{
+			Kind:      kind.Thing,
+			ClassName: "Author",
+		}, nil)
+		authorizer := &authDenierFor{resource: "things/Author"}
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			authorizer, &fakeVectorizer{}, repo, &fakeExplorer{}, schemaGetter, nil)
+
+		_, err := traverser.GetClass(context.Background(), principal, GetParams{
+			Kind:      kind.Thing,
+			ClassName: "Article",
+			Explore: &ExploreParams{
+				NearObject: &NearObjectParams{ID: id.String()},
+			},
+		})
+		assert.Equal(t, errors.New("just a test fake"), err)
+	})
+
+	t.Run("a get query authorizes every resolved reference", func(t *testing.T) {
+		authorizer := &authRecorder{}
+		traverser := newTraverser(authorizer)
+
+		_, err := traverser.GetClass(context.Background(), principal, GetParams{
+			Kind:      kind.Action,
+			ClassName: "Publish",
+			Properties: SelectProperties{{
+				Name: "ofArticle",
+				Refs: []SelectClass{{
+					ClassName: "Article",
+					RefProperties: SelectProperties{{
+						Name: "writtenBy",
+						Refs: []SelectClass{{ClassName: "Author"}},
+					}},
+				}},
+			}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "actions/Publish"},
+			{principal, "get", "things/Article"},
+			{principal, "get", "things/Author"},
+		}, authorizer.calls)
+	})
+
+	t.Run("explore authorizes every class", func(t *testing.T) {
+		authorizer := &authRecorder{}
+		traverser := newTraverser(authorizer)
+
+		_, err := traverser.Explore(context.Background(), principal, ExploreParams{
+			Values: []string{"foo"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "things/Article"},
+			{principal, "get", "things/Author"},
+			{principal, "get", "actions/Publish"},
+		}, authorizer.calls)
+	})
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -111,6 +220,28 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// authDenierFor only denies the specified resource
+type authDenierFor struct {
+	resource string
+}
+
+func (a *authDenierFor) Authorize(principal *models.Principal, verb, resource string) error {
+	if resource == a.resource {
+		return errors.New("just a test fake")
+	}
+
+	return nil
+}
+
+type authRecorder struct {
+	calls []authorizeCall
+}
+
+func (a *authRecorder) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	return nil
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
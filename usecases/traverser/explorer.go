@@ -14,8 +14,12 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	libprojector "github.com/semi-technologies/weaviate/usecases/projector"
 	"github.com/semi-technologies/weaviate/usecases/sempath"
@@ -38,11 +42,22 @@ type Explorer struct {
 
 type distancer func(a, b []float32) (float32, error)
 
+// certaintyCalculator is implemented by repos whose classes can use other
+// distance metrics than cosine, so that the certainty depends on the class.
+// For all other repos the certainty is derived from the distancer.
+type certaintyCalculator interface {
+	Certainty(k kind.Kind, className schema.ClassName, a, b []float32) (float32, error)
+}
+
 type vectorClassSearch interface {
 	ClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorSearch(ctx context.Context, vector []float32, limit int,
 		filters *filters.LocalFilter) ([]search.Result, error)
+	ThingByID(ctx context.Context, id strfmt.UUID, props SelectProperties,
+		underscore UnderscoreProperties) (*search.Result, error)
+	ActionByID(ctx context.Context, id strfmt.UUID, props SelectProperties,
+		underscore UnderscoreProperties) (*search.Result, error)
 }
 
 type nnExtender interface {
@@ -73,7 +88,32 @@ func (e *Explorer) GetClass(ctx context.Context,
 		}
 	}
 
-	if params.Explore != nil {
+	if params.Pagination.Limit == filters.LimitFlagNotSet {
+		params.Pagination.Limit = 100
+	}
+
+	if err := validateVectorSearchParams(params); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
+	if err := validatePaginationParams(params); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
+	if err := validateKeywordRankingParams(params); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
+	if err := validateHybridParams(params); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
+	if params.Hybrid != nil {
+		return e.getClassHybrid(ctx, params)
+	}
+
+	if params.Explore != nil || params.NearVector != nil ||
+		params.NearObject != nil {
 		return e.getClassExploration(ctx, params)
 	}
 
@@ -82,7 +122,7 @@ func (e *Explorer) GetClass(ctx context.Context,
 
 func (e *Explorer) getClassExploration(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
-	searchVector, err := e.vectorFromExploreParams(ctx, params.Explore)
+	searchVector, err := e.vectorFromParams(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("explorer: get class: vectorize params: %v", err)
 	}
@@ -205,20 +245,25 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 		}
 
 		if searchVector != nil {
-			dist, err := e.distancer(res.Vector, searchVector)
+			certainty, err := e.certainty(res, searchVector)
 			if err != nil {
-				return nil, fmt.Errorf("explorer: calculate distance: %v", err)
+				return nil, fmt.Errorf("explorer: calculate certainty: %v", err)
 			}
 
-			if 1-(dist) < float32(params.Explore.Certainty) {
+			if certainty < float32(certaintyFromParams(params)) {
 				continue
 			}
 
 			if params.UnderscoreProperties.Certainty {
-				res.Schema.(map[string]interface{})["_certainty"] = 1 - dist
+				res.Schema.(map[string]interface{})["_certainty"] = certainty
 			}
 		}
 
+		if (params.KeywordRanking != nil || params.Hybrid != nil) &&
+			params.UnderscoreProperties.Score {
+			res.Schema.(map[string]interface{})["_score"] = res.Score
+		}
+
 		output = append(output, res.Schema)
 	}
 
@@ -244,12 +289,12 @@ func (e *Explorer) Concepts(ctx context.Context,
 	results := []search.Result{}
 	for _, item := range res {
 		item.Beacon = beacon(item)
-		dist, err := e.distancer(vector, item.Vector)
+		certainty, err := e.certainty(item, vector)
 		if err != nil {
 			return nil, fmt.Errorf("res %s: %v", item.Beacon, err)
 		}
-		item.Certainty = 1 - dist
-		if item.Certainty >= float32(params.Certainty) {
+		item.Certainty = certainty
+		if item.Certainty >= float32(params.certainty()) {
 			results = append(results, item)
 		}
 	}
@@ -257,8 +302,195 @@ func (e *Explorer) Concepts(ctx context.Context,
 	return results, nil
 }
 
+// certainty of the result with regards to the search vector, 1 if they are
+// identical
+func (e *Explorer) certainty(res search.Result, vector []float32) (float32, error) {
+	if c, ok := e.search.(certaintyCalculator); ok {
+		return c.Certainty(res.Kind, schema.ClassName(res.ClassName), res.Vector, vector)
+	}
+
+	dist, err := e.distancer(res.Vector, vector)
+	if err != nil {
+		return 0, err
+	}
+
+	return 1 - dist, nil
+}
+
+// validatePaginationParams makes sure that a cursor is only used on plain
+// lists, as only those are ordered by id
+func validatePaginationParams(params GetParams) error {
+	if err := params.Pagination.Validate(); err != nil {
+		return err
+	}
+
+	if params.Pagination.After == "" {
+		return nil
+	}
+
+	if params.Filters != nil {
+		return fmt.Errorf("parameter 'after' cannot be combined with 'where'")
+	}
+
+	if len(params.Sort) > 0 {
+		return fmt.Errorf("parameter 'after' cannot be combined with 'sort'")
+	}
+
+	if params.KeywordRanking != nil || params.Hybrid != nil {
+		return fmt.Errorf("parameter 'after' cannot be combined with a keyword search")
+	}
+
+	if params.Explore != nil || params.NearVector != nil ||
+		params.NearObject != nil {
+		return fmt.Errorf("parameter 'after' cannot be combined with a vector search")
+	}
+
+	return nil
+}
+
+// validateKeywordRankingParams makes sure that a keyword search is complete
+// and not combined with any other way of ordering the results
+func validateKeywordRankingParams(params GetParams) error {
+	kr := params.KeywordRanking
+	if kr == nil {
+		return nil
+	}
+
+	if kr.Type != "bm25" {
+		return fmt.Errorf("unsupported keyword ranking type %q", kr.Type)
+	}
+
+	if strings.TrimSpace(kr.Query) == "" {
+		return fmt.Errorf("parameter '%s' requires a query", kr.Type)
+	}
+
+	if len(params.Sort) > 0 {
+		return fmt.Errorf("parameter '%s' cannot be combined with 'sort'", kr.Type)
+	}
+
+	if params.Explore != nil || params.NearVector != nil ||
+		params.NearObject != nil {
+		return fmt.Errorf("parameter '%s' cannot be combined with a vector search",
+			kr.Type)
+	}
+
+	return nil
+}
+
+// validateVectorSearchParams makes sure that at most one way of obtaining
+// the search vector is set
+func validateVectorSearchParams(params GetParams) error {
+	set := 0
+	if params.Explore != nil {
+		set++
+	}
+	if params.NearVector != nil {
+		set++
+	}
+	if params.NearObject != nil {
+		set++
+	}
+
+	if set > 1 {
+		return fmt.Errorf("parameters 'explore', 'nearVector' and 'nearObject' " +
+			"cannot be combined")
+	}
+
+	return nil
+}
+
+// vectorFromParams uses the vector provided by the user in the nearVector
+// param as is and looks up the vector of the object referenced in the
+// nearObject param. Only explore params need to be vectorized.
+func (e *Explorer) vectorFromParams(ctx context.Context,
+	params GetParams) ([]float32, error) {
+	if params.NearVector != nil {
+		return params.NearVector.Vector, nil
+	}
+
+	if params.NearObject != nil {
+		return e.vectorFromNearObject(ctx, params.NearObject)
+	}
+
+	return e.vectorFromExploreParams(ctx, params.Explore)
+}
+
+// vectorFromNearObject retrieves the stored vector of the specified object
+func (e *Explorer) vectorFromNearObject(ctx context.Context,
+	params *NearObjectParams) ([]float32, error) {
+	id := strfmt.UUID(params.ID)
+	res, err := objectByID(ctx, e.search, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, fmt.Errorf("nearObject: no object with id %s found", id)
+	}
+
+	if len(res.Vector) == 0 {
+		return nil, fmt.Errorf("nearObject: object with id %s has no vector", id)
+	}
+
+	return res.Vector, nil
+}
+
+type objectByIDSearch interface {
+	ThingByID(ctx context.Context, id strfmt.UUID, props SelectProperties,
+		underscore UnderscoreProperties) (*search.Result, error)
+	ActionByID(ctx context.Context, id strfmt.UUID, props SelectProperties,
+		underscore UnderscoreProperties) (*search.Result, error)
+}
+
+// objectByID looks up an object of any class. As the id does not tell the
+// kind, both kinds are checked. The result is nil if there is no object with
+// the id.
+func objectByID(ctx context.Context, repo objectByIDSearch,
+	id strfmt.UUID) (*search.Result, error) {
+	res, err := repo.ThingByID(ctx, id, SelectProperties{}, UnderscoreProperties{})
+	if err != nil {
+		return nil, fmt.Errorf("find thing %s: %v", id, err)
+	}
+
+	if res != nil {
+		return res, nil
+	}
+
+	res, err = repo.ActionByID(ctx, id, SelectProperties{}, UnderscoreProperties{})
+	if err != nil {
+		return nil, fmt.Errorf("find action %s: %v", id, err)
+	}
+
+	return res, nil
+}
+
+func certaintyFromParams(params GetParams) float64 {
+	if params.NearVector != nil {
+		return params.NearVector.Certainty
+	}
+
+	if params.NearObject != nil {
+		return params.NearObject.Certainty
+	}
+
+	if params.Explore != nil {
+		return params.Explore.certainty()
+	}
+
+	return 0
+}
+
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	params *ExploreParams) ([]float32, error) {
+	if params.NearVector != nil {
+		// the user provided their own vector, there is nothing to vectorize
+		return params.NearVector.Vector, nil
+	}
+
+	if params.NearObject != nil {
+		return e.vectorFromNearObject(ctx, params.NearObject)
+	}
+
 	vector, err := e.vectorizer.Corpi(ctx, params.Values)
 	if err != nil {
 		return nil, fmt.Errorf("vectorize keywords: %v", err)
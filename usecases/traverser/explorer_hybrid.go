@@ -0,0 +1,338 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser/grouper"
+)
+
+// rrfK dampens the influence of the top ranks in reciprocal rank fusion, 60
+// is the value proposed in the original paper
+const rrfK = 60
+
+// HybridScore explains the score of a hybrid search result. A component is
+// nil if the object was not found by the respective search.
+type HybridScore struct {
+	Score        float32  `json:"score"`
+	VectorScore  *float32 `json:"vectorScore"`
+	KeywordScore *float32 `json:"keywordScore"`
+}
+
+func (e *Explorer) getClassHybrid(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	hybrid := params.Hybrid
+	limit := params.Pagination.Offset + params.Pagination.Limit
+
+	var (
+		vectorRes    []search.Result
+		vectorScores []float32
+		keywordRes   []search.Result
+	)
+
+	if hybrid.Alpha > 0 {
+		vector, err := e.hybridVector(ctx, hybrid)
+		if err != nil {
+			return nil, fmt.Errorf("explorer: get class: vectorize params: %v", err)
+		}
+
+		vectorParams := params
+		vectorParams.Hybrid = nil
+		vectorParams.SearchVector = vector
+		vectorParams.Pagination = &filters.Pagination{Limit: limit}
+		vectorRes, err = e.search.VectorClassSearch(ctx, vectorParams)
+		if err != nil {
+			return nil, fmt.Errorf("explorer: get class: vector search: %v", err)
+		}
+
+		vectorScores = make([]float32, len(vectorRes))
+		for i, res := range vectorRes {
+			certainty, err := e.certainty(res, vector)
+			if err != nil {
+				return nil, fmt.Errorf("explorer: calculate certainty: %v", err)
+			}
+
+			vectorScores[i] = certainty
+		}
+	}
+
+	if hybrid.Alpha < 1 {
+		keywordParams := params
+		keywordParams.Hybrid = nil
+		keywordParams.KeywordRanking = &KeywordRankingParams{
+			Type:       "bm25",
+			Query:      hybrid.Query,
+			Properties: hybrid.Properties,
+		}
+		keywordParams.Pagination = &filters.Pagination{Limit: limit}
+
+		var err error
+		keywordRes, err = e.search.ClassSearch(ctx, keywordParams)
+		if err != nil {
+			return nil, fmt.Errorf("explorer: get class: keyword search: %v", err)
+		}
+	}
+
+	res, scores := fuseHybrid(vectorRes, vectorScores, keywordRes, hybrid)
+	res, scores = hybridPage(res, scores, params.Pagination)
+
+	for i := range res {
+		res[i].Score = scores[i].Score
+		if !params.UnderscoreProperties.Hybrid {
+			continue
+		}
+
+		if schema, ok := res[i].Schema.(map[string]interface{}); ok {
+			schema["_hybrid"] = &scores[i]
+		}
+	}
+
+	if params.Group != nil {
+		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
+		if err != nil {
+			return nil, fmt.Errorf("grouper: %v", err)
+		}
+
+		res = grouped
+	}
+
+	if params.UnderscoreProperties.NearestNeighbors {
+		withNN, err := e.nnExtender.Multi(ctx, res, nil)
+		if err != nil {
+			return nil, fmt.Errorf("extend with nearest neighbors: %v", err)
+		}
+
+		res = withNN
+	}
+
+	if params.UnderscoreProperties.FeatureProjection != nil {
+		withFP, err := e.projector.Reduce(res, params.UnderscoreProperties.FeatureProjection)
+		if err != nil {
+			return nil, fmt.Errorf("extend with feature projections: %v", err)
+		}
+
+		res = withFP
+	}
+
+	if params.UnderscoreProperties.SemanticPath != nil {
+		return nil, fmt.Errorf("semantic path not possible on 'hybrid' queries, only on 'explore' queries")
+	}
+
+	return e.searchResultsToGetResponse(ctx, res, nil, params)
+}
+
+// hybridVector uses the vector provided by the user as is, otherwise the
+// query is vectorized
+func (e *Explorer) hybridVector(ctx context.Context,
+	params *HybridParams) ([]float32, error) {
+	if len(params.Vector) > 0 {
+		return params.Vector, nil
+	}
+
+	vector, err := e.vectorizer.Corpi(ctx, []string{params.Query})
+	if err != nil {
+		return nil, fmt.Errorf("vectorize query: %v", err)
+	}
+
+	return vector, nil
+}
+
+type hybridCandidate struct {
+	result       search.Result
+	vectorRank   int
+	vectorScore  *float32
+	keywordRank  int
+	keywordScore *float32
+}
+
+// fuseHybrid combines the results of the vector and keyword search into a
+// single ranking. The input results must be ordered by their respective
+// score, vectorScores[i] is the score of vectorRes[i].
+func fuseHybrid(vectorRes []search.Result, vectorScores []float32,
+	keywordRes []search.Result,
+	params *HybridParams) ([]search.Result, []HybridScore) {
+	var order []strfmt.UUID
+	candidates := map[strfmt.UUID]*hybridCandidate{}
+	candidate := func(res search.Result) *hybridCandidate {
+		c, ok := candidates[res.ID]
+		if !ok {
+			c = &hybridCandidate{result: res}
+			candidates[res.ID] = c
+			order = append(order, res.ID)
+		}
+		return c
+	}
+
+	normalizedVector := normalizeScores(vectorScores)
+	for i, res := range vectorRes {
+		c := candidate(res)
+		score := vectorScores[i]
+		c.vectorRank = i + 1
+		c.vectorScore = &score
+	}
+
+	keywordScores := make([]float32, len(keywordRes))
+	for i, res := range keywordRes {
+		keywordScores[i] = res.Score
+	}
+	normalizedKeyword := normalizeScores(keywordScores)
+	for i, res := range keywordRes {
+		c := candidate(res)
+		score := res.Score
+		c.keywordRank = i + 1
+		c.keywordScore = &score
+	}
+
+	alpha := params.Alpha
+	out := make([]search.Result, len(order))
+	scores := make([]HybridScore, len(order))
+	for i, id := range order {
+		c := candidates[id]
+		var fused float64
+		if params.Fusion == HybridFusionRelativeScore {
+			if c.vectorRank > 0 {
+				fused += alpha * normalizedVector[c.vectorRank-1]
+			}
+			if c.keywordRank > 0 {
+				fused += (1 - alpha) * normalizedKeyword[c.keywordRank-1]
+			}
+		} else {
+			if c.vectorRank > 0 {
+				fused += alpha / float64(rrfK+c.vectorRank)
+			}
+			if c.keywordRank > 0 {
+				fused += (1 - alpha) / float64(rrfK+c.keywordRank)
+			}
+		}
+
+		out[i] = c.result
+		scores[i] = HybridScore{
+			Score:        float32(fused),
+			VectorScore:  c.vectorScore,
+			KeywordScore: c.keywordScore,
+		}
+	}
+
+	sort.Stable(byHybridScore{results: out, scores: scores})
+	return out, scores
+}
+
+// normalizeScores scales the scores to a range of 0 to 1, if all scores are
+// identical, they are all normalized to 1
+func normalizeScores(scores []float32) []float64 {
+	out := make([]float64, len(scores))
+	if len(scores) == 0 {
+		return out
+	}
+
+	min, max := scores[0], scores[0]
+	for _, score := range scores {
+		if score < min {
+			min = score
+		}
+		if score > max {
+			max = score
+		}
+	}
+
+	for i, score := range scores {
+		if max == min {
+			out[i] = 1
+			continue
+		}
+
+		out[i] = float64(score-min) / float64(max-min)
+	}
+
+	return out
+}
+
+type byHybridScore struct {
+	results []search.Result
+	scores  []HybridScore
+}
+
+func (h byHybridScore) Len() int {
+	return len(h.results)
+}
+
+func (h byHybridScore) Less(a, b int) bool {
+	return h.scores[a].Score > h.scores[b].Score
+}
+
+func (h byHybridScore) Swap(a, b int) {
+	h.results[a], h.results[b] = h.results[b], h.results[a]
+	h.scores[a], h.scores[b] = h.scores[b], h.scores[a]
+}
+
+func hybridPage(res []search.Result, scores []HybridScore,
+	pagination *filters.Pagination) ([]search.Result, []HybridScore) {
+	if pagination.Offset >= len(res) {
+		return nil, nil
+	}
+
+	end := pagination.Offset + pagination.Limit
+	if end > len(res) {
+		end = len(res)
+	}
+
+	return res[pagination.Offset:end], scores[pagination.Offset:end]
+}
+
+// validateHybridParams makes sure that a hybrid search has everything it
+// needs and is not combined with any other way of ordering the results
+func validateHybridParams(params GetParams) error {
+	hybrid := params.Hybrid
+	if hybrid == nil {
+		return nil
+	}
+
+	if hybrid.Alpha < 0 || hybrid.Alpha > 1 {
+		return fmt.Errorf("parameter 'hybrid': alpha must be between 0 and 1, got %v",
+			hybrid.Alpha)
+	}
+
+	if strings.TrimSpace(hybrid.Query) == "" &&
+		(hybrid.Alpha < 1 || len(hybrid.Vector) == 0) {
+		return fmt.Errorf("parameter 'hybrid' requires a query")
+	}
+
+	switch hybrid.Fusion {
+	case "", HybridFusionRanked, HybridFusionRelativeScore:
+	default:
+		return fmt.Errorf("parameter 'hybrid': unsupported fusion %q", hybrid.Fusion)
+	}
+
+	if params.KeywordRanking != nil {
+		return fmt.Errorf("parameter 'hybrid' cannot be combined with '%s'",
+			params.KeywordRanking.Type)
+	}
+
+	if len(params.Sort) > 0 {
+		return fmt.Errorf("parameter 'hybrid' cannot be combined with 'sort'")
+	}
+
+	if params.Explore != nil || params.NearVector != nil ||
+		params.NearObject != nil {
+		return fmt.Errorf("parameter 'hybrid' cannot be combined with another " +
+			"vector search, use its vector field instead")
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_GetClass_Hybrid(t *testing.T) {
+	filter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    "BestClass",
+				Property: "published",
+			},
+			Value: &filters.Value{
+				Value: true,
+				Type:  schema.DataTypeBoolean,
+			},
+		},
+	}
+
+	params := GetParams{
+		Kind:       kind.Thing,
+		ClassName:  "BestClass",
+		Filters:    filter,
+		Pagination: &filters.Pagination{Limit: 2},
+		Hybrid: &HybridParams{
+			Query:      "fox",
+			Alpha:      0.5,
+			Properties: []string{"title"},
+		},
+		UnderscoreProperties: UnderscoreProperties{
+			Score:  true,
+			Hybrid: true,
+		},
+	}
+
+	expectedVectorParams := params
+	expectedVectorParams.Hybrid = nil
+	expectedVectorParams.SearchVector = []float32{1, 2, 3}
+	expectedVectorParams.Pagination = &filters.Pagination{Limit: 2}
+
+	expectedKeywordParams := params
+	expectedKeywordParams.Hybrid = nil
+	expectedKeywordParams.KeywordRanking = &KeywordRankingParams{
+		Type:       "bm25",
+		Query:      "fox",
+		Properties: []string{"title"},
+	}
+	expectedKeywordParams.Pagination = &filters.Pagination{Limit: 2}
+
+	result := func(id string, distance float32, score float32) search.Result {
+		return search.Result{
+			ID:     strfmt.UUID(id),
+			Kind:   kind.Thing,
+			Schema: map[string]interface{}{"name": id},
+			Vector: []float32{distance},
+			Score:  score,
+		}
+	}
+
+	searcher := &fakeVectorSearcher{}
+	searcher.On("VectorClassSearch", expectedVectorParams).
+		Return([]search.Result{result("id1", 0.1, 1), result("id2", 0.2, 1)}, nil)
+	searcher.On("ClassSearch", expectedKeywordParams).
+		Return([]search.Result{result("id2", 0.2, 3), result("id3", 0.3, 1)}, nil)
+
+	// the fake distancer uses the first vector position as the distance
+	distancer := func(a, b []float32) (float32, error) {
+		return a[0], nil
+	}
+
+	log, _ := test.NewNullLogger()
+	explorer := NewExplorer(searcher, &fakeVectorizer{}, distancer, log,
+		&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{})
+
+	res, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	searcher.AssertExpectations(t)
+
+	// id2 was found by both searches, so it ranks first, id3 did not make the
+	// page, as it was ranked lower in the keyword search than id1 in the
+	// vector search
+	require.Len(t, res, 2)
+	first := res[0].(map[string]interface{})
+	second := res[1].(map[string]interface{})
+	assert.Equal(t, "id2", first["name"])
+	assert.Equal(t, "id1", second["name"])
+
+	hybrid := first["_hybrid"].(*HybridScore)
+	assert.InEpsilon(t, 0.5/61+0.5/62, hybrid.Score, 0.0001)
+	assert.Equal(t, hybrid.Score, first["_score"])
+	require.NotNil(t, hybrid.VectorScore)
+	assert.InEpsilon(t, 0.8, *hybrid.VectorScore, 0.0001)
+	require.NotNil(t, hybrid.KeywordScore)
+	assert.Equal(t, float32(3), *hybrid.KeywordScore)
+
+	hybrid = second["_hybrid"].(*HybridScore)
+	require.NotNil(t, hybrid.VectorScore)
+	assert.Nil(t, hybrid.KeywordScore)
+}
+
+func Test_Explorer_GetClass_HybridOnlyKeyword(t *testing.T) {
+	params := GetParams{
+		Kind:       kind.Thing,
+		ClassName:  "BestClass",
+		Pagination: &filters.Pagination{Limit: 10},
+		Hybrid: &HybridParams{
+			Query: "fox",
+			Alpha: 0,
+		},
+	}
+
+	expectedKeywordParams := params
+	expectedKeywordParams.Hybrid = nil
+	expectedKeywordParams.KeywordRanking = &KeywordRankingParams{
+		Type:  "bm25",
+		Query: "fox",
+	}
+
+	searcher := &fakeVectorSearcher{}
+	searcher.On("ClassSearch", expectedKeywordParams).
+		Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"name": "id1"}, Score: 2},
+		}, nil)
+
+	explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), nil,
+		&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{})
+
+	res, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	// an alpha of 0 must not trigger a vector search
+	searcher.AssertExpectations(t)
+	require.Len(t, res, 1)
+}
+
+func Test_Explorer_GetClass_HybridValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		hybrid HybridParams
+		params GetParams
+	}{
+		{
+			name:   "alpha out of range",
+			hybrid: HybridParams{Query: "fox", Alpha: 1.5},
+		},
+		{
+			name:   "no query",
+			hybrid: HybridParams{Alpha: 0.5, Vector: []float32{1, 2, 3}},
+		},
+		{
+			name:   "unknown fusion",
+			hybrid: HybridParams{Query: "fox", Alpha: 0.5, Fusion: "foo"},
+		},
+		{
+			name:   "with bm25",
+			hybrid: HybridParams{Query: "fox", Alpha: 0.5},
+			params: GetParams{
+				KeywordRanking: &KeywordRankingParams{Type: "bm25", Query: "fox"},
+			},
+		},
+		{
+			name:   "with nearVector",
+			hybrid: HybridParams{Query: "fox", Alpha: 0.5},
+			params: GetParams{
+				NearVector: &NearVectorParams{Vector: []float32{1, 2, 3}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := tc.params
+			params.Kind = kind.Thing
+			params.ClassName = "BestClass"
+			hybrid := tc.hybrid
+			params.Hybrid = &hybrid
+
+			explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{},
+				newFakeDistancer(), nil, &fakeExtender{}, &fakeProjector{},
+				&fakePathBuilder{})
+
+			_, err := explorer.GetClass(context.Background(), params)
+			assert.NotNil(t, err)
+		})
+	}
+
+	t.Run("a pure vector search with a vector needs no query", func(t *testing.T) {
+		params := GetParams{
+			Hybrid: &HybridParams{Alpha: 1, Vector: []float32{1, 2, 3}},
+		}
+		assert.Nil(t, validateHybridParams(params))
+	})
+}
+
+func TestFuseHybrid(t *testing.T) {
+	vectorRes := []search.Result{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	vectorScores := []float32{0.9, 0.8, 0.1}
+	keywordRes := []search.Result{{ID: "c", Score: 10}, {ID: "d", Score: 9}}
+
+	ids := func(in []search.Result) []string {
+		out := make([]string, len(in))
+		for i, res := range in {
+			out[i] = res.ID.String()
+		}
+		return out
+	}
+
+	t.Run("ranked fusion", func(t *testing.T) {
+		res, scores := fuseHybrid(vectorRes, vectorScores, keywordRes,
+			&HybridParams{Alpha: 0.5})
+		// c is found by both searches
+		assert.Equal(t, []string{"c", "a", "b", "d"}, ids(res))
+		assert.InEpsilon(t, 0.5/63+0.5/61, scores[0].Score, 0.0001)
+	})
+
+	t.Run("relative score fusion", func(t *testing.T) {
+		res, scores := fuseHybrid(vectorRes, vectorScores, keywordRes,
+			&HybridParams{Alpha: 0.5, Fusion: HybridFusionRelativeScore})
+		// normalized vector: a=1, b=0.875, c=0; keyword: c=1, d=0
+		assert.Equal(t, []string{"a", "c", "b", "d"}, ids(res))
+		assert.InEpsilon(t, 0.5, scores[0].Score, 0.0001)
+		assert.InEpsilon(t, 0.5, scores[1].Score, 0.0001)
+		assert.InEpsilon(t, 0.4375, scores[2].Score, 0.0001)
+		assert.Equal(t, float32(0), scores[3].Score)
+	})
+
+	t.Run("alpha weighs the rankings", func(t *testing.T) {
+		res, _ := fuseHybrid(vectorRes, vectorScores, keywordRes,
+			&HybridParams{Alpha: 0.1})
+		assert.Equal(t, []string{"c", "d", "a", "b"}, ids(res))
+	})
+}
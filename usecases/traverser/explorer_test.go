@@ -15,8 +15,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	libprojector "github.com/semi-technologies/weaviate/usecases/projector"
@@ -88,6 +90,209 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when the nearVector param is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		extender := &fakeExtender{}
+		log, _ := test.NewNullLogger()
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("vector search must be called with the user-provided vector", func(t *testing.T) {
+			assert.Nil(t, err)
+			search.AssertExpectations(t)
+		})
+
+		t.Run("response must contain concepts", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo",
+				}, res[0])
+		})
+	})
+
+	t.Run("when the nearObject param is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearObject: &NearObjectParams{
+				ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		extender := &fakeExtender{}
+		log, _ := test.NewNullLogger()
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		// the object is an action, so the lookup of things comes up empty
+		searcher.
+			On("ThingByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return((*search.Result)(nil), nil)
+		searcher.
+			On("ActionByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return(&search.Result{
+				Kind:   kind.Action,
+				ID:     "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+				Vector: []float32{0.8, 0.2, 0.7},
+			}, nil)
+		searcher.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("vector search must be called with the vector of the object", func(t *testing.T) {
+			assert.Nil(t, err)
+			searcher.AssertExpectations(t)
+		})
+
+		t.Run("response must contain concepts", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo",
+				}, res[0])
+		})
+	})
+
+	t.Run("when the nearObject param references a non-existing object", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearObject: &NearObjectParams{
+				ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{},
+			newFakeDistancer(), log, &fakeExtender{}, &fakeProjector{},
+			&fakePathBuilder{})
+		searcher.
+			On("ThingByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return((*search.Result)(nil), nil)
+		searcher.
+			On("ActionByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return((*search.Result)(nil), nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when both explore and nearVector are set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Explore: &ExploreParams{
+				Values: []string{"foo"},
+			},
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{},
+			newFakeDistancer(), log, &fakeExtender{}, &fakeProjector{},
+			&fakePathBuilder{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a cursor is combined with a vector search", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Pagination: &filters.Pagination{
+				Limit: 100,
+				After: "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11",
+			},
+		}
+
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{},
+			newFakeDistancer(), log, &fakeExtender{}, &fakeProjector{},
+			&fakePathBuilder{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a cursor is combined with a sort", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Sort: []filters.Sort{
+				{Path: []string{"price"}, Order: filters.SortOrderAsc},
+			},
+			Pagination: &filters.Pagination{
+				Limit: 100,
+				After: "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11",
+			},
+		}
+
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{},
+			newFakeDistancer(), log, &fakeExtender{}, &fakeProjector{},
+			&fakePathBuilder{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("when an explore param is set and the required certainty not met", func(t *testing.T) {
 		params := GetParams{
 			Kind:      kind.Thing,
@@ -329,6 +534,115 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when a bm25 param and the _score prop are set", func(t *testing.T) {
+		params := GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			KeywordRanking: &KeywordRankingParams{
+				Type:       "bm25",
+				Query:      "quick fox",
+				Properties: []string{"title"},
+			},
+			UnderscoreProperties: UnderscoreProperties{
+				Score: true,
+			},
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"title": "the quick brown fox",
+				},
+				Score: 1.7,
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, &fakeVectorizer{}, newFakeDistancer(), log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{})
+		search.
+			On("ClassSearch", params).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("class search must be called with right params", func(t *testing.T) {
+			assert.Nil(t, err)
+			search.AssertExpectations(t)
+		})
+
+		t.Run("response must contain the score", func(t *testing.T) {
+			require.Len(t, res, 1)
+
+			resMap := res[0].(map[string]interface{})
+			assert.Equal(t, "the quick brown fox", resMap["title"])
+			assert.Equal(t, float32(1.7), resMap["_score"])
+		})
+	})
+
+	t.Run("when a bm25 param is invalid or combined with other orders", func(t *testing.T) {
+		bm25 := func(query string) *KeywordRankingParams {
+			return &KeywordRankingParams{Type: "bm25", Query: query}
+		}
+
+		tests := []struct {
+			name   string
+			params GetParams
+		}{
+			{
+				name: "empty query",
+				params: GetParams{
+					KeywordRanking: bm25("  "),
+				},
+			},
+			{
+				name: "with a vector search",
+				params: GetParams{
+					KeywordRanking: bm25("fox"),
+					NearVector:     &NearVectorParams{Vector: []float32{1, 2, 3}},
+				},
+			},
+			{
+				name: "with a sort",
+				params: GetParams{
+					KeywordRanking: bm25("fox"),
+					Sort: []filters.Sort{
+						{Path: []string{"price"}, Order: filters.SortOrderAsc},
+					},
+				},
+			},
+			{
+				name: "with a cursor",
+				params: GetParams{
+					KeywordRanking: bm25("fox"),
+					Pagination: &filters.Pagination{
+						Limit: 100,
+						After: "8d5a9a2c-6c0e-4b8b-9a25-7d7a1a0b3c11",
+					},
+				},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				params := tc.params
+				params.Kind = kind.Thing
+				params.ClassName = "BestClass"
+
+				explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{},
+					newFakeDistancer(), nil, &fakeExtender{}, &fakeProjector{},
+					&fakePathBuilder{})
+
+				_, err := explorer.GetClass(context.Background(), params)
+				assert.NotNil(t, err)
+			})
+		}
+	})
+
 	t.Run("when the _interpretation prop is set", func(t *testing.T) {
 		params := GetParams{
 			Kind:       kind.Thing,
@@ -779,6 +1093,60 @@ func Test_Explorer_GetClass(t *testing.T) {
 	})
 }
 
+func TestExplorerCertaintyByClass(t *testing.T) {
+	params := GetParams{
+		Kind:       kind.Thing,
+		ClassName:  "BestClass",
+		Pagination: &filters.Pagination{Limit: 100},
+		NearVector: &NearVectorParams{
+			Vector:    []float32{1.0, 2.0, 3.0},
+			Certainty: 0.6,
+		},
+		UnderscoreProperties: UnderscoreProperties{
+			Certainty: true,
+		},
+	}
+
+	searchResults := []search.Result{
+		{
+			Kind:      kind.Thing,
+			ClassName: "Close",
+			ID:        "id1",
+			Schema:    map[string]interface{}{"age": 10},
+			Vector:    []float32{1.0, 2.0, 3.0},
+		},
+		{
+			Kind:      kind.Thing,
+			ClassName: "Far",
+			ID:        "id2",
+			Schema:    map[string]interface{}{"age": 20},
+			Vector:    []float32{3.0, 2.0, 1.0},
+		},
+	}
+
+	search := &fakeCertaintySearcher{
+		certainties: map[schema.ClassName]float32{"Close": 0.9, "Far": 0.4},
+	}
+	log, _ := test.NewNullLogger()
+	// the distancer is only used by repos which can't calculate the
+	// certainty themselves, with it both results would be filtered out
+	explorer := NewExplorer(search, &fakeVectorizer{}, newFakeDistancer69(), log,
+		&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{})
+	expectedParamsToSearch := params
+	expectedParamsToSearch.SearchVector = []float32{1.0, 2.0, 3.0}
+	search.
+		On("VectorClassSearch", expectedParamsToSearch).
+		Return(searchResults, nil)
+
+	res, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+
+	resMap := res[0].(map[string]interface{})
+	assert.Equal(t, 10, resMap["age"])
+	assert.Equal(t, float32(0.9), resMap["_certainty"])
+}
+
 func newFakeDistancer() func(a, b []float32) (float32, error) {
 	return func(source, target []float32) (float32, error) {
 		return 0.5, nil
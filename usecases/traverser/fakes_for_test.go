@@ -19,6 +19,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	libprojector "github.com/semi-technologies/weaviate/usecases/projector"
 	"github.com/semi-technologies/weaviate/usecases/sempath"
@@ -101,6 +102,30 @@ func (f *fakeVectorSearcher) ClassSearch(ctx context.Context,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) ThingByID(ctx context.Context, id strfmt.UUID,
+	props SelectProperties, underscore UnderscoreProperties) (*search.Result, error) {
+	args := f.Called(id)
+	return args.Get(0).(*search.Result), args.Error(1)
+}
+
+func (f *fakeVectorSearcher) ActionByID(ctx context.Context, id strfmt.UUID,
+	props SelectProperties, underscore UnderscoreProperties) (*search.Result, error) {
+	args := f.Called(id)
+	return args.Get(0).(*search.Result), args.Error(1)
+}
+
+// fakeCertaintySearcher reports a fixed certainty per class, like a repo
+// whose classes use different distance metrics
+type fakeCertaintySearcher struct {
+	fakeVectorSearcher
+	certainties map[schema.ClassName]float32
+}
+
+func (f *fakeCertaintySearcher) Certainty(k kind.Kind, className schema.ClassName,
+	a, b []float32) (float32, error) {
+	return f.certainties[className], nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -132,6 +157,18 @@ func (f *fakeVectorRepo) Aggregate(ctx context.Context,
 	return args.Get(0).(*aggregation.Result), args.Error(1)
 }
 
+func (f *fakeVectorRepo) ThingByID(ctx context.Context, id strfmt.UUID,
+	props SelectProperties, underscore UnderscoreProperties) (*search.Result, error) {
+	args := f.Called(id)
+	return args.Get(0).(*search.Result), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ActionByID(ctx context.Context, id strfmt.UUID,
+	props SelectProperties, underscore UnderscoreProperties) (*search.Result, error) {
+	args := f.Called(id)
+	return args.Get(0).(*search.Result), args.Error(1)
+}
+
 func (f *fakeVectorRepo) GetThing(ctx context.Context, uuid strfmt.UUID,
 	res *models.Thing) error {
 	args := f.Called(uuid)
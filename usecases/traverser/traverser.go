@@ -13,6 +13,7 @@ package traverser
 
 import (
 	"context"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -20,6 +21,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -43,6 +45,7 @@ type Traverser struct {
 	vectorSearcher VectorSearcher
 	explorer       explorer
 	schemaGetter   schema.SchemaGetter
+	metrics        *monitoring.PrometheusMetrics
 }
 
 type CorpiVectorizer interface {
@@ -55,6 +58,7 @@ type VectorSearcher interface {
 	VectorSearch(ctx context.Context, vector []float32,
 		limit int, filters *filters.LocalFilter) ([]search.Result, error)
 	Aggregate(ctx context.Context, params AggregateParams) (*aggregation.Result, error)
+	objectByIDSearch
 }
 
 type explorer interface {
@@ -66,7 +70,8 @@ type explorer interface {
 func NewTraverser(config *config.WeaviateConfig, locks locks,
 	logger logrus.FieldLogger, authorizer authorizer,
 	vectorizer CorpiVectorizer, vectorSearcher VectorSearcher,
-	explorer explorer, schemaGetter schema.SchemaGetter) *Traverser {
+	explorer explorer, schemaGetter schema.SchemaGetter,
+	metrics *monitoring.PrometheusMetrics) *Traverser {
 	return &Traverser{
 		config:         config,
 		locks:          locks,
@@ -76,9 +81,21 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		vectorSearcher: vectorSearcher,
 		explorer:       explorer,
 		schemaGetter:   schemaGetter,
+		metrics:        metrics,
 	}
 }
 
+// recordDuration is meant to be deferred at the start of each GraphQL
+// operation, it does nothing if monitoring is turned off
+func (t *Traverser) recordDuration(operation string, before time.Time) {
+	if t.metrics == nil {
+		return
+	}
+
+	t.metrics.GraphQLRequestDuration.WithLabelValues(operation).
+		Observe(time.Since(before).Seconds())
+}
+
 // TraverserRepo describes the dependencies of the Traverser UC to the
 // connected database
 type TraverserRepo interface {
@@ -16,6 +16,7 @@ import (
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -26,7 +27,10 @@ import (
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *AggregateParams) (interface{}, error) {
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	defer t.recordDuration("aggregate", time.Now())
+
+	err := t.authorizer.Authorize(principal, "get",
+		classResource(params.Kind, params.ClassName.String()))
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +68,9 @@ type AggregateParams struct {
 // Aggregator is the desired computation that the database connector
 // should perform on this property
 type Aggregator struct {
-	Type  string
-	Limit *int // used on TopOccurrence Agg
+	Type     string
+	Limit    *int   // used on TopOccurrence Agg
+	Interval string // used on Histogram Agg
 }
 
 func (a Aggregator) String() string {
@@ -104,6 +109,25 @@ func NewTopOccurrencesAggregator(limit *int) Aggregator {
 	return Aggregator{Type: TopOccurrencesType, Limit: limit}
 }
 
+const HistogramType = "histogram"
+
+// Intervals of a date histogram, each bucket starts at the beginning of the
+// interval (in UTC)
+const (
+	DateIntervalMinute = "minute"
+	DateIntervalHour   = "hour"
+	DateIntervalDay    = "day"
+	DateIntervalWeek   = "week"
+	DateIntervalMonth  = "month"
+	DateIntervalYear   = "year"
+)
+
+// NewHistogramAggregator creates a date HistogramAggregator, we cannot use a
+// singleton for this as the desired interval can be different each time
+func NewHistogramAggregator(interval string) Aggregator {
+	return Aggregator{Type: HistogramType, Interval: interval}
+}
+
 // Aggregators used in ref props
 var (
 	PointingToAggregator = Aggregator{Type: "pointingTo"}
@@ -181,6 +205,10 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 	case TopOccurrencesType:
 		return NewTopOccurrencesAggregator(ptInt(5)), nil // default to limit 5, can be overwritten
 
+	// date
+	case HistogramType:
+		return NewHistogramAggregator(DateIntervalDay), nil // default to day, can be overwritten
+
 	// ref
 	case PointingToAggregator.String():
 		return PointingToAggregator, nil
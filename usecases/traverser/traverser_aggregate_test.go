@@ -37,7 +37,7 @@ func Test_Traverser_Aggregate(t *testing.T) {
 		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
 
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorizer, vectorRepo, explorer, schemaGetter)
+			vectorizer, vectorRepo, explorer, schemaGetter, nil)
 
 		params := AggregateParams{
 			ClassName: "MyClass",
@@ -122,7 +122,7 @@ func Test_Traverser_Aggregate(t *testing.T) {
 		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
 
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorizer, vectorRepo, explorer, schemaGetter)
+			vectorizer, vectorRepo, explorer, schemaGetter, nil)
 
 		params := AggregateParams{
 			ClassName: "MyClass",
@@ -13,6 +13,8 @@ package traverser
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
@@ -21,15 +23,21 @@ import (
 // Explore through unstructured search terms
 func (t *Traverser) Explore(ctx context.Context,
 	principal *models.Principal, params ExploreParams) ([]search.Result, error) {
+	defer t.recordDuration("explore", time.Now())
+
 	if params.Limit == 0 {
 		params.Limit = 20
 	}
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err := t.authorizeAllClasses(principal, "get")
 	if err != nil {
 		return nil, err
 	}
 
+	if err := params.validate(); err != nil {
+		return nil, fmt.Errorf("explore: %v", err)
+	}
+
 	return t.explorer.Concepts(ctx, params)
 }
 
@@ -41,6 +49,49 @@ type ExploreParams struct {
 	MoveAwayFrom ExploreMove
 	Certainty    float64
 	Network      bool
+	NearVector   *NearVectorParams
+	NearObject   *NearObjectParams
+}
+
+// validate that the search is based on exactly one of concepts, a vector or
+// an existing object
+func (p ExploreParams) validate() error {
+	set := 0
+	if len(p.Values) > 0 {
+		set++
+	}
+	if p.NearVector != nil {
+		set++
+	}
+	if p.NearObject != nil {
+		set++
+	}
+
+	if set > 1 {
+		return fmt.Errorf("parameters 'concepts', 'nearVector' and 'nearObject' " +
+			"cannot be combined")
+	}
+
+	if set == 0 {
+		return fmt.Errorf("one of the parameters 'concepts', 'nearVector' or " +
+			"'nearObject' must be set")
+	}
+
+	return nil
+}
+
+// certainty returns the certainty of the nearVector or nearObject param if
+// the search is not based on concepts
+func (p ExploreParams) certainty() float64 {
+	if p.NearVector != nil {
+		return p.NearVector.Certainty
+	}
+
+	if p.NearObject != nil {
+		return p.NearObject.Certainty
+	}
+
+	return p.Certainty
 }
 
 // ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
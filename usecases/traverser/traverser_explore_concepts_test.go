@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -38,7 +39,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorizer, vectorSearcher, explorer, schemaGetter)
+			vectorizer, vectorSearcher, explorer, schemaGetter, nil)
 		params := ExploreParams{
 			Values:  []string{"a search term", "another"},
 			Network: true,
@@ -61,7 +62,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorizer, vectorSearcher, explorer, schemaGetter)
+			vectorizer, vectorSearcher, explorer, schemaGetter, nil)
 		params := ExploreParams{
 			Values: []string{"a search term", "another"},
 		}
@@ -115,7 +116,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorizer, vectorSearcher, explorer, schemaGetter)
+			vectorizer, vectorSearcher, explorer, schemaGetter, nil)
 		params := ExploreParams{
 			Values:    []string{"a search term", "another"},
 			Certainty: 0.6,
@@ -154,7 +155,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorizer, vectorSearcher, explorer, schemaGetter)
+			vectorizer, vectorSearcher, explorer, schemaGetter, nil)
 		params := ExploreParams{
 			Limit:  100,
 			Values: []string{"a search term", "another"},
@@ -205,4 +206,60 @@ func Test_ExploreConcepts(t *testing.T) {
 		assert.Equal(t, 100, vectorSearcher.calledWithLimit,
 			"limit explicitly set")
 	})
+	t.Run("with nearObject set", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter, nil)
+		params := ExploreParams{
+			NearObject: &NearObjectParams{
+				ID: "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
+			},
+		}
+		vectorSearcher.
+			On("ThingByID", strfmt.UUID("bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a")).
+			Return(&search.Result{
+				Kind:   kind.Thing,
+				ID:     "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
+				Vector: []float32{4, 5, 6},
+			}, nil)
+
+		_, err := traverser.Explore(context.Background(), nil, params)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{4, 5, 6}, vectorSearcher.calledWithVector)
+	})
+
+	t.Run("with concepts and nearObject set", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter, nil)
+		params := ExploreParams{
+			Values: []string{"a search term"},
+			NearObject: &NearObjectParams{
+				ID: "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
+			},
+		}
+
+		_, err := traverser.Explore(context.Background(), nil, params)
+		assert.NotNil(t, err)
+	})
 }
@@ -14,13 +14,16 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params GetParams) (interface{}, error) {
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	defer t.recordDuration("get", time.Now())
+
+	err := t.authorizeGet(principal, params)
 	if err != nil {
 		return nil, err
 	}
@@ -31,5 +34,9 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
+	if err := t.authorizeNearObject(ctx, principal, params); err != nil {
+		return nil, err
+	}
+
 	return t.explorer.GetClass(ctx, params)
 }
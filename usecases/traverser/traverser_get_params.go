@@ -27,8 +27,13 @@ type GetParams struct {
 	Filters              *filters.LocalFilter
 	ClassName            string
 	Pagination           *filters.Pagination
+	Sort                 []filters.Sort
 	Properties           SelectProperties
 	Explore              *ExploreParams
+	NearVector           *NearVectorParams
+	NearObject           *NearObjectParams
+	KeywordRanking       *KeywordRankingParams
+	Hybrid               *HybridParams
 	SearchVector         []float32
 	Group                *GroupParams
 	UnderscoreProperties UnderscoreProperties
@@ -51,6 +56,54 @@ type SelectClass struct {
 	RefProperties SelectProperties
 }
 
+// NearVectorParams to do a vector based search with a vector provided by the
+// user rather than one built from search terms
+type NearVectorParams struct {
+	Vector    []float32
+	Certainty float64
+}
+
+// NearObjectParams to do a vector based search with the vector of an
+// existing object. The object can be of any kind or class, it does not need
+// to match the class that is searched.
+type NearObjectParams struct {
+	ID        string
+	Certainty float64
+}
+
+// KeywordRankingParams to rank the results by the relevance of their text
+// props to the query rather than by vector distance. Type is the ranking
+// function, such as "bm25". If no properties are set, all text and string
+// props are searched.
+type KeywordRankingParams struct {
+	Type       string
+	Query      string
+	Properties []string
+}
+
+// HybridParams to combine a vector search with a keyword search for the same
+// query. Alpha weighs the two rankings, 1 is a pure vector search and 0 is a
+// pure keyword search. If no vector is set, the query is vectorized. Fusion
+// determines how the rankings are combined, see HybridFusionRanked and
+// HybridFusionRelativeScore.
+type HybridParams struct {
+	Query      string
+	Alpha      float64
+	Properties []string
+	Vector     []float32
+	Fusion     string
+}
+
+const (
+	// HybridFusionRanked combines the ranks of an object in both searches
+	// using reciprocal rank fusion, the actual scores are ignored
+	HybridFusionRanked = "rankedFusion"
+
+	// HybridFusionRelativeScore normalizes the scores of each search to a
+	// range of 0 to 1 and combines them
+	HybridFusionRelativeScore = "relativeScoreFusion"
+)
+
 type GroupParams struct {
 	Strategy string
 	Force    float32
@@ -147,4 +200,6 @@ type UnderscoreProperties struct {
 	SemanticPath      *sempath.Params
 	FeatureProjection *libprojector.Params
 	Certainty         bool
+	Score             bool
+	Hybrid            bool
 }